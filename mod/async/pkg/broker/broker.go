@@ -22,27 +22,41 @@ package broker
 
 import (
 	"context"
-	"time"
+	"sync"
 )
 
 // Broker broadcasts msgs to registered clients.
 type Broker[T any] struct {
 	// name of the message broker.
 	name string
-	// clients is a map of registered clients.
-	clients map[chan T]struct{}
+	// clients is the registry of subscribers, keyed by their channel.
+	clients map[chan T]*subscriber[T]
 	// msgs is the channel for publishing new messages.
 	msgs chan T
-	// timeout is the timeout for sending a msg to a client.
-	timeout time.Duration
+	// metrics counts the msgs that were dropped or delayed.
+	metrics *brokerMetrics
+	// journal records the published msgs before they are broadcast, nil
+	// if they are not journaled.
+	journal func(any) (any, error)
+	// mu guards clients, which may be (un)subscribed while the broker is
+	// running.
+	mu sync.RWMutex
 }
 
 // New creates a new b.
-func New[T any](name string) *Broker[T] {
+func New[T any](name string, opts ...Option) *Broker[T] {
+	o := &options{
+		bufferSize: defaultBufferSize,
+		sink:       noopSink{},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
 	return &Broker[T]{
-		clients: make(map[chan T]struct{}),
-		msgs:    make(chan T, defaultBufferSize),
-		timeout: defaultTimeout,
+		clients: make(map[chan T]*subscriber[T]),
+		msgs:    make(chan T, o.bufferSize),
+		metrics: newBrokerMetrics(name, o.sink),
+		journal: o.journal,
 		name:    name,
 	}
 }
@@ -64,26 +78,49 @@ func (b *Broker[T]) start(ctx context.Context) {
 		select {
 		case <-ctx.Done():
 			// close all leftover clients and break the broker loop
-			for client := range b.clients {
-				b.Unsubscribe(client)
+			b.mu.Lock()
+			for client, sub := range b.clients {
+				delete(b.clients, client)
+				sub.close()
 			}
+			b.mu.Unlock()
 			return
 		case msg := <-b.msgs:
-			// broadcast published msg to all clients
-			for client := range b.clients {
-				// send msg to client (or discard msg after timeout)
-				select {
-				case client <- msg:
-				case <-time.After(b.timeout):
-				}
-			}
+			b.broadcast(ctx, msg)
 		}
 	}
 }
 
+// broadcast sends a published msg to all registered clients, applying the
+// backpressure policy of each of them.
+func (b *Broker[T]) broadcast(ctx context.Context, msg T) {
+	// Snapshot the registry so that a blocking client does not hold the
+	// lock and prevent others from (un)subscribing.
+	b.mu.RLock()
+	subs := make([]*subscriber[T], 0, len(b.clients))
+	for _, sub := range b.clients {
+		subs = append(subs, sub)
+	}
+	b.mu.RUnlock()
+
+	for _, sub := range subs {
+		sub.send(ctx, msg, b.metrics)
+	}
+}
+
 // Publish publishes a msg to the b.
-// Returns ErrTimeout on timeout.
+// The msg is appended to the journal of the broker first, if any. Returns
+// the context error if the broker cannot accept the msg before the context
+// is done.
 func (b *Broker[T]) Publish(ctx context.Context, msg T) error {
+	if b.journal != nil {
+		journaled, err := b.journal(msg)
+		if err != nil {
+			return err
+		}
+		msg, _ = journaled.(T)
+	}
+
 	select {
 	case b.msgs <- msg:
 		return nil
@@ -93,18 +130,63 @@ func (b *Broker[T]) Publish(ctx context.Context, msg T) error {
 }
 
 // Subscribe registers a new client to the broker and returns it to the caller.
-// Returns ErrTimeout on timeout.
+// The client is buffered with the default size and blocks the broker when
+// full, so that it never misses a msg.
 func (b *Broker[T]) Subscribe() (chan T, error) {
-	client := make(chan T)
-	b.clients[client] = struct{}{}
-	return client, nil
+	return b.SubscribeWithOptions()
+}
+
+// SubscribeWithOptions registers a new client to the broker with the given
+// buffer size, backpressure policy and filter, and returns it to the caller.
+func (b *Broker[T]) SubscribeWithOptions(
+	opts ...SubscriptionOption,
+) (chan T, error) {
+	o := &subscriptionOptions{
+		bufferSize: defaultBufferSize,
+		policy:     Block,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.bufferSize < 0 {
+		return nil, ErrInvalidBufferSize
+	}
+	if o.policy > DropNewest {
+		return nil, ErrInvalidPolicy
+	}
+
+	sub := newSubscriber[T](o.bufferSize, o.policy, o.filter)
+	b.mu.Lock()
+	b.clients[sub.ch] = sub
+	b.mu.Unlock()
+	return sub.ch, nil
 }
 
 // Unsubscribe removes a client from the b.
-// Returns ErrTimeout on timeout.
+// It is a no-op if the client has already been removed, e.g. because the
+// broker has been stopped.
 func (b *Broker[T]) Unsubscribe(client chan T) {
+	b.mu.Lock()
+	sub, ok := b.clients[client]
+	if !ok {
+		b.mu.Unlock()
+		return
+	}
 	// Remove the client from the broker
 	delete(b.clients, client)
-	// close the client channel
-	close(client)
+	b.mu.Unlock()
+	// close the client channel, releasing the broker if it is blocked on it
+	sub.close()
+}
+
+// Dropped returns the number of msgs that were dropped by clients with a
+// drop policy.
+func (b *Broker[T]) Dropped() uint64 {
+	return b.metrics.dropped.Load()
+}
+
+// Delayed returns the number of msgs the broker had to wait on because a
+// client with the block policy was full.
+func (b *Broker[T]) Delayed() uint64 {
+	return b.metrics.delayed.Load()
 }
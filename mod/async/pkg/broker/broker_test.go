@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/stretchr/testify/require"
+)
+
+// startBroker returns a started broker that is stopped with the test.
+func startBroker(t *testing.T, opts ...broker.Option) *broker.Broker[int] {
+	t.Helper()
+	b := broker.New[int]("test-broker", opts...)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, b.Start(ctx))
+	return b
+}
+
+// publish publishes the msgs in order.
+func publish(t *testing.T, b *broker.Broker[int], msgs ...int) {
+	t.Helper()
+	for _, msg := range msgs {
+		require.NoError(t, b.Publish(context.Background(), msg))
+	}
+}
+
+// receive returns the next msg of the client.
+func receive(t *testing.T, ch chan int) int {
+	t.Helper()
+	select {
+	case msg, ok := <-ch:
+		require.True(t, ok, "client closed")
+		return msg
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for msg")
+		return 0
+	}
+}
+
+// drain returns the msgs buffered by the client.
+func drain(ch chan int) []int {
+	var msgs []int
+	for {
+		select {
+		case msg := <-ch:
+			msgs = append(msgs, msg)
+		default:
+			return msgs
+		}
+	}
+}
+
+// waitFor waits until the counter of the broker reaches n.
+func waitFor(t *testing.T, counter func() uint64, n uint64) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return counter() == n
+	}, time.Second, time.Millisecond)
+}
+
+func TestBroker_Block(t *testing.T) {
+	b := startBroker(t)
+	ch, err := b.SubscribeWithOptions(broker.WithSubscriberBufferSize(1))
+	require.NoError(t, err)
+
+	// The broker waits on the second message until the first one is read.
+	publish(t, b, 1, 2, 3)
+	waitFor(t, b.Delayed, 1)
+	require.Equal(t, 1, receive(t, ch))
+	require.Equal(t, 2, receive(t, ch))
+	require.Equal(t, 3, receive(t, ch))
+	require.Zero(t, b.Dropped())
+}
+
+func TestBroker_DropOldest(t *testing.T) {
+	b := startBroker(t)
+	ch, err := b.SubscribeWithOptions(
+		broker.WithSubscriberBufferSize(2),
+		broker.WithPolicy(broker.DropOldest),
+	)
+	require.NoError(t, err)
+
+	// Neither message is read until both overflowing messages are dropped.
+	publish(t, b, 1, 2, 3, 4)
+	waitFor(t, b.Dropped, 2)
+	require.Equal(t, []int{3, 4}, drain(ch))
+}
+
+func TestBroker_DropNewest(t *testing.T) {
+	b := startBroker(t)
+	ch, err := b.SubscribeWithOptions(
+		broker.WithSubscriberBufferSize(2),
+		broker.WithPolicy(broker.DropNewest),
+	)
+	require.NoError(t, err)
+
+	// Neither message is read until both overflowing messages are dropped.
+	publish(t, b, 1, 2, 3, 4)
+	waitFor(t, b.Dropped, 2)
+	require.Equal(t, []int{1, 2}, drain(ch))
+}
+
+func TestBroker_Filter(t *testing.T) {
+	b := startBroker(t)
+	ch, err := b.SubscribeWithOptions(
+		broker.WithFilter(func(msg int) bool { return msg%2 == 0 }),
+	)
+	require.NoError(t, err)
+
+	publish(t, b, 1, 2, 3, 4)
+	require.Equal(t, 2, receive(t, ch))
+	require.Equal(t, 4, receive(t, ch))
+}
+
+func TestBroker_InvalidSubscription(t *testing.T) {
+	b := broker.New[int]("test-broker")
+	_, err := b.SubscribeWithOptions(broker.WithSubscriberBufferSize(-1))
+	require.ErrorIs(t, err, broker.ErrInvalidBufferSize)
+	_, err = b.SubscribeWithOptions(broker.WithPolicy(broker.Policy(42)))
+	require.ErrorIs(t, err, broker.ErrInvalidPolicy)
+}
+
+func TestBroker_UnsubscribeReleasesBlockedBroadcast(t *testing.T) {
+	b := startBroker(t)
+	stuck, err := b.SubscribeWithOptions(broker.WithSubscriberBufferSize(0))
+	require.NoError(t, err)
+	ch, err := b.Subscribe()
+	require.NoError(t, err)
+
+	// The broker blocks on the client that never reads until it is removed.
+	publish(t, b, 1)
+	b.Unsubscribe(stuck)
+	_, ok := <-stuck
+	require.False(t, ok)
+	require.Equal(t, 1, receive(t, ch))
+}
+
+func TestBroker_ConcurrentSubscriptions(t *testing.T) {
+	b := startBroker(t)
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				ch, err := b.SubscribeWithOptions(
+					broker.WithPolicy(broker.DropNewest),
+				)
+				require.NoError(t, err)
+				b.Unsubscribe(ch)
+			}
+		}()
+	}
+	for i := range 100 {
+		publish(t, b, i)
+	}
+	wg.Wait()
+}
+
+func TestBroker_StopClosesClients(t *testing.T) {
+	b := broker.New[int]("test-broker")
+	ch, err := b.Subscribe()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, b.Start(ctx))
+	cancel()
+
+	select {
+	case _, ok := <-ch:
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		require.FailNow(t, "client was not closed")
+	}
+	// Unsubscribing a client of a stopped broker is a no-op.
+	b.Unsubscribe(ch)
+}
@@ -20,13 +20,8 @@
 
 package broker
 
-import "time"
-
 const (
-	// defaultTimeout specifies the default timeout when the broker
-	// tries to send a message to a client, a message is published to the
-	// broker, or a client subscribes or unsubscribes.
-	defaultTimeout = time.Second
-	// defaultBufferSize specifies the default size of the message buffer.
+	// defaultBufferSize specifies the default size of the publish buffer and
+	// of each client channel.
 	defaultBufferSize = 10
 )
@@ -24,5 +24,13 @@ import (
 	"errors"
 )
 
-// ErrTimeout is the error returned when a broker operation timed out.
-var ErrTimeout = errors.New("timeout")
+var (
+	// ErrInvalidBufferSize is returned when subscribing with a negative
+	// buffer size.
+	ErrInvalidBufferSize = errors.New("invalid buffer size")
+	// ErrInvalidPolicy is returned when subscribing with an unknown policy.
+	ErrInvalidPolicy = errors.New("invalid policy")
+	// ErrJournalTypeMismatch is returned when publishing to a broker whose
+	// journal does not take its msg type.
+	ErrJournalTypeMismatch = errors.New("journal type mismatch")
+)
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+import "sync/atomic"
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments the counter identified by
+	// the provided key.
+	IncrementCounter(key string, args ...string)
+}
+
+// noopSink is a TelemetrySink that discards all metrics.
+type noopSink struct{}
+
+// IncrementCounter is a no-op.
+func (noopSink) IncrementCounter(string, ...string) {}
+
+// brokerMetrics counts the msgs a broker could not deliver right away.
+type brokerMetrics struct {
+	// name is the name of the broker, used as a label.
+	name string
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+	// dropped is the number of msgs dropped by clients.
+	dropped atomic.Uint64
+	// delayed is the number of msgs that blocked on a full client.
+	delayed atomic.Uint64
+}
+
+// newBrokerMetrics creates a new brokerMetrics.
+func newBrokerMetrics(name string, sink TelemetrySink) *brokerMetrics {
+	return &brokerMetrics{
+		name: name,
+		sink: sink,
+	}
+}
+
+// markDropped increments the number of msgs dropped under the given policy.
+func (bm *brokerMetrics) markDropped(policy Policy) {
+	bm.dropped.Add(1)
+	bm.sink.IncrementCounter(
+		"beacon_kit.async.broker.dropped",
+		"broker", bm.name,
+		"policy", policy.String(),
+	)
+}
+
+// markDelayed increments the number of msgs delayed by a full client.
+func (bm *brokerMetrics) markDelayed() {
+	bm.delayed.Add(1)
+	bm.sink.IncrementCounter(
+		"beacon_kit.async.broker.delayed",
+		"broker", bm.name,
+	)
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+// Option is a functional option for the broker.
+type Option func(*options)
+
+// options holds the configuration of the broker.
+type options struct {
+	// bufferSize is the size of the publish buffer.
+	bufferSize int
+	// sink records the dropped and delayed msgs.
+	sink TelemetrySink
+	// journal records the published msgs before they are broadcast, nil
+	// if they are not journaled.
+	journal func(any) (any, error)
+}
+
+// WithBufferSize sets the size of the buffer msgs are published to.
+func WithBufferSize(size int) Option {
+	return func(o *options) {
+		o.bufferSize = size
+	}
+}
+
+// WithTelemetrySink sets the sink the broker reports its metrics to.
+func WithTelemetrySink(sink TelemetrySink) Option {
+	return func(o *options) {
+		o.sink = sink
+	}
+}
+
+// WithJournal appends every published msg to the journal before it is
+// broadcast. The journal must take the msg type of the broker, otherwise
+// publishing fails with ErrJournalTypeMismatch.
+func WithJournal[T any](journal Journal[T]) Option {
+	return func(o *options) {
+		o.journal = func(msg any) (any, error) {
+			typed, ok := msg.(T)
+			if !ok {
+				return nil, ErrJournalTypeMismatch
+			}
+			return journal.Append(typed)
+		}
+	}
+}
+
+// SubscriptionOption is a functional option for a client of the broker.
+type SubscriptionOption func(*subscriptionOptions)
+
+// subscriptionOptions holds the configuration of a client.
+type subscriptionOptions struct {
+	// bufferSize is the size of the client channel.
+	bufferSize int
+	// policy is applied when the client channel is full.
+	policy Policy
+	// filter selects the msgs delivered to the client, nil for all of them.
+	filter func(any) bool
+}
+
+// WithSubscriberBufferSize sets the size of the client channel.
+func WithSubscriberBufferSize(size int) SubscriptionOption {
+	return func(o *subscriptionOptions) {
+		o.bufferSize = size
+	}
+}
+
+// WithPolicy sets the policy applied when the client channel is full.
+func WithPolicy(policy Policy) SubscriptionOption {
+	return func(o *subscriptionOptions) {
+		o.policy = policy
+	}
+}
+
+// WithFilter only delivers the msgs for which the filter returns true. The
+// filter must take the msg type of the broker, otherwise no msg is delivered.
+func WithFilter[T any](filter func(T) bool) SubscriptionOption {
+	return func(o *subscriptionOptions) {
+		o.filter = func(msg any) bool {
+			typed, ok := msg.(T)
+			return ok && filter(typed)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+import (
+	"context"
+	"sync"
+)
+
+// Policy is the backpressure policy applied when a client's buffer is full.
+type Policy uint8
+
+const (
+	// Block makes the broker wait until the client has room for the msg.
+	// No msg is ever lost, at the cost of delaying every other client.
+	Block Policy = iota
+	// DropOldest evicts the oldest buffered msg to make room for the new one.
+	DropOldest
+	// DropNewest discards the new msg and keeps the buffered ones.
+	DropNewest
+)
+
+// String returns the name of the policy.
+func (p Policy) String() string {
+	switch p {
+	case Block:
+		return "block"
+	case DropOldest:
+		return "drop-oldest"
+	case DropNewest:
+		return "drop-newest"
+	default:
+		return "unknown"
+	}
+}
+
+// subscriber is a client registered to the broker.
+type subscriber[T any] struct {
+	// ch is the channel the client receives msgs on.
+	ch chan T
+	// policy is applied when ch is full.
+	policy Policy
+	// filter selects the msgs sent to the client, nil for all of them.
+	filter func(any) bool
+	// done is closed when the client is removed, to release a blocked send.
+	done chan struct{}
+	// doneOnce guards closing done.
+	doneOnce sync.Once
+	// mu serializes sends with closing ch.
+	mu sync.Mutex
+	// closed is set once ch has been closed.
+	closed bool
+}
+
+// newSubscriber creates a new subscriber.
+func newSubscriber[T any](
+	bufferSize int,
+	policy Policy,
+	filter func(any) bool,
+) *subscriber[T] {
+	return &subscriber[T]{
+		ch:     make(chan T, bufferSize),
+		policy: policy,
+		filter: filter,
+		done:   make(chan struct{}),
+	}
+}
+
+// send sends the msg to the client according to its policy, recording the
+// msgs that could not be delivered right away.
+func (s *subscriber[T]) send(
+	ctx context.Context,
+	msg T,
+	metrics *brokerMetrics,
+) {
+	if s.filter != nil && !s.filter(msg) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+
+	select {
+	case s.ch <- msg:
+		return
+	default:
+	}
+
+	switch s.policy {
+	case DropOldest:
+		// Only the broker sends on ch, so once the oldest msg is evicted
+		// there is room for the new one, unless ch is unbuffered.
+		select {
+		case <-s.ch:
+		default:
+		}
+		select {
+		case s.ch <- msg:
+		default:
+		}
+		metrics.markDropped(s.policy)
+	case DropNewest:
+		metrics.markDropped(s.policy)
+	default:
+		metrics.markDelayed()
+		select {
+		case s.ch <- msg:
+		case <-s.done:
+		case <-ctx.Done():
+		}
+	}
+}
+
+// close closes the client channel once no send is in flight.
+func (s *subscriber[T]) close() {
+	s.doneOnce.Do(func() { close(s.done) })
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.ch)
+	}
+}
@@ -0,0 +1,400 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bus
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/async/pkg/journal"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+)
+
+// Message is the view of an event shared by all the payload types, as
+// received by the wildcard subscribers of the bus.
+type Message interface {
+	// Type returns the ID of the event.
+	Type() asynctypes.EventID
+	// Context returns the context associated with the event.
+	Context() context.Context
+	// Error returns the error associated with the event.
+	Error() error
+	// Is returns true if the event has the given ID.
+	Is(eventID asynctypes.EventID) bool
+}
+
+// Bus routes the events of the node to the subscribers of their IDs. Every
+// event ID is registered with the type of its payload, and the events that
+// share a payload type are carried by the same topic.
+type Bus struct {
+	// opts are the options the brokers of the topics are created with.
+	opts []broker.Option
+	// topics maps the registered event IDs to their topic.
+	topics map[asynctypes.EventID]topic
+	// all is the list of the registered topics.
+	all []topic
+	// wildcards are the subscribers to every event of the bus.
+	wildcards []*wildcard
+	// subs maps the channel of each subscriber to its unsubscribe function.
+	subs map[any]func()
+	// ctx is the context the bus has been started with, nil until then.
+	ctx context.Context
+	// mu guards the registry, which may be updated while the bus is running.
+	mu sync.RWMutex
+}
+
+// New creates a new bus, whose topics are brokers created with the given
+// options.
+func New(opts ...broker.Option) *Bus {
+	return &Bus{
+		opts:   opts,
+		topics: make(map[asynctypes.EventID]topic),
+		subs:   make(map[any]func()),
+	}
+}
+
+// Name returns the name of the bus.
+func (b *Bus) Name() string {
+	return "event-bus"
+}
+
+// Start starts the topics of the bus. Topics registered later on are started
+// as soon as they are registered.
+func (b *Bus) Start(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.ctx != nil {
+		return ErrAlreadyStarted
+	}
+	b.ctx = ctx
+	for _, t := range b.all {
+		if err := t.start(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stop unsubscribes the remaining subscribers of the bus, closing their
+// channels. The topics stop once the context the bus has been started with
+// is cancelled.
+func (b *Bus) Stop(context.Context) error {
+	b.mu.Lock()
+	subs := b.subs
+	b.subs = make(map[any]func())
+	b.mu.Unlock()
+	for _, unsubscribe := range subs {
+		unsubscribe()
+	}
+	return nil
+}
+
+// Status returns the status of the bus.
+func (b *Bus) Status() error {
+	return nil
+}
+
+// Register registers the given event IDs with the payload type T. The events
+// are carried by a new topic with the given name, used in the metrics.
+func Register[T any](
+	b *Bus,
+	name string,
+	eventIDs ...asynctypes.EventID,
+) error {
+	return register[T](b, name, nil, eventIDs)
+}
+
+// RegisterDurable registers the given event IDs with the payload type T, like
+// Register, and appends the events with the durable IDs to the journal
+// before they are dispatched, using the codec to encode their payload.
+// Events carrying an error are not journaled.
+func RegisterDurable[T any](
+	b *Bus,
+	name string,
+	j *journal.Journal,
+	codec Codec[T],
+	durableIDs []asynctypes.EventID,
+	eventIDs ...asynctypes.EventID,
+) error {
+	ej := newEventJournal(j, codec)
+	for _, eventID := range durableIDs {
+		if !slices.Contains(eventIDs, eventID) {
+			return ErrUnknownEvent
+		}
+		ej.durable[eventID] = struct{}{}
+	}
+	return register(b, name, ej, eventIDs)
+}
+
+// register registers the given event IDs with the payload type T, journaled
+// by the given journal if not nil.
+func register[T any](
+	b *Bus,
+	name string,
+	ej *eventJournal[T],
+	eventIDs []asynctypes.EventID,
+) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, eventID := range eventIDs {
+		if _, ok := b.topics[eventID]; ok {
+			return ErrAlreadyRegistered
+		}
+	}
+
+	opts := b.opts
+	if ej != nil {
+		opts = append(slices.Clone(opts), broker.WithJournal(ej))
+	}
+	t := &typedTopic[T]{
+		broker:  broker.New[*asynctypes.Event[T]](name, opts...),
+		journal: ej,
+	}
+	for _, eventID := range eventIDs {
+		b.topics[eventID] = t
+	}
+	b.all = append(b.all, t)
+
+	if b.ctx != nil {
+		if err := t.start(b.ctx); err != nil {
+			return err
+		}
+	}
+	for _, w := range b.wildcards {
+		if err := w.attach(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Topic returns the broker carrying the events with the given ID, for the
+// producers and consumers of the node that are wired to a single feed.
+func Topic[T any](
+	b *Bus,
+	eventID asynctypes.EventID,
+) (*broker.Broker[*asynctypes.Event[T]], error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	t, err := lookup[T](b, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return t.broker, nil
+}
+
+// Publish publishes the event to the subscribers of its ID.
+func Publish[T any](
+	ctx context.Context,
+	b *Bus,
+	event *asynctypes.Event[T],
+) error {
+	t, err := Topic[T](b, event.Type())
+	if err != nil {
+		return err
+	}
+	return t.Publish(ctx, event)
+}
+
+// Subscribe subscribes to the events with the given IDs, which must all
+// have been registered with the payload type T.
+func Subscribe[T any](
+	b *Bus,
+	eventIDs ...asynctypes.EventID,
+) (chan *asynctypes.Event[T], error) {
+	return SubscribeWithOptions[T](b, eventIDs)
+}
+
+// SubscribeWithOptions subscribes to the events with the given IDs with the
+// given buffer size and backpressure policy.
+func SubscribeWithOptions[T any](
+	b *Bus,
+	eventIDs []asynctypes.EventID,
+	opts ...broker.SubscriptionOption,
+) (chan *asynctypes.Event[T], error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	t, filter, err := lookupAll[T](b, eventIDs)
+	if err != nil {
+		return nil, err
+	}
+	return subscribe(b, t, filter, opts...)
+}
+
+// SubscribeDurable subscribes the named consumer to the events with the
+// given IDs. If the topic of the events is journaled, the journaled events
+// the consumer has not acknowledged yet, such as the ones left over by a
+// previous run, are replayed first, and every journaled event must be
+// acknowledged with Ack once handled. Otherwise, it is a plain
+// subscription and acknowledging is a no-op.
+func SubscribeDurable[T any](
+	b *Bus,
+	consumer string,
+	eventIDs ...asynctypes.EventID,
+) (chan *asynctypes.Event[T], error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	t, filter, err := lookupAll[T](b, eventIDs)
+	if err != nil {
+		return nil, err
+	}
+	if t.journal == nil {
+		return subscribe(b, t, filter)
+	}
+
+	if err = t.journal.register(consumer); err != nil {
+		return nil, err
+	}
+	// Subscribe before reading the pending events, so that no event falls
+	// in between. The events found in both are only delivered once.
+	ch, err := t.broker.Subscribe()
+	if err != nil {
+		return nil, err
+	}
+	pending, err := t.journal.pending(consumer)
+	if err != nil {
+		t.broker.Unsubscribe(ch)
+		return nil, err
+	}
+
+	out, stop := t.journal.replay(consumer, pending, ch, filter)
+	b.subs[out] = func() {
+		stop()
+		t.broker.Unsubscribe(ch)
+	}
+	return out, nil
+}
+
+// SubscribeAll subscribes to every event of the bus, including the ones
+// registered later on. It is meant for the observability sinks of the node,
+// which must not hold back the other subscribers, so it drops the oldest
+// events unless configured otherwise.
+func (b *Bus) SubscribeAll(
+	opts ...broker.SubscriptionOption,
+) (chan Message, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	w := newWildcard(append(
+		[]broker.SubscriptionOption{broker.WithPolicy(broker.DropOldest)},
+		opts...,
+	))
+	for _, t := range b.all {
+		if err := w.attach(t); err != nil {
+			w.close()
+			return nil, err
+		}
+	}
+	b.wildcards = append(b.wildcards, w)
+	b.subs[w.ch] = func() {
+		b.mu.Lock()
+		for i, other := range b.wildcards {
+			if other == w {
+				b.wildcards = append(b.wildcards[:i], b.wildcards[i+1:]...)
+				break
+			}
+		}
+		b.mu.Unlock()
+		w.close()
+	}
+	return w.ch, nil
+}
+
+// Unsubscribe removes the subscriber with the given channel, returned by
+// Subscribe or SubscribeAll, and closes the channel. It is a no-op if the
+// subscriber has already been removed.
+func (b *Bus) Unsubscribe(ch any) {
+	b.mu.Lock()
+	unsubscribe, ok := b.subs[ch]
+	delete(b.subs, ch)
+	b.mu.Unlock()
+	if ok {
+		unsubscribe()
+	}
+}
+
+// subscribe subscribes to the events of the topic with the IDs of the
+// filter. The caller must hold the lock.
+func subscribe[T any](
+	b *Bus,
+	t *typedTopic[T],
+	filter map[asynctypes.EventID]struct{},
+	opts ...broker.SubscriptionOption,
+) (chan *asynctypes.Event[T], error) {
+	ch, err := t.broker.SubscribeWithOptions(append(
+		opts, broker.WithFilter(func(event *asynctypes.Event[T]) bool {
+			_, ok := filter[event.Type()]
+			return ok
+		}),
+	)...)
+	if err != nil {
+		return nil, err
+	}
+	b.subs[ch] = func() { t.broker.Unsubscribe(ch) }
+	return ch, nil
+}
+
+// lookupAll returns the topic of the given event IDs, which must all be
+// carried by the same topic, along with the set of the IDs. The caller must
+// hold the lock.
+func lookupAll[T any](
+	b *Bus,
+	eventIDs []asynctypes.EventID,
+) (*typedTopic[T], map[asynctypes.EventID]struct{}, error) {
+	if len(eventIDs) == 0 {
+		return nil, nil, ErrNoEventIDs
+	}
+
+	t, err := lookup[T](b, eventIDs[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	filter := make(map[asynctypes.EventID]struct{}, len(eventIDs))
+	for _, eventID := range eventIDs {
+		var other *typedTopic[T]
+		if other, err = lookup[T](b, eventID); err != nil {
+			return nil, nil, err
+		}
+		if other != t {
+			return nil, nil, ErrTopicMismatch
+		}
+		filter[eventID] = struct{}{}
+	}
+	return t, filter, nil
+}
+
+// lookup returns the topic of the given event ID, which must have been
+// registered with the payload type T. The caller must hold the lock.
+func lookup[T any](
+	b *Bus,
+	eventID asynctypes.EventID,
+) (*typedTopic[T], error) {
+	t, ok := b.topics[eventID]
+	if !ok {
+		return nil, ErrUnknownEvent
+	}
+	typed, ok := t.(*typedTopic[T])
+	if !ok {
+		return nil, ErrTypeMismatch
+	}
+	return typed, nil
+}
@@ -0,0 +1,266 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bus_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/bus"
+	"github.com/berachain/beacon-kit/mod/async/pkg/journal"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	blockBuilt     asynctypes.EventID = "block-built"
+	blockFinalized asynctypes.EventID = "block-finalized"
+	newSlot        asynctypes.EventID = "new-slot"
+)
+
+// newTestBus returns a started bus with a string topic for blocks and a
+// uint64 topic for slots.
+func newTestBus(t *testing.T) *bus.Bus {
+	t.Helper()
+	b := bus.New()
+	require.NoError(
+		t, bus.Register[string](b, "blk-broker", blockBuilt, blockFinalized),
+	)
+	require.NoError(t, bus.Register[uint64](b, "slot-broker", newSlot))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, b.Start(ctx))
+	return b
+}
+
+// publish publishes an event with the given ID and payload.
+func publish[T any](
+	t *testing.T,
+	b *bus.Bus,
+	eventID asynctypes.EventID,
+	data T,
+) {
+	t.Helper()
+	require.NoError(t, bus.Publish(
+		context.Background(), b,
+		asynctypes.NewEvent(context.Background(), eventID, data),
+	))
+}
+
+// receive returns the next msg of the channel.
+func receive[T any](t *testing.T, ch chan T) T {
+	t.Helper()
+	select {
+	case msg, ok := <-ch:
+		require.True(t, ok, "subscriber closed")
+		return msg
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for event")
+		var zero T
+		return zero
+	}
+}
+
+func TestBus_SubscribeFiltersEventIDs(t *testing.T) {
+	b := newTestBus(t)
+	ch, err := bus.Subscribe[string](b, blockFinalized)
+	require.NoError(t, err)
+
+	publish(t, b, blockBuilt, "built")
+	publish(t, b, blockFinalized, "finalized")
+	event := receive(t, ch)
+	require.True(t, event.Is(blockFinalized))
+	require.Equal(t, "finalized", event.Data())
+
+	b.Unsubscribe(ch)
+	_, ok := <-ch
+	require.False(t, ok)
+}
+
+func TestBus_TypeSafety(t *testing.T) {
+	b := newTestBus(t)
+
+	_, err := bus.Subscribe[uint64](b, blockBuilt)
+	require.ErrorIs(t, err, bus.ErrTypeMismatch)
+	_, err = bus.Subscribe[string](b, "unknown")
+	require.ErrorIs(t, err, bus.ErrUnknownEvent)
+	_, err = bus.Subscribe[string](b)
+	require.ErrorIs(t, err, bus.ErrNoEventIDs)
+	require.ErrorIs(t, bus.Publish(
+		context.Background(), b,
+		asynctypes.NewEvent(context.Background(), newSlot, "slot"),
+	), bus.ErrTypeMismatch)
+	require.ErrorIs(
+		t, bus.Register[string](b, "dup-broker", blockBuilt),
+		bus.ErrAlreadyRegistered,
+	)
+}
+
+func TestFeed_SubscribesToEventIDs(t *testing.T) {
+	b := newTestBus(t)
+	feed, err := bus.NewFeed[string](b, blockFinalized)
+	require.NoError(t, err)
+	ch, err := feed.Subscribe()
+	require.NoError(t, err)
+
+	// The feed publishes any event of its payload type.
+	require.NoError(t, feed.Publish(
+		context.Background(),
+		asynctypes.NewEvent(context.Background(), blockBuilt, "built"),
+	))
+	publish(t, b, blockFinalized, "finalized")
+	event := receive(t, ch)
+	require.True(t, event.Is(blockFinalized))
+	require.Equal(t, "finalized", event.Data())
+
+	_, err = bus.NewFeed[uint64](b, blockFinalized)
+	require.ErrorIs(t, err, bus.ErrTypeMismatch)
+
+	// A feed without event IDs can only publish.
+	feed, err = bus.NewFeed[string](b)
+	require.NoError(t, err)
+	_, err = feed.Subscribe()
+	require.ErrorIs(t, err, bus.ErrNoEventIDs)
+}
+
+func TestBus_TopicSharesEvents(t *testing.T) {
+	b := newTestBus(t)
+	topic, err := bus.Topic[string](b, blockBuilt)
+	require.NoError(t, err)
+	ch, err := bus.Subscribe[string](b, blockBuilt)
+	require.NoError(t, err)
+
+	// Events published on the topic directly reach the subscribers of the
+	// bus.
+	require.NoError(t, topic.Publish(
+		context.Background(),
+		asynctypes.NewEvent(context.Background(), blockBuilt, "built"),
+	))
+	require.Equal(t, "built", receive(t, ch).Data())
+}
+
+func TestBus_SubscribeAll(t *testing.T) {
+	b := newTestBus(t)
+	all, err := b.SubscribeAll()
+	require.NoError(t, err)
+
+	publish(t, b, blockBuilt, "built")
+	require.True(t, receive(t, all).Is(blockBuilt))
+	publish(t, b, newSlot, uint64(1))
+	require.True(t, receive(t, all).Is(newSlot))
+
+	// Topics registered after subscribing are forwarded as well.
+	const statusUpdated asynctypes.EventID = "status-updated"
+	require.NoError(t, bus.Register[bool](b, "status-broker", statusUpdated))
+	publish(t, b, statusUpdated, true)
+	require.True(t, receive(t, all).Is(statusUpdated))
+
+	b.Unsubscribe(all)
+	_, ok := <-all
+	require.False(t, ok)
+}
+
+func TestBus_StopClosesSubscribers(t *testing.T) {
+	b := newTestBus(t)
+	blocks, err := bus.Subscribe[string](b, blockBuilt)
+	require.NoError(t, err)
+	all, err := b.SubscribeAll()
+	require.NoError(t, err)
+
+	require.NoError(t, b.Stop(context.Background()))
+	_, ok := <-blocks
+	require.False(t, ok)
+	_, ok = <-all
+	require.False(t, ok)
+}
+
+// stringCodec encodes string payloads as raw bytes.
+type stringCodec struct{}
+
+func (stringCodec) Marshal(data string) ([]byte, error) {
+	return []byte(data), nil
+}
+
+func (stringCodec) Unmarshal(bz []byte) (string, error) {
+	return string(bz), nil
+}
+
+// newDurableBus returns a started bus whose finalized blocks are journaled
+// in the given directory.
+func newDurableBus(t *testing.T, dir string) (*bus.Bus, context.CancelFunc) {
+	t.Helper()
+	j, err := journal.New(dir)
+	require.NoError(t, err)
+	b := bus.New()
+	require.NoError(t, bus.RegisterDurable[string](
+		b, "blk-broker", j, stringCodec{},
+		[]asynctypes.EventID{blockFinalized}, blockBuilt, blockFinalized,
+	))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, b.Start(ctx))
+	return b, cancel
+}
+
+func TestBus_DurableReplay(t *testing.T) {
+	dir := t.TempDir()
+	b, stop := newDurableBus(t, dir)
+	ch, err := bus.SubscribeDurable[string](b, "pruner", blockFinalized)
+	require.NoError(t, err)
+
+	publish(t, b, blockBuilt, "built")
+	publish(t, b, blockFinalized, "handled")
+	publish(t, b, blockFinalized, "lost")
+	event := receive(t, ch)
+	require.Equal(t, "handled", event.Data())
+	require.NoError(t, event.Ack())
+	require.Equal(t, "lost", receive(t, ch).Data())
+
+	// The node stops before the second event is acknowledged, so it is
+	// replayed to the consumer on restart.
+	stop()
+	b, _ = newDurableBus(t, dir)
+	ch, err = bus.SubscribeDurable[string](b, "pruner", blockFinalized)
+	require.NoError(t, err)
+	event = receive(t, ch)
+	require.Equal(t, "lost", event.Data())
+	require.NoError(t, event.Ack())
+
+	publish(t, b, blockFinalized, "live")
+	event = receive(t, ch)
+	require.Equal(t, "live", event.Data())
+	require.Equal(t, uint64(3), event.Sequence())
+}
+
+func TestBus_DurableWithoutJournal(t *testing.T) {
+	b := newTestBus(t)
+	ch, err := bus.SubscribeDurable[string](b, "pruner", blockFinalized)
+	require.NoError(t, err)
+
+	publish(t, b, blockBuilt, "built")
+	publish(t, b, blockFinalized, "finalized")
+	event := receive(t, ch)
+	require.Equal(t, "finalized", event.Data())
+	require.NoError(t, event.Ack())
+}
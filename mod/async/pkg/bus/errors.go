@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bus
+
+import "errors"
+
+var (
+	// ErrAlreadyStarted is returned when starting a bus twice.
+	ErrAlreadyStarted = errors.New("bus already started")
+	// ErrAlreadyRegistered is returned when registering an event ID twice.
+	ErrAlreadyRegistered = errors.New("event already registered")
+	// ErrUnknownEvent is returned for an event ID that is not registered.
+	ErrUnknownEvent = errors.New("unknown event")
+	// ErrTypeMismatch is returned when an event ID is used with a payload
+	// type other than the one it is registered with.
+	ErrTypeMismatch = errors.New("event payload type mismatch")
+	// ErrTopicMismatch is returned when subscribing at once to event IDs
+	// that are carried by different topics.
+	ErrTopicMismatch = errors.New("events registered in different topics")
+	// ErrNoEventIDs is returned when subscribing to no event ID.
+	ErrNoEventIDs = errors.New("no event IDs to subscribe to")
+)
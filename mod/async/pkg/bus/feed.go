@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bus
+
+import (
+	"context"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+)
+
+// Feed is the view of the bus for a service exchanging events with the
+// payload type T. It publishes events of any ID registered with T, but only
+// subscribes to the events with the IDs it is created with.
+type Feed[T any] struct {
+	// bus is the bus the events are routed through.
+	bus *Bus
+	// eventIDs are the IDs of the events the feed subscribes to.
+	eventIDs []asynctypes.EventID
+}
+
+// NewFeed creates a new feed subscribing to the events with the given IDs,
+// which must all have been registered with the payload type T. A feed
+// created without event IDs can only publish.
+func NewFeed[T any](
+	b *Bus,
+	eventIDs ...asynctypes.EventID,
+) (*Feed[T], error) {
+	if len(eventIDs) > 0 {
+		b.mu.RLock()
+		_, _, err := lookupAll[T](b, eventIDs)
+		b.mu.RUnlock()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &Feed[T]{bus: b, eventIDs: eventIDs}, nil
+}
+
+// Publish publishes the event to the subscribers of its ID.
+func (f *Feed[T]) Publish(
+	ctx context.Context,
+	event *asynctypes.Event[T],
+) error {
+	return Publish(ctx, f.bus, event)
+}
+
+// Subscribe subscribes to the events of the feed.
+func (f *Feed[T]) Subscribe() (chan *asynctypes.Event[T], error) {
+	return Subscribe[T](f.bus, f.eventIDs...)
+}
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bus
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/journal"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+)
+
+// Codec encodes the payloads of the journaled events.
+type Codec[T any] interface {
+	// Marshal encodes the payload.
+	Marshal(data T) ([]byte, error)
+	// Unmarshal decodes the payload.
+	Unmarshal(bz []byte) (T, error)
+}
+
+// eventJournal journals the durable events of a topic.
+type eventJournal[T any] struct {
+	// journal stores the events.
+	journal *journal.Journal
+	// codec encodes the payloads of the events.
+	codec Codec[T]
+	// durable is the set of the IDs of the journaled events.
+	durable map[asynctypes.EventID]struct{}
+}
+
+// newEventJournal creates a new eventJournal.
+func newEventJournal[T any](
+	j *journal.Journal,
+	codec Codec[T],
+) *eventJournal[T] {
+	return &eventJournal[T]{
+		journal: j,
+		codec:   codec,
+		durable: make(map[asynctypes.EventID]struct{}),
+	}
+}
+
+// Append journals the event if it is durable and returns it along with its
+// sequence number.
+func (ej *eventJournal[T]) Append(
+	event *asynctypes.Event[T],
+) (*asynctypes.Event[T], error) {
+	if _, ok := ej.durable[event.Type()]; !ok || event.Error() != nil {
+		return event, nil
+	}
+
+	bz, err := ej.codec.Marshal(event.Data())
+	if err != nil {
+		return nil, err
+	}
+	seq, err := ej.journal.Append(event.Type(), bz)
+	if err != nil {
+		return nil, err
+	}
+	return event.WithSequence(seq), nil
+}
+
+// register registers the consumer to the journal.
+func (ej *eventJournal[T]) register(consumer string) error {
+	return ej.journal.Register(consumer)
+}
+
+// pending returns the events the consumer has not acknowledged yet.
+func (ej *eventJournal[T]) pending(consumer string) ([]journal.Entry, error) {
+	return ej.journal.Pending(consumer)
+}
+
+// replay returns a channel delivering the pending events followed by the
+// live events of ch, filtered by ID, and the function to stop it. The
+// journaled events are acknowledged on behalf of the consumer by Ack, or
+// right away if the consumer is not subscribed to them. Pending events that
+// cannot be decoded are dropped.
+func (ej *eventJournal[T]) replay(
+	consumer string,
+	pending []journal.Entry,
+	ch chan *asynctypes.Event[T],
+	filter map[asynctypes.EventID]struct{},
+) (chan *asynctypes.Event[T], func()) {
+	out := make(chan *asynctypes.Event[T])
+	done := make(chan struct{})
+	ack := func(seq uint64) func() error {
+		return func() error { return ej.journal.Ack(consumer, seq) }
+	}
+	send := func(event *asynctypes.Event[T]) bool {
+		select {
+		case out <- event:
+			return true
+		case <-done:
+			return false
+		}
+	}
+
+	go func() {
+		defer close(out)
+		var replayed uint64
+		for _, entry := range pending {
+			replayed = entry.Seq
+			_, subscribed := filter[entry.EventID]
+			data, err := ej.codec.Unmarshal(entry.Data)
+			if !subscribed || err != nil {
+				_ = ack(entry.Seq)()
+				continue
+			}
+			if !send(asynctypes.NewEvent(
+				context.Background(), entry.EventID, data,
+			).WithSequence(entry.Seq).WithAck(ack(entry.Seq))) {
+				return
+			}
+		}
+
+		for event := range ch {
+			seq := event.Sequence()
+			if seq != 0 && seq <= replayed {
+				continue
+			}
+			if _, subscribed := filter[event.Type()]; !subscribed {
+				if seq != 0 {
+					_ = ack(seq)()
+				}
+				continue
+			}
+			if seq != 0 {
+				event = event.WithAck(ack(seq))
+			}
+			if !send(event) {
+				return
+			}
+		}
+	}()
+	return out, func() { close(done) }
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bus
+
+import (
+	"context"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+)
+
+// topic carries the events of the IDs registered with a payload type.
+type topic interface {
+	// start starts the broker of the topic.
+	start(ctx context.Context) error
+	// forward subscribes to the topic and forwards its events to out until
+	// done is closed, returning the function to unsubscribe.
+	forward(
+		out chan<- Message,
+		done <-chan struct{},
+		wg *sync.WaitGroup,
+		opts ...broker.SubscriptionOption,
+	) (func(), error)
+}
+
+// typedTopic is a topic whose events carry a payload of type T.
+type typedTopic[T any] struct {
+	// broker carries the events of the topic.
+	broker *broker.Broker[*asynctypes.Event[T]]
+	// journal records the durable events of the topic, nil if the topic is
+	// not journaled.
+	journal *eventJournal[T]
+}
+
+// start starts the broker of the topic.
+func (t *typedTopic[T]) start(ctx context.Context) error {
+	return t.broker.Start(ctx)
+}
+
+// forward subscribes to the topic and forwards its events to out.
+func (t *typedTopic[T]) forward(
+	out chan<- Message,
+	done <-chan struct{},
+	wg *sync.WaitGroup,
+	opts ...broker.SubscriptionOption,
+) (func(), error) {
+	ch, err := t.broker.SubscribeWithOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for event := range ch {
+			select {
+			case out <- event:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() { t.broker.Unsubscribe(ch) }, nil
+}
+
+// wildcard is a subscriber to every topic of the bus.
+type wildcard struct {
+	// ch is the channel the events of all the topics are forwarded to.
+	ch chan Message
+	// opts are the options the topics are subscribed to with.
+	opts []broker.SubscriptionOption
+	// done is closed to stop forwarding events.
+	done chan struct{}
+	// wg tracks the goroutines forwarding events to ch.
+	wg sync.WaitGroup
+	// unsubs are the functions unsubscribing from the topics.
+	unsubs []func()
+	// mu guards unsubs.
+	mu sync.Mutex
+}
+
+// newWildcard creates a new wildcard subscriber.
+func newWildcard(opts []broker.SubscriptionOption) *wildcard {
+	return &wildcard{
+		ch:   make(chan Message),
+		opts: opts,
+		done: make(chan struct{}),
+	}
+}
+
+// attach forwards the events of the topic to the subscriber.
+func (w *wildcard) attach(t topic) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	unsubscribe, err := t.forward(w.ch, w.done, &w.wg, w.opts...)
+	if err != nil {
+		return err
+	}
+	w.unsubs = append(w.unsubs, unsubscribe)
+	return nil
+}
+
+// close unsubscribes from all the topics and closes the channel once no
+// event is being forwarded anymore.
+func (w *wildcard) close() {
+	close(w.done)
+	w.mu.Lock()
+	for _, unsubscribe := range w.unsubs {
+		unsubscribe()
+	}
+	w.mu.Unlock()
+	w.wg.Wait()
+	close(w.ch)
+}
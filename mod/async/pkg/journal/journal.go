@@ -0,0 +1,380 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package journal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+)
+
+const (
+	// eventsDir is the directory the journaled events are written to.
+	eventsDir = "events"
+	// consumersDir is the directory the acknowledgements of each consumer
+	// are written to.
+	consumersDir = "consumers"
+	// tmpSuffix is the suffix of the files being written.
+	tmpSuffix = ".tmp"
+	// filePerm is the permission of the files of the journal.
+	filePerm = 0o600
+	// dirPerm is the permission of the directories of the journal.
+	dirPerm = 0o700
+)
+
+// Entry is an event recorded in the journal.
+type Entry struct {
+	// Seq is the sequence number of the event, starting at 1.
+	Seq uint64
+	// EventID is the ID of the event.
+	EventID asynctypes.EventID
+	// Data is the encoded payload of the event.
+	Data []byte
+}
+
+// Journal is an on-disk log of events. An event is appended before it is
+// dispatched and is kept until every registered consumer has acknowledged
+// it, so that the events a consumer has not handled yet can be replayed
+// after a restart.
+//
+// Every event is stored in its own file, named after its sequence number,
+// and every acknowledgement is an empty file in the directory of the
+// consumer.
+type Journal struct {
+	// dir is the root directory of the journal.
+	dir string
+	// next is the sequence number of the next event.
+	next uint64
+	// mu guards the files of the journal.
+	mu sync.Mutex
+}
+
+// New opens the journal in the given directory, creating it if needed.
+func New(dir string) (*Journal, error) {
+	for _, d := range []string{eventsDir, consumersDir} {
+		if err := os.MkdirAll(filepath.Join(dir, d), dirPerm); err != nil {
+			return nil, err
+		}
+	}
+
+	j := &Journal{dir: dir}
+	last, err := j.lastSeq()
+	if err != nil {
+		return nil, err
+	}
+	j.next = last + 1
+	return j, nil
+}
+
+// Append records the event and returns its sequence number.
+func (j *Journal) Append(
+	eventID asynctypes.EventID,
+	data []byte,
+) (uint64, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	bz := make([]byte, 2, 2+len(eventID)+len(data))
+	//#nosec:G115 // event IDs are short constants.
+	binary.BigEndian.PutUint16(bz, uint16(len(eventID)))
+	bz = append(bz, eventID...)
+	bz = append(bz, data...)
+
+	seq := j.next
+	if err := writeFile(j.eventPath(seq), bz); err != nil {
+		return 0, err
+	}
+	j.next++
+	return seq, nil
+}
+
+// Register registers a consumer, whose acknowledgements are then required
+// before an event is removed. A new consumer is only concerned with the
+// events appended after its registration.
+func (j *Journal) Register(consumer string) error {
+	if err := validateConsumer(consumer); err != nil {
+		return err
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	dir := j.consumerDir(consumer)
+	if _, err := os.Stat(dir); err == nil {
+		return nil
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	// Acknowledge the existing events on behalf of the new consumer before
+	// registering it, so that it is never registered with missing
+	// acknowledgements.
+	tmp := dir + tmpSuffix
+	if err := os.RemoveAll(tmp); err != nil {
+		return err
+	}
+	if err := os.Mkdir(tmp, dirPerm); err != nil {
+		return err
+	}
+	seqs, err := j.seqs(filepath.Join(j.dir, eventsDir))
+	if err != nil {
+		return err
+	}
+	for _, seq := range seqs {
+		if err = writeFile(filepath.Join(tmp, name(seq)), nil); err != nil {
+			return err
+		}
+	}
+	return os.Rename(tmp, dir)
+}
+
+// Ack acknowledges the event with the given sequence number on behalf of
+// the consumer. The event is removed once every consumer acknowledged it.
+func (j *Journal) Ack(consumer string, seq uint64) error {
+	if err := validateConsumer(consumer); err != nil {
+		return err
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	dir := j.consumerDir(consumer)
+	if _, err := os.Stat(dir); err != nil {
+		if os.IsNotExist(err) {
+			return ErrUnknownConsumer
+		}
+		return err
+	}
+	if _, err := os.Stat(j.eventPath(seq)); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if err := writeFile(filepath.Join(dir, name(seq)), nil); err != nil {
+		return err
+	}
+	return j.collect(seq)
+}
+
+// Pending returns the events the consumer has not acknowledged yet, in the
+// order they were appended.
+func (j *Journal) Pending(consumer string) ([]Entry, error) {
+	if err := validateConsumer(consumer); err != nil {
+		return nil, err
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	seqs, err := j.seqs(filepath.Join(j.dir, eventsDir))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, 0, len(seqs))
+	for _, seq := range seqs {
+		acked, ackErr := exists(
+			filepath.Join(j.consumerDir(consumer), name(seq)),
+		)
+		if ackErr != nil {
+			return nil, ackErr
+		}
+		if acked {
+			continue
+		}
+
+		entry, readErr := j.read(seq)
+		if readErr != nil {
+			return nil, readErr
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// collect removes the event once every consumer acknowledged it. The
+// acknowledgements are removed first, so that a crash in between replays
+// the event rather than leaving stale acknowledgements behind.
+func (j *Journal) collect(seq uint64) error {
+	consumers, err := os.ReadDir(filepath.Join(j.dir, consumersDir))
+	if err != nil {
+		return err
+	}
+
+	var acks []string
+	for _, c := range consumers {
+		if !c.IsDir() || strings.HasSuffix(c.Name(), tmpSuffix) {
+			continue
+		}
+		ack := filepath.Join(j.consumerDir(c.Name()), name(seq))
+		acked, ackErr := exists(ack)
+		if ackErr != nil {
+			return ackErr
+		}
+		if !acked {
+			return nil
+		}
+		acks = append(acks, ack)
+	}
+
+	for _, ack := range acks {
+		if err = os.Remove(ack); err != nil {
+			return err
+		}
+	}
+	return os.Remove(j.eventPath(seq))
+}
+
+// read reads the event with the given sequence number.
+func (j *Journal) read(seq uint64) (Entry, error) {
+	bz, err := os.ReadFile(j.eventPath(seq))
+	if err != nil {
+		return Entry{}, err
+	}
+	if len(bz) < 2 {
+		return Entry{}, ErrCorruptedEntry
+	}
+	idLen := int(binary.BigEndian.Uint16(bz))
+	if len(bz) < 2+idLen {
+		return Entry{}, ErrCorruptedEntry
+	}
+	return Entry{
+		Seq:     seq,
+		EventID: asynctypes.EventID(bz[2 : 2+idLen]),
+		Data:    bz[2+idLen:],
+	}, nil
+}
+
+// lastSeq returns the highest sequence number found in the journal, so that
+// sequence numbers are never reused while an event or an acknowledgement
+// still refers to them.
+func (j *Journal) lastSeq() (uint64, error) {
+	dirs := []string{filepath.Join(j.dir, eventsDir)}
+	consumers, err := os.ReadDir(filepath.Join(j.dir, consumersDir))
+	if err != nil {
+		return 0, err
+	}
+	for _, c := range consumers {
+		if c.IsDir() {
+			dirs = append(dirs, j.consumerDir(c.Name()))
+		}
+	}
+
+	var last uint64
+	for _, dir := range dirs {
+		seqs, seqsErr := j.seqs(dir)
+		if seqsErr != nil {
+			return 0, seqsErr
+		}
+		if len(seqs) > 0 {
+			last = max(last, seqs[len(seqs)-1])
+		}
+	}
+	return last, nil
+}
+
+// seqs returns the sorted sequence numbers of the files in the directory,
+// ignoring the files being written.
+func (j *Journal) seqs(dir string) ([]uint64, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	seqs := make([]uint64, 0, len(files))
+	for _, f := range files {
+		seq, parseErr := strconv.ParseUint(f.Name(), 10, 64)
+		if parseErr != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(a, b int) bool { return seqs[a] < seqs[b] })
+	return seqs, nil
+}
+
+// eventPath returns the path of the event with the given sequence number.
+func (j *Journal) eventPath(seq uint64) string {
+	return filepath.Join(j.dir, eventsDir, name(seq))
+}
+
+// consumerDir returns the directory of the acknowledgements of the
+// consumer.
+func (j *Journal) consumerDir(consumer string) string {
+	return filepath.Join(j.dir, consumersDir, consumer)
+}
+
+// name returns the file name of the given sequence number, padded so that
+// the files sort in sequence order.
+func name(seq uint64) string {
+	return fmt.Sprintf("%020d", seq)
+}
+
+// validateConsumer checks that the consumer name can be used as a
+// directory name.
+func validateConsumer(consumer string) error {
+	if consumer == "" || consumer == "." || consumer == ".." ||
+		strings.ContainsAny(consumer, `/\`) ||
+		strings.HasSuffix(consumer, tmpSuffix) {
+		return ErrInvalidConsumer
+	}
+	return nil
+}
+
+// exists returns true if the file exists.
+func exists(path string) (bool, error) {
+	_, err := os.Stat(path)
+	switch {
+	case err == nil:
+		return true, nil
+	case os.IsNotExist(err):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// writeFile durably writes the file by syncing a temporary file and renaming
+// it, so that a crash never leaves a partially written file behind.
+func writeFile(path string, bz []byte) error {
+	tmp := path + tmpSuffix
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, filePerm)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(bz); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
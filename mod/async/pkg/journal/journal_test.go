@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package journal_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/journal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournal_AppendAckPending(t *testing.T) {
+	j, err := journal.New(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, j.Register("pruner"))
+	require.NoError(t, j.Register("deposits"))
+
+	seq1, err := j.Append("finalized", []byte{0x01})
+	require.NoError(t, err)
+	seq2, err := j.Append("finalized", []byte{0x02})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), seq1)
+	require.Equal(t, uint64(2), seq2)
+
+	require.NoError(t, j.Ack("pruner", seq1))
+	pending, err := j.Pending("pruner")
+	require.NoError(t, err)
+	require.Equal(t, []journal.Entry{
+		{Seq: seq2, EventID: "finalized", Data: []byte{0x02}},
+	}, pending)
+
+	// The event is kept until every consumer acknowledged it.
+	pending, err = j.Pending("deposits")
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.NoError(t, j.Ack("deposits", seq1))
+	pending, err = j.Pending("deposits")
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, seq2, pending[0].Seq)
+}
+
+func TestJournal_Reopen(t *testing.T) {
+	dir := t.TempDir()
+	j, err := journal.New(dir)
+	require.NoError(t, err)
+	require.NoError(t, j.Register("pruner"))
+	_, err = j.Append("finalized", []byte{0x01})
+	require.NoError(t, err)
+	_, err = j.Append("finalized", []byte{0x02})
+	require.NoError(t, err)
+	require.NoError(t, j.Ack("pruner", 1))
+
+	// The unacknowledged events survive a restart and their sequence numbers
+	// are not reused.
+	j, err = journal.New(dir)
+	require.NoError(t, err)
+	pending, err := j.Pending("pruner")
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, uint64(2), pending[0].Seq)
+	seq, err := j.Append("finalized", []byte{0x03})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), seq)
+}
+
+func TestJournal_NewConsumer(t *testing.T) {
+	j, err := journal.New(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, j.Register("pruner"))
+	_, err = j.Append("finalized", []byte{0x01})
+	require.NoError(t, err)
+
+	// A new consumer is not concerned with the existing events.
+	require.NoError(t, j.Register("deposits"))
+	pending, err := j.Pending("deposits")
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	require.ErrorIs(t, j.Ack("unknown", 1), journal.ErrUnknownConsumer)
+	require.ErrorIs(t, j.Register("../escape"), journal.ErrInvalidConsumer)
+}
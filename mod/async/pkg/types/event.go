@@ -38,6 +38,12 @@ type Event[DataT any] struct {
 	data DataT
 	// error is the error associated with the event.
 	err error
+	// seq is the sequence number of the event in the journal, zero if the
+	// event is not journaled.
+	seq uint64
+	// ack acknowledges the event to the journal, nil if the event does not
+	// need to be acknowledged.
+	ack func() error
 }
 
 // NewEvent creates a new Event with the given context and beacon event.
@@ -78,3 +84,30 @@ func (e Event[DataT]) Error() error {
 func (e Event[DataT]) Is(eventType EventID) bool {
 	return e.eventType == eventType
 }
+
+// Sequence returns the sequence number of the event in the journal, or zero
+// if the event is not journaled.
+func (e Event[DataT]) Sequence() uint64 {
+	return e.seq
+}
+
+// WithSequence returns a copy of the event with the given sequence number.
+func (e Event[DataT]) WithSequence(seq uint64) *Event[DataT] {
+	e.seq = seq
+	return &e
+}
+
+// WithAck returns a copy of the event acknowledged by the given function.
+func (e Event[DataT]) WithAck(ack func() error) *Event[DataT] {
+	e.ack = ack
+	return &e
+}
+
+// Ack acknowledges that the event has been handled, so that it is not
+// replayed on restart. It is a no-op for events that are not journaled.
+func (e Event[DataT]) Ack() error {
+	if e.ack == nil {
+		return nil
+	}
+	return e.ack()
+}
@@ -32,23 +32,36 @@ import (
 // ProcessGenesisData processes the genesis state and initializes the beacon
 // state.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, GenesisT, _, _,
+	_, _, _, _, _, _, _, _, _, _, GenesisT, _, _,
 ]) ProcessGenesisData(
 	ctx context.Context,
 	genesisData GenesisT,
 ) (transition.ValidatorUpdates, error) {
-	return s.sp.InitializePreminedBeaconStateFromEth1(
+	valUpdates, err := s.sp.InitializePreminedBeaconStateFromEth1(
 		s.sb.StateFromContext(ctx),
 		genesisData.GetDeposits(),
 		genesisData.GetExecutionPayloadHeader(),
 		genesisData.GetForkVersion(),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The genesis deposits are the first leaves of the deposit tree, so
+	// they are stored alongside the contract deposits to allow proposers
+	// to rebuild the tree when voting on eth1 data.
+	if err = s.sb.DepositStore(ctx).EnqueueDeposits(
+		genesisData.GetDeposits(),
+	); err != nil {
+		return nil, err
+	}
+	return valUpdates, nil
 }
 
 // ProcessBeaconBlock receives an incoming beacon block, it first validates
 // and then processes the block.
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _,
 ]) ProcessBeaconBlock(
 	ctx context.Context,
 	blk BeaconBlockT,
@@ -98,7 +111,7 @@ func (s *Service[
 
 // executeStateTransition runs the stf.
 func (s *Service[
-	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _,
 ]) executeStateTransition(
 	ctx context.Context,
 	st BeaconStateT,
@@ -42,6 +42,7 @@ type Service[
 	],
 	BlobSidecarsT BlobSidecars,
 	DepositT any,
+	DepositStoreT DepositStore[DepositT],
 	ExecutionPayloadT ExecutionPayload,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	GenesisT Genesis[DepositT, ExecutionPayloadHeaderT],
@@ -59,6 +60,7 @@ type Service[
 		BeaconBlockBodyT,
 		BeaconStateT,
 		BlobSidecarsT,
+		DepositStoreT,
 	]
 	// logger is used for logging messages in the service.
 	logger log.Logger[any]
@@ -91,6 +93,8 @@ type Service[
 	optimisticPayloadBuilds bool
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
+	// wg waits for the goroutine of the service to exit.
+	wg sync.WaitGroup
 }
 
 // NewService creates a new validator service.
@@ -105,6 +109,7 @@ func NewService[
 	],
 	BlobSidecarsT BlobSidecars,
 	DepositT any,
+	DepositStoreT DepositStore[DepositT],
 	ExecutionPayloadT ExecutionPayload,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	GenesisT Genesis[DepositT, ExecutionPayloadHeaderT],
@@ -120,6 +125,7 @@ func NewService[
 		BeaconBlockBodyT,
 		BeaconStateT,
 		BlobSidecarsT,
+		DepositStoreT,
 	],
 	logger log.Logger[any],
 	cs common.ChainSpec,
@@ -141,13 +147,14 @@ func NewService[
 	optimisticPayloadBuilds bool,
 ) *Service[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, BlobSidecarsT, DepositT, ExecutionPayloadT,
+	BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT, ExecutionPayloadT,
 	ExecutionPayloadHeaderT, GenesisT, PayloadAttributesT, WithdrawalT,
 ] {
 	return &Service[
 		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-		BeaconStateT, BlobSidecarsT, DepositT, ExecutionPayloadT,
-		ExecutionPayloadHeaderT, GenesisT, PayloadAttributesT, WithdrawalT,
+		BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT, GenesisT,
+		PayloadAttributesT, WithdrawalT,
 	]{
 		sb:                      sb,
 		logger:                  logger,
@@ -166,13 +173,13 @@ func NewService[
 
 // Name returns the name of the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Name() string {
 	return "blockchain"
 }
 
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Start(ctx context.Context) error {
 	subBlkCh, err := s.blkBroker.Subscribe()
 	if err != nil {
@@ -182,12 +189,31 @@ func (s *Service[
 	if err != nil {
 		return err
 	}
-	go s.start(ctx, subBlkCh, subGenCh)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.start(ctx, subBlkCh, subGenCh)
+	}()
 	return nil
 }
 
+// Stop waits for the service to finish handling its current event.
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, GenesisT, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) Stop(context.Context) error {
+	s.wg.Wait()
+	return nil
+}
+
+// Status returns the status of the service.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) Status() error {
+	return nil
+}
+
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, GenesisT, _, _,
 ]) start(
 	ctx context.Context,
 	subBlkCh chan *asynctypes.Event[BeaconBlockT],
@@ -213,7 +239,7 @@ func (s *Service[
 }
 
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, GenesisT, _, _,
+	_, _, _, _, _, _, _, _, _, _, GenesisT, _, _,
 ]) handleProcessGenesisDataRequest(msg *asynctypes.Event[GenesisT]) {
 	if msg.Error() != nil {
 		s.logger.Error("Error processing genesis data", "error", msg.Error())
@@ -245,7 +271,7 @@ func (s *Service[
 }
 
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _,
 ]) handleBeaconBlockReceived(
 	msg *asynctypes.Event[BeaconBlockT],
 ) {
@@ -270,7 +296,7 @@ func (s *Service[
 }
 
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _,
 ]) handleBeaconBlockFinalization(
 	msg *asynctypes.Event[BeaconBlockT],
 ) {
@@ -93,6 +93,12 @@ type ExecutionEngine[PayloadAttributesT any] interface {
 	) (*engineprimitives.PayloadID, *common.ExecutionHash, error)
 }
 
+// DepositStore defines the interface for deposit storage.
+type DepositStore[DepositT any] interface {
+	// EnqueueDeposits adds a list of deposits to the deposit store.
+	EnqueueDeposits(deposits []DepositT) error
+}
+
 // EventFeed is a generic interface for sending events.
 type EventFeed[EventT any] interface {
 	// Send sends an event and returns the number of
@@ -212,10 +218,13 @@ type StorageBackend[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
 	BeaconBlockBodyT,
 	BeaconStateT,
-	BlobSidecarsT any,
+	BlobSidecarsT,
+	DepositStoreT any,
 ] interface {
 	// AvailabilityStore returns the availability store for the given context.
 	AvailabilityStore(context.Context) AvailabilityStoreT
+	// DepositStore retrieves the deposit store for the given context.
+	DepositStore(context.Context) DepositStoreT
 	// StateFromContext retrieves the beacon state from the given context.
 	StateFromContext(context.Context) BeaconStateT
 }
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// VoluntaryExit is the interface of a voluntary exit held by the pool.
+type VoluntaryExit interface {
+	// GetValidatorIndex returns the index of the exiting validator.
+	GetValidatorIndex() math.ValidatorIndex
+}
+
+// ProposerSlashing is the interface of a proposer slashing held by the pool.
+type ProposerSlashing interface {
+	// GetProposerIndex returns the index of the proposer being slashed.
+	GetProposerIndex() math.ValidatorIndex
+}
+
+// OperationPool holds the voluntary exits and proposer slashings waiting to
+// be included in a block. It holds at most one operation of each kind per
+// validator, as any further one would be invalid once the first is included.
+type OperationPool[
+	VoluntaryExitT VoluntaryExit,
+	ProposerSlashingT ProposerSlashing,
+] struct {
+	// mu protects the operations below.
+	mu sync.RWMutex
+	// voluntaryExits maps validator indices to their voluntary exit.
+	voluntaryExits map[math.ValidatorIndex]VoluntaryExitT
+	// proposerSlashings maps proposer indices to their slashing.
+	proposerSlashings map[math.ValidatorIndex]ProposerSlashingT
+}
+
+// NewOperationPool creates a new, empty operation pool.
+func NewOperationPool[
+	VoluntaryExitT VoluntaryExit,
+	ProposerSlashingT ProposerSlashing,
+]() *OperationPool[VoluntaryExitT, ProposerSlashingT] {
+	return &OperationPool[VoluntaryExitT, ProposerSlashingT]{
+		voluntaryExits: make(map[math.ValidatorIndex]VoluntaryExitT),
+		proposerSlashings: make(
+			map[math.ValidatorIndex]ProposerSlashingT,
+		),
+	}
+}
+
+// AddVoluntaryExit adds the voluntary exit to the pool, replacing any exit
+// of the same validator.
+func (p *OperationPool[VoluntaryExitT, _]) AddVoluntaryExit(
+	exit VoluntaryExitT,
+) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.voluntaryExits[exit.GetValidatorIndex()] = exit
+}
+
+// VoluntaryExits returns the voluntary exits of the pool, sorted by
+// validator index.
+func (p *OperationPool[VoluntaryExitT, _]) VoluntaryExits() []VoluntaryExitT {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return sortedValues(p.voluntaryExits)
+}
+
+// RemoveVoluntaryExit removes the voluntary exit of the validator at the
+// given index from the pool.
+func (p *OperationPool[_, _]) RemoveVoluntaryExit(idx math.ValidatorIndex) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.voluntaryExits, idx)
+}
+
+// AddProposerSlashing adds the proposer slashing to the pool, replacing any
+// slashing of the same proposer.
+func (p *OperationPool[_, ProposerSlashingT]) AddProposerSlashing(
+	slashing ProposerSlashingT,
+) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.proposerSlashings[slashing.GetProposerIndex()] = slashing
+}
+
+// ProposerSlashings returns the proposer slashings of the pool, sorted by
+// proposer index.
+func (p *OperationPool[
+	_, ProposerSlashingT,
+]) ProposerSlashings() []ProposerSlashingT {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return sortedValues(p.proposerSlashings)
+}
+
+// RemoveProposerSlashing removes the slashing of the proposer at the given
+// index from the pool.
+func (p *OperationPool[_, _]) RemoveProposerSlashing(
+	idx math.ValidatorIndex,
+) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.proposerSlashings, idx)
+}
+
+// sortedValues returns the values of the map, sorted by key.
+func sortedValues[T any](m map[math.ValidatorIndex]T) []T {
+	keys := make([]math.ValidatorIndex, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	values := make([]T, len(keys))
+	for i, k := range keys {
+		values[i] = m[k]
+	}
+	return values
+}
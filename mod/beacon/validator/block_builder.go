@@ -22,12 +22,15 @@ package validator
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
@@ -37,7 +40,7 @@ import (
 
 // buildBlockAndSidecars builds a new beacon block.
 func (s *Service[
-	BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _,
+	BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, _, _,
 ]) buildBlockAndSidecars(
 	ctx context.Context,
 	requestedSlot math.Slot,
@@ -92,8 +95,10 @@ func (s *Service[
 	}
 
 	// We have to assemble the block body prior to producing the sidecars
-	// since we need to generate the inclusion proofs.
-	if err = s.buildBlockBody(ctx, st, blk, reveal, envelope); err != nil {
+	// since we need to generate the inclusion proofs. The block may end up
+	// with the payload of an external builder in place of the local one.
+	envelope, err = s.buildBlockBody(ctx, st, blk, reveal, envelope)
+	if err != nil {
 		return blk, sidecars, err
 	}
 
@@ -132,7 +137,7 @@ func (s *Service[
 
 // getEmptyBeaconBlockForSlot creates a new empty block.
 func (s *Service[
-	BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _,
+	BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
 ]) getEmptyBeaconBlockForSlot(
 	st BeaconStateT, requestedSlot math.Slot,
 ) (BeaconBlockT, error) {
@@ -170,7 +175,7 @@ func (s *Service[
 
 // buildRandaoReveal builds a randao reveal for the given slot.
 func (s *Service[
-	_, _, BeaconStateT, _, _, _, _, _, _, ForkDataT,
+	_, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
 ]) buildRandaoReveal(
 	st BeaconStateT,
 	slot math.Slot,
@@ -203,13 +208,10 @@ func (s *Service[
 // retrieveExecutionPayload retrieves the execution payload for the block.
 func (s *Service[
 	BeaconBlockT, _, BeaconStateT, _, _, _, _,
-	ExecutionPayloadT, ExecutionPayloadHeaderT, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _,
 ]) retrieveExecutionPayload(
 	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
-	//
-	// TODO: Add external block builders to this flow.
-	//
 	// Get the payload for the block.
 	envelope, err := s.localPayloadBuilder.
 		RetrievePayload(
@@ -257,70 +259,464 @@ func (s *Service[
 	return envelope, nil
 }
 
-// BuildBlockBody assembles the block body with necessary components.
+// buildBlockBody assembles the block body with necessary components. It
+// returns the envelope of the execution payload the block ends up with,
+// which is the one of the relay bid whenever it pays more than the local
+// payload.
 func (s *Service[
 	BeaconBlockT, _, BeaconStateT, _,
-	_, _, Eth1DataT, ExecutionPayloadT, _, _,
+	_, _, _, ExecutionPayloadT, _, _, _, _,
 ]) buildBlockBody(
 	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
 	reveal crypto.BLSSignature,
-	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
-) error {
+	local engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	// Assemble a new block with the payload.
 	body := blk.GetBody()
 	if body.IsNil() {
-		return ErrNilBlkBody
+		return nil, ErrNilBlkBody
 	}
 
 	// Set the reveal on the block body.
 	body.SetRandaoReveal(reveal)
 
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return nil, ErrNilDepositIndexStart
+	}
+
+	// Build the eth1 data vote of the block, along with the eth1 data that
+	// will be in effect once the vote has been processed.
+	depositStore := s.bsb.DepositStore(ctx)
+	vote, eth1Data, err := s.buildEth1Data(st, depositStore)
+	if err != nil {
+		return nil, err
+	}
+
+	// The block must include every pending deposit committed to by the eth1
+	// data, up to the maximum number of deposits per block.
+	depositCount := min(
+		s.chainSpec.MaxDepositsPerBlock(),
+		uint64(eth1Data.GetDepositCount())-depositIndex,
+	)
+
+	// Dequeue deposits from the state.
+	deposits, err := depositStore.GetDepositsByIndex(
+		depositIndex,
+		depositCount,
+	)
+	if err != nil {
+		return nil, err
+	} else if uint64(len(deposits)) != depositCount {
+		return nil, errors.Wrapf(
+			ErrMissingDeposits, "expected: %d, got: %d",
+			depositCount, len(deposits),
+		)
+	}
+
+	// Prove each deposit against the deposit root of the eth1 data.
+	if err = proveDeposits(
+		depositStore,
+		deposits,
+		depositIndex,
+		uint64(eth1Data.GetDepositCount()),
+	); err != nil {
+		return nil, err
+	}
+
+	// Set the deposits and the eth1 data vote on the block body.
+	body.SetDeposits(deposits)
+	body.SetEth1Data(vote)
+
+	// Include the pooled operations that are valid on top of the state.
+	proposerSlashings, voluntaryExits := s.buildOperations(st)
+	body.SetProposerSlashings(proposerSlashings)
+	body.SetVoluntaryExits(voluntaryExits)
+
+	// Set the graffiti on the block body.
+	body.SetGraffiti(bytes.ToBytes32([]byte(s.cfg.Graffiti)))
+
+	// The rest of the body is final, so the block can be signed blinded
+	// if the relay outbids the local payload.
+	envelope := s.retrieveBuilderPayload(ctx, st, blk, local)
+
 	// If we get returned a nil blobs bundle, we should return an error.
 	blobsBundle := envelope.GetBlobsBundle()
 	if blobsBundle == nil {
-		return ErrNilBlobsBundle
+		return nil, ErrNilBlobsBundle
 	}
 
 	// Set the KZG commitments on the block body.
 	body.SetBlobKzgCommitments(blobsBundle.GetCommitments())
 
-	depositIndex, err := st.GetEth1DepositIndex()
+	return envelope, body.SetExecutionData(envelope.GetExecutionPayload())
+}
+
+// buildOperations returns the proposer slashings and voluntary exits of the
+// operation pool that can be included in a block on top of the given state,
+// up to the maximum number per block. Operations that are no longer valid,
+// e.g. because they were included in an earlier block, are removed from the
+// pool.
+func (s *Service[
+	_, _, BeaconStateT, _, _, _, _, _, _, _, ProposerSlashingT, VoluntaryExitT,
+]) buildOperations(
+	st BeaconStateT,
+) ([]ProposerSlashingT, []VoluntaryExitT) {
+	var (
+		proposerSlashings = make([]ProposerSlashingT, 0)
+		voluntaryExits    = make([]VoluntaryExitT, 0)
+		slashed           = make(map[math.ValidatorIndex]struct{})
+	)
+
+	for _, ps := range s.operationPool.ProposerSlashings() {
+		if uint64(len(proposerSlashings)) ==
+			constants.MaxProposerSlashingsPerBlock {
+			break
+		}
+		idx := ps.GetProposerIndex()
+		if err := s.stateProcessor.ValidateProposerSlashing(
+			st, ps,
+		); err != nil {
+			s.logger.Debug(
+				"dropping invalid proposer slashing from the pool",
+				"proposer_index", idx.Base10(), "error", err,
+			)
+			s.operationPool.RemoveProposerSlashing(idx)
+			continue
+		}
+		proposerSlashings = append(proposerSlashings, ps)
+		slashed[idx] = struct{}{}
+	}
+
+	for _, exit := range s.operationPool.VoluntaryExits() {
+		if uint64(len(voluntaryExits)) ==
+			constants.MaxVoluntaryExitsPerBlock {
+			break
+		}
+		idx := exit.GetValidatorIndex()
+		// Slashing a validator initiates its exit, so its voluntary exit
+		// would be rejected by the block.
+		if _, ok := slashed[idx]; ok {
+			continue
+		}
+		if err := s.stateProcessor.ValidateVoluntaryExit(
+			st, exit,
+		); err != nil {
+			s.logger.Debug(
+				"dropping invalid voluntary exit from the pool",
+				"validator_index", idx.Base10(), "error", err,
+			)
+			s.operationPool.RemoveVoluntaryExit(idx)
+			continue
+		}
+		voluntaryExits = append(voluntaryExits, exit)
+	}
+
+	return proposerSlashings, voluntaryExits
+}
+
+// retrieveBuilderPayload requests a bid from the relay and, if it pays more
+// than the local payload, unblinds the payload of the bid by signing the
+// blinded block. It falls back to the local payload whenever the relay does
+// not deliver.
+func (s *Service[
+	BeaconBlockT, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _,
+]) retrieveBuilderPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	local engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+) engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT] {
+	// The payload extends the execution block of the parent beacon block.
+	lph, err := st.GetLatestExecutionPayloadHeader()
 	if err != nil {
-		return ErrNilDepositIndexStart
+		return local
 	}
 
-	// Dequeue deposits from the state.
-	deposits, err := s.bsb.DepositStore(ctx).GetDepositsByIndex(
-		depositIndex,
-		s.chainSpec.MaxDepositsPerBlock(),
+	bid := s.localPayloadBuilder.RequestBuilderBid(
+		ctx, blk.GetSlot(), lph.GetBlockHash(), s.signer.PublicKey(), local,
 	)
+	if bid == nil {
+		return local
+	}
+
+	envelope, err := s.unblindBuilderPayload(ctx, st, blk, bid)
 	if err != nil {
-		return err
+		s.logger.Warn(
+			"Failed to unblind payload from relay, falling back to local payload",
+			"slot", blk.GetSlot().Base10(),
+			"err", err,
+		)
+		return local
 	}
+	return envelope
+}
 
-	// Set the deposits on the block body.
-	body.SetDeposits(deposits)
+// unblindBuilderPayload signs the block blinded with the execution payload
+// header of the bid and submits it to the relay, which reveals the payload.
+//
+// NOTE: The state root of the block is left empty in the signed blinded
+// block, since it is only known once the revealed payload is processed.
+func (s *Service[
+	BeaconBlockT, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, ForkDataT, _, _,
+]) unblindBuilderPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	bid *relay.SignedBuilderBid[ExecutionPayloadHeaderT],
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	var forkData ForkDataT
 
-	var eth1Data Eth1DataT
-	// TODO: assemble real eth1data.
-	body.SetEth1Data(eth1Data.New(
-		common.Bytes32{},
-		0,
-		common.ZeroHash,
-	))
+	// The blinded block commits to the blobs of the bid.
+	blk.GetBody().SetBlobKzgCommitments(bid.Message.BlobKZGCommitments)
 
-	// Set the graffiti on the block body.
-	body.SetGraffiti(bytes.ToBytes32([]byte(s.cfg.Graffiti)))
+	headerRoot, err := bid.Message.Header.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	blindedRoot, err := blk.BlindedHashTreeRoot(headerRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return nil, err
+	}
+	signingRoot, err := forkData.New(
+		version.FromUint32[common.Version](
+			s.chainSpec.ActiveForkVersionForSlot(blk.GetSlot()),
+		), genesisValidatorsRoot,
+	).ComputeSigningRoot(s.chainSpec.DomainTypeProposer(), blindedRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	// A later round at the same height may pick a different bid, so refuse
+	// to sign a second blinded block for the slot.
+	if err = s.proposals.checkAndRecord(
+		blk.GetSlot(), blindedRoot,
+	); err != nil {
+		return nil, err
+	}
+	signature, err := s.signer.Sign(signingRoot[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return s.localPayloadBuilder.UnblindPayload(
+		ctx,
+		blk.GetSlot(),
+		&relay.SignedBlindedBeaconBlock[BeaconBlockT, ExecutionPayloadHeaderT]{
+			Message:                blk,
+			ExecutionPayloadHeader: bid.Message.Header,
+			Signature:              signature,
+		},
+		bid,
+	)
+}
+
+// buildEth1Data returns the eth1 data vote of the block being built, as well
+// as the eth1 data of the state once the vote has been processed. As in the
+// specification, the vote joins the most common valid vote of the voting
+// period, falling back to the eth1 data of the voting period's candidate
+// block, so that every honest proposer of the period votes alike.
+func (s *Service[
+	_, _, BeaconStateT, _, _, DepositStoreT, Eth1DataT, _, _, _, _, _,
+]) buildEth1Data(
+	st BeaconStateT,
+	depositStore DepositStoreT,
+) (Eth1DataT, Eth1DataT, error) {
+	var vote Eth1DataT
+	stateEth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return vote, vote, err
+	}
+
+	depositCount := uint64(stateEth1Data.GetDepositCount())
+	treeCount := depositStore.GetDepositCount()
+	if treeCount < depositCount {
+		return vote, vote, errors.Wrapf(
+			ErrMissingDeposits, "expected: %d deposits, got: %d",
+			depositCount, treeCount,
+		)
+	}
+
+	candidate, err := s.eth1CandidateBlock(st)
+	if err != nil {
+		return vote, vote, err
+	}
+	votes, err := st.GetEth1DataVotes()
+	if err != nil {
+		return vote, vote, err
+	}
 
-	return body.SetExecutionData(envelope.GetExecutionPayload())
+	// Join the most common valid vote, the earliest one on ties. Votes are
+	// tallied under the index of their first occurrence.
+	best := -1
+	tallies := make([]int, len(votes))
+	for i, v := range votes {
+		var valid bool
+		valid, err = s.isValidEth1Vote(
+			v, stateEth1Data, candidate, depositStore,
+		)
+		if err != nil {
+			return vote, vote, err
+		}
+		if !valid {
+			continue
+		}
+		first := slices.IndexFunc(votes[:i+1], v.Equals)
+		tallies[first]++
+		if best < 0 || tallies[first] > tallies[best] ||
+			(tallies[first] == tallies[best] && first < best) {
+			best = first
+		}
+	}
+	if best >= 0 {
+		vote = votes[best]
+	} else {
+		vote, err = s.candidateEth1Data(
+			stateEth1Data, candidate, depositStore,
+		)
+		if err != nil {
+			return vote, vote, err
+		}
+	}
+
+	// The vote takes effect once it is cast by a strict majority of the
+	// voting period, counting the vote of this block.
+	count := uint64(1)
+	for _, v := range votes {
+		if v.Equals(vote) {
+			count++
+		}
+	}
+	if count*2 > s.chainSpec.EpochsPerEth1VotingPeriod()*
+		s.chainSpec.SlotsPerEpoch() {
+		return vote, vote, nil
+	}
+	return vote, stateEth1Data, nil
+}
+
+// eth1CandidateBlock returns the number of the execution block the current
+// voting period votes on, eth1FollowDistance blocks behind the execution
+// block the period started at. Since every beacon block carries one
+// execution payload, the latter is the number of the latest execution
+// payload minus the slots elapsed in the period.
+func (s *Service[
+	_, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+]) eth1CandidateBlock(st BeaconStateT) (uint64, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return 0, err
+	}
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return 0, err
+	}
+
+	slotsPerPeriod := s.chainSpec.EpochsPerEth1VotingPeriod() *
+		s.chainSpec.SlotsPerEpoch()
+	elapsed := slot.Unwrap() % slotsPerPeriod
+	lag := elapsed + s.chainSpec.Eth1FollowDistance()
+	if header.GetNumber().Unwrap() < lag {
+		return 0, nil
+	}
+	return header.GetNumber().Unwrap() - lag, nil
+}
+
+// candidateEth1Data returns the eth1 data of the latest synced execution
+// block at or before the candidate block, or the eth1 data of the state if
+// that block does not hold more deposits.
+func (s *Service[
+	_, _, _, _, _, DepositStoreT, Eth1DataT, _, _, _, _, _,
+]) candidateEth1Data(
+	stateEth1Data Eth1DataT,
+	candidate uint64,
+	depositStore DepositStoreT,
+) (Eth1DataT, error) {
+	blockHash, depositCount, found, err := depositStore.GetEth1Block(
+		candidate,
+	)
+	if err != nil || !found ||
+		depositCount <= uint64(stateEth1Data.GetDepositCount()) {
+		return stateEth1Data, err
+	}
+	depositRoot, err := depositStore.GetDepositRoot(depositCount)
+	if err != nil {
+		return stateEth1Data, err
+	}
+	return stateEth1Data.New(
+		depositRoot, math.U64(depositCount), blockHash,
+	), nil
+}
+
+// isValidEth1Vote returns whether the vote refers to a synced execution block
+// no later than the candidate block, holding no fewer deposits than the eth1
+// data of the state, with the deposit count and root of that block.
+func (s *Service[
+	_, _, _, _, _, DepositStoreT, Eth1DataT, _, _, _, _, _,
+]) isValidEth1Vote(
+	vote Eth1DataT,
+	stateEth1Data Eth1DataT,
+	candidate uint64,
+	depositStore DepositStoreT,
+) (bool, error) {
+	if vote.GetDepositCount() < stateEth1Data.GetDepositCount() {
+		return false, nil
+	}
+	blockNum, found, err := depositStore.GetEth1BlockNumber(
+		vote.GetBlockHash(),
+	)
+	if err != nil || !found || blockNum > candidate {
+		return false, err
+	}
+	blockHash, depositCount, found, err := depositStore.GetEth1Block(blockNum)
+	if err != nil || !found || blockHash != vote.GetBlockHash() ||
+		depositCount != uint64(vote.GetDepositCount()) {
+		return false, err
+	}
+	depositRoot, err := depositStore.GetDepositRoot(depositCount)
+	if err != nil {
+		return false, err
+	}
+	return depositRoot == vote.GetDepositRoot(), nil
+}
+
+// proveDeposits attaches to each deposit, starting at the given deposit
+// index, its Merkle proof against the deposit root of the deposit tree
+// holding the given number of deposits.
+func proveDeposits[
+	DepositT Deposit,
+	DepositStoreT DepositStore[DepositT],
+](
+	depositStore DepositStoreT,
+	deposits []DepositT,
+	depositIndex uint64,
+	depositCount uint64,
+) error {
+	for i, deposit := range deposits {
+		//#nosec:G701 // i is bounded by the number of deposits.
+		proof, err := depositStore.GetDepositProof(
+			depositIndex+uint64(i), depositCount,
+		)
+		if err != nil {
+			return err
+		}
+		deposit.SetProof(proof)
+	}
+	return nil
 }
 
 // computeAndSetStateRoot computes the state root of an outgoing block
 // and sets it in the block.
 func (s *Service[
-	BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _,
+	BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
 ]) computeAndSetStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
@@ -341,7 +737,7 @@ func (s *Service[
 
 // computeStateRoot computes the state root of an outgoing block.
 func (s *Service[
-	BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _,
+	BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
 ]) computeStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
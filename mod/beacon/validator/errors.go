@@ -46,7 +46,15 @@ var (
 	// ErrNilBlobsBundle is an error for when the blobs bundle is nil.
 	ErrNilBlobsBundle = errors.New("nil blobs bundle")
 
+	// ErrMissingDeposits is an error for when the deposit store does not
+	// hold all the deposits that must be included in a block.
+	ErrMissingDeposits = errors.New("missing deposits")
+
 	// ErrNilDepositIndexStart is an error for when the deposit index start is
 	// nil.
 	ErrNilDepositIndexStart = errors.New("nil deposit index start")
+
+	// ErrSlashableProposal is an error for when signing a proposal could get
+	// the node slashed.
+	ErrSlashableProposal = errors.New("slashable proposal")
 )
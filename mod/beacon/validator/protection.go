@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// lastProposalKey is the key of the last signed proposal in the slashing
+// protection database.
+var lastProposalKey = []byte("last_proposal")
+
+// proposalRecordLength is the length of an encoded proposal record, i.e. the
+// slot followed by the blinded block root.
+const proposalRecordLength = 8 + 32
+
+// proposalProtection guards the node against signing two different blocks for
+// the same slot, which is exactly the evidence of a proposer slashing. The
+// last signed proposal is persisted so that the protection survives restarts.
+type proposalProtection struct {
+	// mu serializes the check and the record of a proposal.
+	mu sync.Mutex
+	// db persists the last signed proposal.
+	db SlashingProtectionDB
+}
+
+// newProposalProtection creates a new proposal protection backed by the given
+// database.
+func newProposalProtection(db SlashingProtectionDB) *proposalProtection {
+	return &proposalProtection{db: db}
+}
+
+// checkAndRecord records the proposal of the given root at the given slot,
+// returning ErrSlashableProposal if signing it could get the node slashed.
+// Signing the same root again for the same slot is safe, which allows a block
+// to be proposed again in a later round at the same height.
+//
+// NOTE: The proposal is recorded before it is signed, so that a crash in
+// between can never let the node sign a conflicting proposal afterwards.
+func (p *proposalProtection) checkAndRecord(
+	slot math.Slot,
+	root common.Root,
+) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ok, err := p.db.Has(lastProposalKey)
+	if err != nil {
+		return err
+	}
+	if ok {
+		var bz []byte
+		if bz, err = p.db.Get(lastProposalKey); err != nil {
+			return err
+		}
+		if len(bz) != proposalRecordLength {
+			return errors.Wrapf(
+				ErrSlashableProposal,
+				"corrupted proposal record of length %d", len(bz),
+			)
+		}
+
+		lastSlot := math.Slot(binary.LittleEndian.Uint64(bz[:8]))
+		switch {
+		case slot < lastSlot:
+			return errors.Wrapf(
+				ErrSlashableProposal,
+				"slot %d is older than the last signed slot %d",
+				slot, lastSlot,
+			)
+		case slot == lastSlot && common.Root(bz[8:]) != root:
+			return errors.Wrapf(
+				ErrSlashableProposal,
+				"slot %d was already signed with root %s",
+				slot, common.Root(bz[8:]),
+			)
+		case slot == lastSlot:
+			return nil
+		}
+	}
+
+	bz := make([]byte, proposalRecordLength)
+	binary.LittleEndian.PutUint64(bz[:8], slot.Unwrap())
+	copy(bz[8:], root[:])
+	return p.db.Set(lastProposalKey, bz)
+}
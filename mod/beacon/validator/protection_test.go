@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+// memDB is an in-memory slashing protection database.
+type memDB map[string][]byte
+
+func (db memDB) Get(key []byte) ([]byte, error) {
+	bz, ok := db[string(key)]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return bz, nil
+}
+
+func (db memDB) Has(key []byte) (bool, error) {
+	_, ok := db[string(key)]
+	return ok, nil
+}
+
+func (db memDB) Set(key []byte, value []byte) error {
+	db[string(key)] = value
+	return nil
+}
+
+func TestProposalProtection_ReProposal(t *testing.T) {
+	db := make(memDB)
+	p := newProposalProtection(db)
+	first, second := common.Root{0x01}, common.Root{0x02}
+
+	require.NoError(t, p.checkAndRecord(10, first))
+
+	// A later round at the same height may propose the same block again.
+	require.NoError(t, p.checkAndRecord(10, first))
+
+	// But it must not sign a different block for the slot.
+	err := p.checkAndRecord(10, second)
+	require.ErrorIs(t, err, ErrSlashableProposal)
+
+	// The record survives a restart.
+	p = newProposalProtection(db)
+	err = p.checkAndRecord(10, second)
+	require.ErrorIs(t, err, ErrSlashableProposal)
+	require.NoError(t, p.checkAndRecord(10, first))
+}
+
+func TestProposalProtection_Slots(t *testing.T) {
+	p := newProposalProtection(make(memDB))
+
+	require.NoError(t, p.checkAndRecord(10, common.Root{0x01}))
+	require.NoError(t, p.checkAndRecord(11, common.Root{0x02}))
+
+	err := p.checkAndRecord(10, common.Root{0x01})
+	require.ErrorIs(t, err, ErrSlashableProposal)
+}
@@ -22,6 +22,7 @@ package validator
 
 import (
 	"context"
+	"sync"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -36,18 +37,22 @@ import (
 type Service[
 	BeaconBlockT BeaconBlock[
 		BeaconBlockT, BeaconBlockBodyT, DepositT, Eth1DataT, ExecutionPayloadT,
+		ProposerSlashingT, VoluntaryExitT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		DepositT, Eth1DataT, ExecutionPayloadT,
+		DepositT, Eth1DataT, ExecutionPayloadT, ProposerSlashingT,
+		VoluntaryExitT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
-	BlobSidecarsT,
-	DepositT any,
+	BeaconStateT BeaconState[Eth1DataT, ExecutionPayloadHeaderT],
+	BlobSidecarsT any,
+	DepositT Deposit,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
 	ExecutionPayloadT any,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
+	ProposerSlashingT ProposerSlashing,
+	VoluntaryExitT VoluntaryExit,
 ] struct {
 	// cfg is the validator config.
 	cfg *Config
@@ -57,30 +62,43 @@ type Service[
 	chainSpec common.ChainSpec
 	// signer is used to retrieve the public key of this node.
 	signer crypto.BLSSigner
+	// proposals protects the signer against signing conflicting proposals.
+	proposals *proposalProtection
 	// blobFactory is used to create blob sidecars for blocks.
 	blobFactory BlobFactory[
 		BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
-		DepositT, Eth1DataT, ExecutionPayloadT,
+		DepositT, Eth1DataT, ExecutionPayloadT, ProposerSlashingT,
+		VoluntaryExitT,
 	]
 	// bsb is the beacon state backend.
 	bsb StorageBackend[
-		BeaconStateT, DepositT, DepositStoreT, ExecutionPayloadHeaderT,
+		BeaconStateT, DepositT, DepositStoreT, Eth1DataT,
+		ExecutionPayloadHeaderT,
 	]
 	// stateProcessor is responsible for processing the state.
 	stateProcessor StateProcessor[
 		BeaconBlockT,
 		BeaconStateT,
 		*transition.Context,
+		Eth1DataT,
 		ExecutionPayloadHeaderT,
+		ProposerSlashingT,
+		VoluntaryExitT,
 	]
+	// operationPool holds the operations waiting to be included in a block.
+	operationPool OperationPool[ProposerSlashingT, VoluntaryExitT]
 	// localPayloadBuilder represents the local block builder, this builder
 	// is connected to this nodes execution client via the EngineAPI.
 	// Building blocks are done by submitting forkchoice updates through.
 	// The local Builder.
-	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	localPayloadBuilder PayloadBuilder[
+		BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	]
 	// remotePayloadBuilders represents a list of remote block builders, these
 	// builders are connected to other execution clients via the EngineAPI.
-	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	remotePayloadBuilders []PayloadBuilder[
+		BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	]
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
 	// blkBroker is a publisher for blocks.
@@ -89,44 +107,61 @@ type Service[
 	sidecarBroker EventPublisher[*asynctypes.Event[BlobSidecarsT]]
 	// newSlotSub is a feed for slots.
 	newSlotSub chan *asynctypes.Event[math.Slot]
+	// wg waits for the goroutine of the service to exit.
+	wg sync.WaitGroup
 }
 
 // NewService creates a new validator service.
 func NewService[
 	BeaconBlockT BeaconBlock[
 		BeaconBlockT, BeaconBlockBodyT, DepositT, Eth1DataT, ExecutionPayloadT,
+		ProposerSlashingT, VoluntaryExitT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		DepositT, Eth1DataT, ExecutionPayloadT,
+		DepositT, Eth1DataT, ExecutionPayloadT, ProposerSlashingT,
+		VoluntaryExitT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
-	BlobSidecarsT,
-	DepositT any,
+	BeaconStateT BeaconState[Eth1DataT, ExecutionPayloadHeaderT],
+	BlobSidecarsT any,
+	DepositT Deposit,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
 	ExecutionPayloadT any,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
+	ProposerSlashingT ProposerSlashing,
+	VoluntaryExitT VoluntaryExit,
 ](
 	cfg *Config,
 	logger log.Logger[any],
 	chainSpec common.ChainSpec,
 	bsb StorageBackend[
-		BeaconStateT, DepositT, DepositStoreT, ExecutionPayloadHeaderT,
+		BeaconStateT, DepositT, DepositStoreT, Eth1DataT,
+		ExecutionPayloadHeaderT,
 	],
 	stateProcessor StateProcessor[
 		BeaconBlockT,
 		BeaconStateT,
 		*transition.Context,
+		Eth1DataT,
 		ExecutionPayloadHeaderT,
+		ProposerSlashingT,
+		VoluntaryExitT,
 	],
 	signer crypto.BLSSigner,
+	slashingDB SlashingProtectionDB,
 	blobFactory BlobFactory[
 		BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
-		DepositT, Eth1DataT, ExecutionPayloadT,
+		DepositT, Eth1DataT, ExecutionPayloadT, ProposerSlashingT,
+		VoluntaryExitT,
+	],
+	operationPool OperationPool[ProposerSlashingT, VoluntaryExitT],
+	localPayloadBuilder PayloadBuilder[
+		BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	],
+	remotePayloadBuilders []PayloadBuilder[
+		BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	],
-	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
-	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT],
 	ts TelemetrySink,
 	blkBroker EventPublisher[*asynctypes.Event[BeaconBlockT]],
 	sidecarBroker EventPublisher[*asynctypes.Event[BlobSidecarsT]],
@@ -134,20 +169,22 @@ func NewService[
 ) *Service[
 	BeaconBlockT, BeaconBlockBodyT, BeaconStateT, BlobSidecarsT,
 	DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
-	ExecutionPayloadHeaderT, ForkDataT,
+	ExecutionPayloadHeaderT, ForkDataT, ProposerSlashingT, VoluntaryExitT,
 ] {
 	return &Service[
 		BeaconBlockT, BeaconBlockBodyT, BeaconStateT, BlobSidecarsT,
 		DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
-		ExecutionPayloadHeaderT, ForkDataT,
+		ExecutionPayloadHeaderT, ForkDataT, ProposerSlashingT, VoluntaryExitT,
 	]{
 		cfg:                   cfg,
 		logger:                logger,
 		bsb:                   bsb,
 		chainSpec:             chainSpec,
 		signer:                signer,
+		proposals:             newProposalProtection(slashingDB),
 		stateProcessor:        stateProcessor,
 		blobFactory:           blobFactory,
+		operationPool:         operationPool,
 		localPayloadBuilder:   localPayloadBuilder,
 		remotePayloadBuilders: remotePayloadBuilders,
 		metrics:               newValidatorMetrics(ts),
@@ -159,24 +196,43 @@ func NewService[
 
 // Name returns the name of the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _,
 ]) Name() string {
 	return "validator"
 }
 
 // Start starts the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _,
 ]) Start(
 	ctx context.Context,
 ) error {
-	go s.start(ctx)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.start(ctx)
+	}()
+	return nil
+}
+
+// Stop waits for the service to finish handling its current slot.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _,
+]) Stop(context.Context) error {
+	s.wg.Wait()
+	return nil
+}
+
+// Status returns the status of the service.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _,
+]) Status() error {
 	return nil
 }
 
 // start starts the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _,
 ]) start(
 	ctx context.Context,
 ) {
@@ -194,7 +250,7 @@ func (s *Service[
 
 // handleBlockRequest handles a block request.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _,
 ]) handleNewSlot(msg *asynctypes.Event[math.Slot]) {
 	blk, sidecars, err := s.buildBlockAndSidecars(
 		msg.Context(), msg.Data(),
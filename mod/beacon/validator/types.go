@@ -25,6 +25,7 @@ import (
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -37,11 +38,14 @@ import (
 type BeaconBlock[
 	BeaconBlockT any,
 	BeaconBlockBodyT BeaconBlockBody[
-		DepositT, Eth1DataT, ExecutionPayloadT,
+		DepositT, Eth1DataT, ExecutionPayloadT, ProposerSlashingT,
+		VoluntaryExitT,
 	],
 	DepositT,
 	Eth1DataT,
-	ExecutionPayloadT any,
+	ExecutionPayloadT,
+	ProposerSlashingT,
+	VoluntaryExitT any,
 ] interface {
 	constraints.SSZMarshallable
 	// NewWithVersion creates a new beacon block with the given parameters.
@@ -61,11 +65,16 @@ type BeaconBlock[
 	GetStateRoot() common.Root
 	// GetBody returns the body of the beacon block.
 	GetBody() BeaconBlockBodyT
+	// BlindedHashTreeRoot returns the hash tree root of the beacon block
+	// with its execution payload replaced by the header with the given
+	// root.
+	BlindedHashTreeRoot(common.Root) (common.Root, error)
 }
 
 // BeaconBlockBody represents a beacon block body interface.
 type BeaconBlockBody[
-	DepositT, Eth1DataT, ExecutionPayloadT any,
+	DepositT, Eth1DataT, ExecutionPayloadT, ProposerSlashingT,
+	VoluntaryExitT any,
 ] interface {
 	constraints.SSZMarshallable
 	constraints.Nillable
@@ -75,6 +84,11 @@ type BeaconBlockBody[
 	SetEth1Data(Eth1DataT)
 	// SetDeposits sets the deposits of the beacon block body.
 	SetDeposits([]DepositT)
+	// SetProposerSlashings sets the proposer slashings of the beacon block
+	// body.
+	SetProposerSlashings([]ProposerSlashingT)
+	// SetVoluntaryExits sets the voluntary exits of the beacon block body.
+	SetVoluntaryExits([]VoluntaryExitT)
 	// SetExecutionData sets the execution data of the beacon block body.
 	SetExecutionData(ExecutionPayloadT) error
 	// SetGraffiti sets the graffiti of the beacon block body.
@@ -85,7 +99,7 @@ type BeaconBlockBody[
 }
 
 // BeaconState represents a beacon state interface.
-type BeaconState[ExecutionPayloadHeaderT any] interface {
+type BeaconState[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	// GetBlockRootAtIndex returns the block root at the given index.
 	GetBlockRootAtIndex(uint64) (common.Root, error)
 	// GetLatestExecutionPayloadHeader returns the latest execution payload
@@ -99,6 +113,11 @@ type BeaconState[ExecutionPayloadHeaderT any] interface {
 	HashTreeRoot() ([32]byte, error)
 	// ValidatorIndexByPubkey returns the validator index by public key.
 	ValidatorIndexByPubkey(crypto.BLSPubkey) (math.ValidatorIndex, error)
+	// GetEth1Data returns the eth1 data from the beacon state.
+	GetEth1Data() (Eth1DataT, error)
+	// GetEth1DataVotes returns the eth1 data votes cast during the current
+	// voting period.
+	GetEth1DataVotes() ([]Eth1DataT, error)
 	// GetEth1DepositIndex returns the latest deposit index from the beacon
 	// state.
 	GetEth1DepositIndex() (uint64, error)
@@ -110,14 +129,18 @@ type BeaconState[ExecutionPayloadHeaderT any] interface {
 type BlobFactory[
 	BeaconBlockT BeaconBlock[
 		BeaconBlockT, BeaconBlockBodyT, DepositT, Eth1DataT, ExecutionPayloadT,
+		ProposerSlashingT, VoluntaryExitT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		DepositT, Eth1DataT, ExecutionPayloadT,
+		DepositT, Eth1DataT, ExecutionPayloadT, ProposerSlashingT,
+		VoluntaryExitT,
 	],
 	BlobSidecarsT,
 	DepositT,
 	Eth1DataT,
-	ExecutionPayloadT any,
+	ExecutionPayloadT,
+	ProposerSlashingT,
+	VoluntaryExitT any,
 ] interface {
 	// BuildSidecars builds sidecars for a given block and blobs bundle.
 	BuildSidecars(
@@ -126,6 +149,13 @@ type BlobFactory[
 	) (BlobSidecarsT, error)
 }
 
+// Deposit represents a deposit interface.
+type Deposit interface {
+	// SetProof sets the Merkle proof of the deposit against the deposit
+	// root.
+	SetProof([]common.Root)
+}
+
 // DepositStore defines the interface for deposit storage.
 type DepositStore[DepositT any] interface {
 	// GetDepositsByIndex returns `numView` expected deposits.
@@ -133,6 +163,22 @@ type DepositStore[DepositT any] interface {
 		startIndex uint64,
 		numView uint64,
 	) ([]DepositT, error)
+	// GetDepositCount returns the number of deposits in the deposit tree.
+	GetDepositCount() uint64
+	// GetDepositRoot returns the deposit root of the deposit tree as it was
+	// when it held the given number of deposits.
+	GetDepositRoot(depositCount uint64) (common.Root, error)
+	// GetDepositProof returns the Merkle proof of the deposit at the given
+	// index against the deposit root of the deposit tree as it was when it
+	// held the given number of deposits.
+	GetDepositProof(index, depositCount uint64) ([]common.Root, error)
+	// GetEth1Block returns the hash and deposit count of the latest synced
+	// execution block whose number is at most the given one, and false if
+	// there is none.
+	GetEth1Block(blockNum uint64) (common.ExecutionHash, uint64, bool, error)
+	// GetEth1BlockNumber returns the number of the synced execution block
+	// with the given hash, and false if there is none.
+	GetEth1BlockNumber(blockHash common.ExecutionHash) (uint64, bool, error)
 }
 
 // Eth1Data represents the eth1 data interface.
@@ -143,16 +189,30 @@ type Eth1Data[T any] interface {
 		depositCount math.U64,
 		blockHash common.ExecutionHash,
 	) T
+	// GetDepositRoot returns the root of the deposit tree.
+	GetDepositRoot() common.Root
+	// GetDepositCount returns the number of deposits in the deposit
+	// contract.
+	GetDepositCount() math.U64
+	// GetBlockHash returns the hash of the eth1 block.
+	GetBlockHash() common.ExecutionHash
+	// Equals returns true if the eth1 data is equal to the given eth1 data.
+	Equals(T) bool
 }
 
 // ExecutionPayloadHeader represents the execution payload header interface.
 type ExecutionPayloadHeader interface {
+	// GetNumber returns the block number of the execution payload header.
+	GetNumber() math.U64
 	// GetTimestamp returns the timestamp of the execution payload header.
 	GetTimestamp() math.U64
 	// GetBlockHash returns the block hash of the execution payload header.
 	GetBlockHash() common.ExecutionHash
 	// GetParentHash returns the parent hash of the execution payload header.
 	GetParentHash() common.ExecutionHash
+	// HashTreeRoot returns the hash tree root of the execution payload
+	// header.
+	HashTreeRoot() ([32]byte, error)
 }
 
 // EventSubscription represents the event subscription interface.
@@ -176,11 +236,35 @@ type ForkData[T any] interface {
 		common.DomainType,
 		math.Epoch,
 	) (common.Root, error)
+	// ComputeSigningRoot computes the signing root of the object with the
+	// given root.
+	ComputeSigningRoot(
+		common.DomainType,
+		common.Root,
+	) (common.Root, error)
+}
+
+// OperationPool represents the pool of operations waiting to be included in
+// a block.
+type OperationPool[ProposerSlashingT, VoluntaryExitT any] interface {
+	// ProposerSlashings returns the proposer slashings of the pool.
+	ProposerSlashings() []ProposerSlashingT
+	// RemoveProposerSlashing removes the slashing of the proposer at the
+	// given index from the pool.
+	RemoveProposerSlashing(math.ValidatorIndex)
+	// VoluntaryExits returns the voluntary exits of the pool.
+	VoluntaryExits() []VoluntaryExitT
+	// RemoveVoluntaryExit removes the voluntary exit of the validator at the
+	// given index from the pool.
+	RemoveVoluntaryExit(math.ValidatorIndex)
 }
 
 // PayloadBuilder represents a service that is responsible for
 // building eth1 blocks.
-type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
+type PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT any,
+	ExecutionPayloadHeaderT relay.ExecutionPayloadHeader,
+] interface {
 	// RetrievePayload retrieves the payload for the given slot.
 	RetrievePayload(
 		ctx context.Context,
@@ -198,14 +282,48 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 		headEth1BlockHash common.ExecutionHash,
 		finalEth1BlockHash common.ExecutionHash,
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+	// RequestBuilderBid requests a bid for the given slot from the relay,
+	// returning nil if the local payload should be proposed instead.
+	RequestBuilderBid(
+		ctx context.Context,
+		slot math.Slot,
+		parentHash common.ExecutionHash,
+		pubkey crypto.BLSPubkey,
+		local engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+	) *relay.SignedBuilderBid[ExecutionPayloadHeaderT]
+	// UnblindPayload submits the signed blinded block built on top of the
+	// bid to the relay, which reveals the execution payload of the bid.
+	UnblindPayload(
+		ctx context.Context,
+		slot math.Slot,
+		signedBlindedBlock any,
+		bid *relay.SignedBuilderBid[ExecutionPayloadHeaderT],
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+}
+
+// ProposerSlashing represents a proposer slashing interface.
+type ProposerSlashing interface {
+	// GetProposerIndex returns the index of the proposer being slashed.
+	GetProposerIndex() math.ValidatorIndex
+}
+
+// SlashingProtectionDB is the key-value store in which the validator keeps
+// the records protecting it against being slashed.
+type SlashingProtectionDB interface {
+	Get(key []byte) ([]byte, error)
+	Has(key []byte) (bool, error)
+	Set(key []byte, value []byte) error
 }
 
 // StateProcessor defines the interface for processing the state.
 type StateProcessor[
 	BeaconBlockT any,
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[Eth1DataT, ExecutionPayloadHeaderT],
 	ContextT,
-	ExecutionPayloadHeaderT any,
+	Eth1DataT,
+	ExecutionPayloadHeaderT,
+	ProposerSlashingT,
+	VoluntaryExitT any,
 ] interface {
 	// ProcessSlot processes the slot.
 	ProcessSlots(
@@ -217,13 +335,20 @@ type StateProcessor[
 		st BeaconStateT,
 		blk BeaconBlockT,
 	) (transition.ValidatorUpdates, error)
+	// ValidateProposerSlashing returns an error if the proposer slashing can
+	// not be included in a block on top of the given state.
+	ValidateProposerSlashing(st BeaconStateT, ps ProposerSlashingT) error
+	// ValidateVoluntaryExit returns an error if the voluntary exit can not
+	// be included in a block on top of the given state.
+	ValidateVoluntaryExit(st BeaconStateT, exit VoluntaryExitT) error
 }
 
 // StorageBackend is the interface for the storage backend.
 type StorageBackend[
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[Eth1DataT, ExecutionPayloadHeaderT],
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
+	Eth1DataT,
 	ExecutionPayloadHeaderT any,
 ] interface {
 	// DepositStore retrieves the deposit store.
@@ -241,3 +366,9 @@ type TelemetrySink interface {
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
 }
+
+// VoluntaryExit represents a voluntary exit interface.
+type VoluntaryExit interface {
+	// GetValidatorIndex returns the index of the exiting validator.
+	GetValidatorIndex() math.ValidatorIndex
+}
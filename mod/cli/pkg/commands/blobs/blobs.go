@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobs
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	beaconconfig "github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagOutputDir is the flag of the directory sidecars are exported to.
+	FlagOutputDir = "output-dir"
+	// defaultOutputDir is the default directory sidecars are exported to.
+	defaultOutputDir = "blobs"
+	// blobsFolder is the folder of the availability store in the home
+	// directory.
+	blobsFolder = "data/blobs"
+)
+
+// Commands creates a new command for blob sidecar related actions.
+func Commands(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "blobs",
+		Short:                      "blob sidecar subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewExportCommand(chainSpec),
+	)
+
+	return cmd
+}
+
+// NewExportCommand creates a new command for exporting the blob sidecars of
+// a range of slots.
+func NewExportCommand(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [from-slot] [to-slot]",
+		Short: "Exports the blob sidecars of a range of slots",
+		Long: `Exports the blob sidecars stored by the node for every slot from
+from-slot to to-slot, inclusive, including the sidecars moved to the cold
+storage. The sidecars of each slot are written as an SSZ encoded list to
+<slot>.ssz in the output directory, slots without sidecars are skipped.`,
+		Args: cobra.ExactArgs(2), //nolint:mnd // from and to slots.
+		RunE: exportBlobSidecars(chainSpec),
+	}
+
+	cmd.Flags().StringP(
+		FlagOutputDir, "o", defaultOutputDir,
+		"Directory to write the exported blob sidecars to",
+	)
+	return cmd
+}
+
+// exportBlobSidecars exports the blob sidecars of the slots in the range
+// given as arguments.
+func exportBlobSidecars(chainSpec common.ChainSpec) func(
+	cmd *cobra.Command,
+	args []string,
+) error {
+	return func(cmd *cobra.Command, args []string) error {
+		from, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidSlot, "%s", args[0])
+		}
+		to, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidSlot, "%s", args[1])
+		}
+		if from > to {
+			return errors.Wrapf(ErrInvalidSlotRange, "%d > %d", from, to)
+		}
+
+		outputDir, err := cmd.Flags().GetString(FlagOutputDir)
+		if err != nil {
+			return err
+		}
+
+		store, err := openStore(cmd, chainSpec)
+		if err != nil {
+			return err
+		}
+
+		fs := afero.NewOsFs()
+		if err = fs.MkdirAll(outputDir, os.ModePerm); err != nil {
+			return err
+		}
+
+		var exported int
+		for slot := from; slot <= to; slot++ {
+			sidecars, sErr := store.GetBlobSidecars(math.Slot(slot))
+			if sErr != nil {
+				return sErr
+			}
+			if sidecars.Len() == 0 {
+				continue
+			}
+
+			bz, sErr := sidecars.MarshalSSZ()
+			if sErr != nil {
+				return sErr
+			}
+			if sErr = afero.WriteFile(
+				fs,
+				filepath.Join(outputDir, strconv.FormatUint(slot, 10)+".ssz"),
+				bz,
+				os.ModePerm,
+			); sErr != nil {
+				return sErr
+			}
+			exported += sidecars.Len()
+		}
+
+		cmd.Printf(
+			"Successfully exported %d blob sidecars to: %s\n",
+			exported, outputDir,
+		)
+		return nil
+	}
+}
+
+// openStore opens the availability store of the node, along with its cold
+// storage if one is configured.
+func openStore(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+) (*dastore.Store[*types.BeaconBlockBody], error) {
+	clientCtx, ok := cmd.Context().
+		Value(client.ClientContextKey).(*client.Context)
+	if !ok {
+		return nil, ErrNoClientCtx
+	}
+
+	cfg, err := beaconconfig.ReadConfigFromAppOpts(
+		server.GetServerContextFromCmd(cmd).Viper,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []dastore.Option
+	if dir := cfg.Storage.BlobColdStorageDir; dir != "" {
+		opts = append(opts, dastore.WithColdStorage(
+			dastore.NewColdStorage(afero.NewOsFs(), dir),
+		))
+	}
+
+	return dastore.New[*types.BeaconBlockBody](
+		filedb.NewRangeDB(
+			filedb.NewDB(
+				filedb.WithRootDirectory(
+					filepath.Join(clientCtx.HomeDir, blobsFolder),
+				),
+				filedb.WithFileExtension("ssz"),
+				filedb.WithDirectoryPermissions(os.ModePerm),
+				filedb.WithLogger(noop.NewLogger()),
+			),
+		),
+		noop.NewLogger(),
+		chainSpec,
+		opts...,
+	), nil
+}
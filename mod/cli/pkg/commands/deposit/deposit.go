@@ -42,6 +42,7 @@ func Commands(chainSpec common.ChainSpec) *cobra.Command {
 	cmd.AddCommand(
 		NewValidateDeposit(chainSpec),
 		NewCreateValidator(chainSpec),
+		NewVoluntaryExit(chainSpec),
 	)
 
 	return cmd
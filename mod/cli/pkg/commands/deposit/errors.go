@@ -28,4 +28,8 @@ var (
 	ErrValidatorPrivateKeyRequired = errors.New(
 		"validator private key required",
 	)
+
+	// ErrVoluntaryExitRejected is returned when the node API does not accept
+	// the submitted voluntary exit.
+	ErrVoluntaryExitRejected = errors.New("voluntary exit rejected")
 )
@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/spf13/cobra"
+)
+
+// NewVoluntaryExit creates a new command for signing a voluntary exit.
+func NewVoluntaryExit(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exit",
+		Short: "Creates a signed voluntary exit",
+		Long: `Creates a voluntary exit signed with the validator key, which
+		removes the validator from the active set once included in a block.
+		The arguments are expected in the order of validator index, the epoch
+		from which the exit is valid, current version, and genesis validator
+		root. If a node API URL is given, the exit is submitted to the
+		operation pool of that node.`,
+		Args: cobra.ExactArgs(4), //nolint:mnd // The number of arguments.
+		RunE: voluntaryExitCmd(chainSpec),
+	}
+
+	cmd.Flags().BoolP(
+		overrideNodeKey, overrideNodeKeyShorthand,
+		defaultOverrideNodeKey, overrideNodeKeyMsg,
+	)
+	cmd.Flags().
+		String(valPrivateKey, defaultValidatorPrivateKey, valPrivateKeyMsg)
+	cmd.Flags().String(nodeAPIURL, defaultNodeAPIURL, nodeAPIURLMsg)
+
+	return cmd
+}
+
+// voluntaryExitCmd returns a command that builds a signed voluntary exit.
+func voluntaryExitCmd(
+	chainSpec common.ChainSpec,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var (
+			logger = log.NewLogger(os.Stdout)
+		)
+
+		// Get the BLS signer.
+		blsSigner, err := getBLSSigner(cmd)
+		if err != nil {
+			return err
+		}
+
+		validatorIndex, err := parser.ConvertValidatorIndex(args[0])
+		if err != nil {
+			return err
+		}
+
+		epoch, err := parser.ConvertEpoch(args[1])
+		if err != nil {
+			return err
+		}
+
+		currentVersion, err := parser.ConvertVersion(args[2])
+		if err != nil {
+			return err
+		}
+
+		genesisValidatorRoot, err := parser.ConvertGenesisValidatorRoot(args[3])
+		if err != nil {
+			return err
+		}
+
+		// Create and sign the voluntary exit.
+		forkData := types.NewForkData(currentVersion, genesisValidatorRoot)
+		exit, err := types.CreateAndSignVoluntaryExit(
+			forkData,
+			chainSpec.DomainTypeVoluntaryExit(),
+			blsSigner,
+			epoch,
+			validatorIndex,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Verify the voluntary exit.
+		if err = exit.VerifySignature(
+			forkData,
+			chainSpec.DomainTypeVoluntaryExit(),
+			blsSigner.PublicKey(),
+			signer.BLSSigner{}.VerifySignature,
+		); err != nil {
+			return err
+		}
+
+		bz, err := exit.MarshalSSZ()
+		if err != nil {
+			return err
+		}
+
+		logger.Info(
+			"Signed Voluntary Exit",
+			"pubkey", blsSigner.PublicKey().String(),
+			"validator index", exit.GetValidatorIndex(),
+			"epoch", exit.GetEpoch(),
+			"signature", exit.Signature.String(),
+			"ssz", bytes.Bytes(bz).String(),
+		)
+
+		// Submit the voluntary exit to the node, if requested.
+		apiURL, err := cmd.Flags().GetString(nodeAPIURL)
+		if err != nil || apiURL == "" {
+			return err
+		}
+		if err = submitVoluntaryExit(cmd.Context(), apiURL, exit); err != nil {
+			return err
+		}
+		logger.Info("Submitted Voluntary Exit", "node api", apiURL)
+
+		return nil
+	}
+}
+
+// submitVoluntaryExit submits the signed voluntary exit to the operation pool
+// of the node serving the node API at the given URL.
+func submitVoluntaryExit(
+	ctx context.Context,
+	apiURL string,
+	exit *types.SignedVoluntaryExit,
+) error {
+	body, err := json.Marshal(exit)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		apiURL+"/eth/v1/beacon/pool/voluntary_exits",
+		strings.NewReader(string(body)),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf(
+			"%w: status %d: %s", ErrVoluntaryExitRejected,
+			resp.StatusCode, msg,
+		)
+	}
+	return nil
+}
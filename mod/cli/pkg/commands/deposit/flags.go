@@ -38,6 +38,9 @@ const (
 
 	// engineRPCURL is the flag for the URL for the engine RPC.
 	engineRPCURL = "engine-rpc-url"
+
+	// nodeAPIURL is the flag for the URL of the node API.
+	nodeAPIURL = "node-api-url"
 )
 
 const (
@@ -71,6 +74,9 @@ const (
 
 	// defaultEngineRPCURL is the default value for the engineRPCURL flag.
 	defaultEngineRPCURL = "http://localhost:8551"
+
+	// defaultNodeAPIURL is the default value for the nodeAPIURL flag.
+	defaultNodeAPIURL = ""
 )
 
 const (
@@ -97,4 +103,8 @@ const (
 
 	// engineRPCURLMsg is the usage description for the engineRPCURL flag.
 	engineRPCURLMsg = "URL for the engine RPC"
+
+	// nodeAPIURLMsg is the usage description for the nodeAPIURL flag.
+	nodeAPIURLMsg = `URL of the node API to submit the voluntary exit to. The
+	exit is only printed if not set.`
 )
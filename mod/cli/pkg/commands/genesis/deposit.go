@@ -114,12 +114,13 @@ func AddGenesisDepositCmd(cs common.ChainSpec) *cobra.Command {
 				return err
 			}
 
-			deposit := types.Deposit{
-				Pubkey:      depositMsg.Pubkey,
-				Amount:      depositMsg.Amount,
-				Signature:   signature,
-				Credentials: depositMsg.Credentials,
-			}
+			deposit := types.NewDeposit(
+				depositMsg.Pubkey,
+				depositMsg.Credentials,
+				depositMsg.Amount,
+				signature,
+				0,
+			)
 
 			//#nosec:G703 // Ignore errors on this line.
 			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
@@ -131,7 +132,7 @@ func AddGenesisDepositCmd(cs common.ChainSpec) *cobra.Command {
 				}
 			}
 
-			if err = writeDepositToFile(outputDocument, &deposit); err != nil {
+			if err = writeDepositToFile(outputDocument, deposit); err != nil {
 				return errors.Wrap(err, "failed to write signed gen tx")
 			}
 
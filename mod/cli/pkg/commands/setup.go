@@ -22,6 +22,7 @@ package commands
 
 import (
 	confixcmd "cosmossdk.io/tools/confix/cmd"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/blobs"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/client"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/cometbft"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
@@ -56,6 +57,8 @@ func DefaultRootCommandSetup[T types.Node](
 	root.cmd.AddCommand(
 		// `comet`
 		cometbft.Commands(appCreator),
+		// `blobs`
+		blobs.Commands(chainSpec),
 		// `client`
 		client.Commands(),
 		// `config`
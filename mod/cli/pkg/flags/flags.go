@@ -35,6 +35,9 @@ const (
 	SuggestedFeeRecipient    = builderRoot + "suggested-fee-recipient"
 	LocalBuilderEnabled      = builderRoot + "local-builder-enabled"
 	LocalBuildPayloadTimeout = builderRoot + "local-build-payload-timeout"
+	PayloadPollInterval      = builderRoot + "payload-poll-interval"
+	RelayURL                 = builderRoot + "relay-url"
+	RelayTimeout             = builderRoot + "relay-timeout"
 
 	// Validator Config.
 	validatorRoot = beaconKitRoot + "validator."
@@ -43,6 +46,8 @@ const (
 	// Engine Config.
 	engineRoot              = beaconKitRoot + "engine."
 	RPCDialURL              = engineRoot + "rpc-dial-url"
+	RPCFallbackDialURLs     = engineRoot + "rpc-fallback-dial-urls"
+	RPCShadowDialURL        = engineRoot + "rpc-shadow-dial-url"
 	RPCRetries              = engineRoot + "rpc-retries"
 	RPCTimeout              = engineRoot + "rpc-timeout"
 	RPCStartupCheckInterval = engineRoot + "rpc-startup-check-interval"
@@ -73,6 +78,14 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().String(
 		RPCDialURL, defaultCfg.Engine.RPCDialURL.String(), "rpc dial url",
 	)
+	startCmd.Flags().StringSlice(
+		RPCFallbackDialURLs, nil, "rpc fallback dial urls",
+	)
+	startCmd.Flags().String(
+		RPCShadowDialURL,
+		defaultCfg.Engine.RPCShadowDialURL.String(),
+		"rpc shadow dial url",
+	)
 	startCmd.Flags().Uint64(
 		RPCRetries, defaultCfg.Engine.RPCRetries, "rpc retries",
 	)
@@ -84,6 +97,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCStartupCheckInterval,
 		"rpc startup check interval",
 	)
+	startCmd.Flags().Duration(
+		RPCHealthCheckInteval,
+		defaultCfg.Engine.RPCHealthCheckInterval,
+		"rpc health check interval",
+	)
 	startCmd.Flags().Duration(
 		RPCJWTRefreshInterval,
 		defaultCfg.Engine.RPCJWTRefreshInterval,
@@ -94,6 +112,21 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
 		"suggested fee recipient",
 	)
+	startCmd.Flags().Duration(
+		PayloadPollInterval,
+		defaultCfg.PayloadBuilder.PayloadPollInterval,
+		"payload poll interval",
+	)
+	startCmd.Flags().String(
+		RelayURL,
+		defaultCfg.PayloadBuilder.RelayURL,
+		"builder-API relay url",
+	)
+	startCmd.Flags().Duration(
+		RelayTimeout,
+		defaultCfg.PayloadBuilder.RelayTimeout,
+		"builder-API relay timeout",
+	)
 	startCmd.Flags().String(
 		KZGTrustedSetupPath,
 		defaultCfg.KZG.TrustedSetupPath,
@@ -54,6 +54,17 @@ var (
 		"invalid root length",
 	)
 
+	// ErrInvalidValidatorIndex is returned when the validator index is
+	// invalid.
+	ErrInvalidValidatorIndex = errors.New(
+		"invalid validator index",
+	)
+
+	// ErrInvalidEpoch is returned when the epoch is invalid.
+	ErrInvalidEpoch = errors.New(
+		"invalid epoch",
+	)
+
 	// ErrDepositTransactionFailed is returned when the deposit transaction
 	// fails.
 	ErrDepositTransactionFailed = errors.New(
@@ -22,6 +22,7 @@ package parser
 
 import (
 	"math/big"
+	"strconv"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
@@ -110,3 +111,21 @@ func ConvertGenesisValidatorRoot(root string) (common.Root, error) {
 	}
 	return common.Root(rootBytes), nil
 }
+
+// ConvertValidatorIndex converts a string to a validator index.
+func ConvertValidatorIndex(index string) (math.ValidatorIndex, error) {
+	idx, err := strconv.ParseUint(index, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidValidatorIndex
+	}
+	return math.ValidatorIndex(idx), nil
+}
+
+// ConvertEpoch converts a string to an epoch.
+func ConvertEpoch(epoch string) (math.Epoch, error) {
+	e, err := strconv.ParseUint(epoch, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidEpoch
+	}
+	return math.Epoch(e), nil
+}
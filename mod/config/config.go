@@ -22,6 +22,7 @@ package config
 
 import (
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/config/pkg/storage"
 	"github.com/berachain/beacon-kit/mod/config/pkg/template"
 	viperlib "github.com/berachain/beacon-kit/mod/config/pkg/viper"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
@@ -46,6 +47,7 @@ func DefaultConfig() *Config {
 		KZG:            kzg.DefaultConfig(),
 		PayloadBuilder: builder.DefaultConfig(),
 		Validator:      validator.DefaultConfig(),
+		Storage:        storage.DefaultConfig(),
 	}
 }
 
@@ -61,6 +63,8 @@ type Config struct {
 	PayloadBuilder builder.Config `mapstructure:"payload-builder"`
 	// Validator is the configuration for the validator client.
 	Validator validator.Config `mapstructure:"validator"`
+	// Storage is the configuration for the storage of the node.
+	Storage storage.Config `mapstructure:"storage"`
 }
 
 // GetEngine returns the execution client configuration.
@@ -65,9 +65,14 @@ func BaseSpec() chain.SpecData[
 		EjectionBalance:           uint64(16e9),
 		EffectiveBalanceIncrement: uint64(1e9),
 		// Time parameters constants.
-		SlotsPerEpoch:                32,
-		MinEpochsToInactivityPenalty: 4,
-		SlotsPerHistoricalRoot:       8,
+		SlotsPerEpoch:                    32,
+		MinEpochsToInactivityPenalty:     4,
+		SlotsPerHistoricalRoot:           8,
+		MinValidatorWithdrawabilityDelay: 256,
+		ShardCommitteePeriod:             256,
+		// Validator cycle.
+		MinPerEpochChurnLimit: 4,
+		ChurnLimitQuotient:    1 << 16,
 		// Signature domains.
 		DomainTypeProposer: common.DomainType{
 			0x00, 0x00, 0x00, 0x00,
@@ -100,6 +105,7 @@ func BaseSpec() chain.SpecData[
 		DepositEth1ChainID:        uint64(80084),
 		Eth1FollowDistance:        1,
 		TargetSecondsPerEth1Block: 3,
+		EpochsPerEth1VotingPeriod: 4,
 		// Fork-related values.
 		ElectraForkEpoch: 9999999999999999,
 		// State list length constants.
@@ -111,6 +117,8 @@ func BaseSpec() chain.SpecData[
 		MaxDepositsPerBlock: 16,
 		// Slashing
 		ProportionalSlashingMultiplier: 1,
+		MinSlashingPenaltyQuotient:     128,
+		WhistleblowerRewardQuotient:    512,
 		// Capella values.
 		MaxWithdrawalsPerPayload:         16,
 		MaxValidatorsPerWithdrawalsSweep: 1 << 14,
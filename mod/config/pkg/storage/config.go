@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package storage
+
+const (
+	// defaultHistoricalStates is the default number of historical states
+	// to retain, deferring to the pruning settings of the application.
+	defaultHistoricalStates = 0
+	// defaultHistoricalBlocks is the default number of finalized blocks to
+	// retain, covering the 4096 epochs of 32 slots the blob sidecars are
+	// available for.
+	defaultHistoricalBlocks = 131072
+	// defaultBlobArchive is the default archive mode of the blob sidecars,
+	// which only keeps them within the data availability window.
+	defaultBlobArchive = false
+	// defaultBlobRetentionEpochs is the default number of epochs blob
+	// sidecars are kept for in archive mode, keeping every sidecar.
+	defaultBlobRetentionEpochs = 0
+	// defaultBlobColdStorageDir is the default cold storage directory of
+	// the blob sidecars, deleting them once they expire.
+	defaultBlobColdStorageDir = ""
+	// defaultEventJournal is the default journaling of the finalized
+	// blocks, which are only dispatched in memory.
+	defaultEventJournal = false
+)
+
+// Config is the configuration for the storage of the node.
+type Config struct {
+	// HistoricalStates is the number of most recently committed beacon
+	// states that are retained and can be queried at their slot. It
+	// overrides the pruning settings of the application, unless it is zero.
+	HistoricalStates uint64 `mapstructure:"historical-states"`
+	// HistoricalBlocks is the number of most recently finalized blocks that
+	// are kept in the block store. Zero keeps every block.
+	HistoricalBlocks uint64 `mapstructure:"historical-blocks"`
+	// BlobArchive keeps the blob sidecars past the data availability window,
+	// for BlobRetentionEpochs epochs.
+	BlobArchive bool `mapstructure:"blob-archive"`
+	// BlobRetentionEpochs is the number of epochs the blob sidecars are kept
+	// for in archive mode, which is never less than the data availability
+	// window. Zero keeps every sidecar.
+	BlobRetentionEpochs uint64 `mapstructure:"blob-retention-epochs"`
+	// BlobColdStorageDir is the directory expired blob sidecars are moved
+	// to, compressed, instead of being deleted. Empty deletes them.
+	BlobColdStorageDir string `mapstructure:"blob-cold-storage-dir"`
+	// EventJournal records the finalized blocks on disk until the services
+	// consuming them have handled them, so that they are replayed after a
+	// restart.
+	EventJournal bool `mapstructure:"event-journal"`
+}
+
+// DefaultConfig returns the default storage configuration.
+func DefaultConfig() Config {
+	return Config{
+		HistoricalStates:    defaultHistoricalStates,
+		HistoricalBlocks:    defaultHistoricalBlocks,
+		BlobArchive:         defaultBlobArchive,
+		BlobRetentionEpochs: defaultBlobRetentionEpochs,
+		BlobColdStorageDir:  defaultBlobColdStorageDir,
+		EventJournal:        defaultEventJournal,
+	}
+}
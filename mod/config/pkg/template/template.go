@@ -30,6 +30,13 @@ const TomlTemplate = `
 # HTTP url of the execution client JSON-RPC endpoint.
 rpc-dial-url = "{{ .BeaconKit.Engine.RPCDialURL }}"
 
+# HTTP urls of the standby execution clients, in order of preference.
+rpc-fallback-dial-urls = [{{ range $i, $url := .BeaconKit.Engine.RPCFallbackDialURLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# HTTP url of an optional shadow execution client whose responses are
+# compared against the primary. Leave empty to disable.
+rpc-shadow-dial-url = "{{ .BeaconKit.Engine.RPCShadowDialURL }}"
+
 # Number of retries before shutting down consensus client.
 rpc-retries = "{{.BeaconKit.Engine.RPCRetries}}"
 
@@ -42,6 +49,9 @@ rpc-startup-check-interval = "{{ .BeaconKit.Engine.RPCStartupCheckInterval }}"
 # Interval for the JWT refresh.
 rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 
+# Interval for the execution client health checks.
+rpc-health-check-interval = "{{ .BeaconKit.Engine.RPCHealthCheckInterval }}"
+
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
@@ -77,6 +87,18 @@ suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 # timeout_proposal in the CometBFT configuration.
 payload-timeout = "{{ .BeaconKit.PayloadBuilder.PayloadTimeout }}"
 
+# The interval at which the execution client is polled for whether the payload
+# being built is ready, if it supports it. The payload is used as soon as it is
+# ready. Set to 0s to always wait for the full payload-timeout.
+payload-poll-interval = "{{ .BeaconKit.PayloadBuilder.PayloadPollInterval }}"
+
+# The url of the builder-API relay used to source payloads from external block
+# builders. Leave empty to only build payloads locally.
+relay-url = "{{ .BeaconKit.PayloadBuilder.RelayURL }}"
+
+# The timeout for requests to the relay, after which the local payload is used.
+relay-timeout = "{{ .BeaconKit.PayloadBuilder.RelayTimeout }}"
+
 [beacon-kit.validator]
 # Graffiti string that will be included in the graffiti field of the beacon block.
 graffiti = "{{.BeaconKit.Validator.Graffiti}}"
@@ -84,4 +106,31 @@ graffiti = "{{.BeaconKit.Validator.Graffiti}}"
 # EnableOptimisticPayloadBuilds enables building the next block's payload optimistically in
 # process-proposal to allow for the execution client to have more time to assemble the block.
 enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPayloadBuilds}}"
+
+[beacon-kit.storage]
+# Number of most recently committed beacon states to retain for historical
+# queries. When set, it overrides the pruning settings of the application.
+# Zero defers to the pruning settings of the application.
+historical-states = {{ .BeaconKit.Storage.HistoricalStates }}
+
+# Number of most recently finalized blocks to keep in the block store. The
+# default covers the data availability window of the blob sidecars. Zero keeps
+# every block.
+historical-blocks = {{ .BeaconKit.Storage.HistoricalBlocks }}
+
+# Whether to keep blob sidecars past the data availability window, for
+# blob-retention-epochs epochs.
+blob-archive = {{ .BeaconKit.Storage.BlobArchive }}
+
+# Number of epochs blob sidecars are kept for in archive mode, which is never
+# less than the data availability window. Zero keeps every sidecar.
+blob-retention-epochs = {{ .BeaconKit.Storage.BlobRetentionEpochs }}
+
+# Directory expired blob sidecars are moved to, compressed, instead of being
+# deleted. Empty deletes them.
+blob-cold-storage-dir = "{{ .BeaconKit.Storage.BlobColdStorageDir }}"
+
+# Whether to record finalized blocks on disk until the services consuming them
+# have handled them, so that they are replayed after a restart.
+event-journal = {{ .BeaconKit.Storage.EventJournal }}
 `
@@ -43,6 +43,7 @@ type BeaconState struct {
 
 	// Eth1
 	Eth1Data                     *types.Eth1Data                    `json:"eth1Data"`
+	Eth1DataVotes                []*types.Eth1Data                  `json:"eth1DataVotes"                ssz-max:"2048"`
 	Eth1DepositIndex             uint64                             `json:"eth1DepositIndex"`
 	LatestExecutionPayloadHeader *types.ExecutionPayloadHeaderDeneb `json:"latestExecutionPayloadHeader"`
 
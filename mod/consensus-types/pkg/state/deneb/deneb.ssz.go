@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: d26a5a9bcca159e8057c235e091c67cec8c6b8bdab9668dbb709cf13edde1efe
+// Hash: 363974addea575f31514d0b9a1172752b4aa19901df85afe64bc1d92c18c6376
 // Version: 0.1.3
 package deneb
 
@@ -18,7 +18,7 @@ func (b *BeaconState) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the BeaconState object to a target array
 func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(300)
+	offset := int(304)
 
 	// Field (0) 'GenesisValidatorsRoot'
 	dst = append(dst, b.GenesisValidatorsRoot[:]...)
@@ -58,38 +58,42 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		return
 	}
 
-	// Field (7) 'Eth1DepositIndex'
+	// Offset (7) 'Eth1DataVotes'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Eth1DataVotes) * 72
+
+	// Field (8) 'Eth1DepositIndex'
 	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
 
-	// Offset (8) 'LatestExecutionPayloadHeader'
+	// Offset (9) 'LatestExecutionPayloadHeader'
 	dst = ssz.WriteOffset(dst, offset)
 	if b.LatestExecutionPayloadHeader == nil {
 		b.LatestExecutionPayloadHeader = new(types.ExecutionPayloadHeaderDeneb)
 	}
 	offset += b.LatestExecutionPayloadHeader.SizeSSZ()
 
-	// Offset (9) 'Validators'
+	// Offset (10) 'Validators'
 	dst = ssz.WriteOffset(dst, offset)
 	offset += len(b.Validators) * 121
 
-	// Offset (10) 'Balances'
+	// Offset (11) 'Balances'
 	dst = ssz.WriteOffset(dst, offset)
 	offset += len(b.Balances) * 8
 
-	// Offset (11) 'RandaoMixes'
+	// Offset (12) 'RandaoMixes'
 	dst = ssz.WriteOffset(dst, offset)
 	offset += len(b.RandaoMixes) * 32
 
-	// Field (12) 'NextWithdrawalIndex'
+	// Field (13) 'NextWithdrawalIndex'
 	dst = ssz.MarshalUint64(dst, b.NextWithdrawalIndex)
 
-	// Field (13) 'NextWithdrawalValidatorIndex'
+	// Field (14) 'NextWithdrawalValidatorIndex'
 	dst = ssz.MarshalUint64(dst, uint64(b.NextWithdrawalValidatorIndex))
 
-	// Offset (14) 'Slashings'
+	// Offset (15) 'Slashings'
 	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (15) 'TotalSlashing'
+	// Field (16) 'TotalSlashing'
 	dst = ssz.MarshalUint64(dst, uint64(b.TotalSlashing))
 
 	// Field (4) 'BlockRoots'
@@ -110,12 +114,23 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		dst = append(dst, b.StateRoots[ii][:]...)
 	}
 
-	// Field (8) 'LatestExecutionPayloadHeader'
+	// Field (7) 'Eth1DataVotes'
+	if size := len(b.Eth1DataVotes); size > 2048 {
+		err = ssz.ErrListTooBigFn("BeaconState.Eth1DataVotes", size, 2048)
+		return
+	}
+	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+		if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (9) 'LatestExecutionPayloadHeader'
 	if dst, err = b.LatestExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
-	// Field (9) 'Validators'
+	// Field (10) 'Validators'
 	if size := len(b.Validators); size > 1099511627776 {
 		err = ssz.ErrListTooBigFn("BeaconState.Validators", size, 1099511627776)
 		return
@@ -126,7 +141,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		}
 	}
 
-	// Field (10) 'Balances'
+	// Field (11) 'Balances'
 	if size := len(b.Balances); size > 1099511627776 {
 		err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
 		return
@@ -135,7 +150,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		dst = ssz.MarshalUint64(dst, b.Balances[ii])
 	}
 
-	// Field (11) 'RandaoMixes'
+	// Field (12) 'RandaoMixes'
 	if size := len(b.RandaoMixes); size > 65536 {
 		err = ssz.ErrListTooBigFn("BeaconState.RandaoMixes", size, 65536)
 		return
@@ -144,7 +159,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		dst = append(dst, b.RandaoMixes[ii][:]...)
 	}
 
-	// Field (14) 'Slashings'
+	// Field (15) 'Slashings'
 	if size := len(b.Slashings); size > 1099511627776 {
 		err = ssz.ErrListTooBigFn("BeaconState.Slashings", size, 1099511627776)
 		return
@@ -160,12 +175,12 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 300 {
+	if size < 304 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o4, o5, o8, o9, o10, o11, o14 uint64
+	var o4, o5, o7, o9, o10, o11, o12, o15 uint64
 
 	// Field (0) 'GenesisValidatorsRoot'
 	copy(b.GenesisValidatorsRoot[:], buf[0:32])
@@ -194,7 +209,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o4 < 300 {
+	if o4 < 304 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -211,42 +226,47 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		return err
 	}
 
-	// Field (7) 'Eth1DepositIndex'
-	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[248:256])
-
-	// Offset (8) 'LatestExecutionPayloadHeader'
-	if o8 = ssz.ReadOffset(buf[256:260]); o8 > size || o5 > o8 {
+	// Offset (7) 'Eth1DataVotes'
+	if o7 = ssz.ReadOffset(buf[248:252]); o7 > size || o5 > o7 {
 		return ssz.ErrOffset
 	}
 
-	// Offset (9) 'Validators'
-	if o9 = ssz.ReadOffset(buf[260:264]); o9 > size || o8 > o9 {
+	// Field (8) 'Eth1DepositIndex'
+	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[252:260])
+
+	// Offset (9) 'LatestExecutionPayloadHeader'
+	if o9 = ssz.ReadOffset(buf[260:264]); o9 > size || o7 > o9 {
 		return ssz.ErrOffset
 	}
 
-	// Offset (10) 'Balances'
+	// Offset (10) 'Validators'
 	if o10 = ssz.ReadOffset(buf[264:268]); o10 > size || o9 > o10 {
 		return ssz.ErrOffset
 	}
 
-	// Offset (11) 'RandaoMixes'
+	// Offset (11) 'Balances'
 	if o11 = ssz.ReadOffset(buf[268:272]); o11 > size || o10 > o11 {
 		return ssz.ErrOffset
 	}
 
-	// Field (12) 'NextWithdrawalIndex'
-	b.NextWithdrawalIndex = ssz.UnmarshallUint64(buf[272:280])
+	// Offset (12) 'RandaoMixes'
+	if o12 = ssz.ReadOffset(buf[272:276]); o12 > size || o11 > o12 {
+		return ssz.ErrOffset
+	}
+
+	// Field (13) 'NextWithdrawalIndex'
+	b.NextWithdrawalIndex = ssz.UnmarshallUint64(buf[276:284])
 
-	// Field (13) 'NextWithdrawalValidatorIndex'
-	b.NextWithdrawalValidatorIndex = math.ValidatorIndex(ssz.UnmarshallUint64(buf[280:288]))
+	// Field (14) 'NextWithdrawalValidatorIndex'
+	b.NextWithdrawalValidatorIndex = math.ValidatorIndex(ssz.UnmarshallUint64(buf[284:292]))
 
-	// Offset (14) 'Slashings'
-	if o14 = ssz.ReadOffset(buf[288:292]); o14 > size || o11 > o14 {
+	// Offset (15) 'Slashings'
+	if o15 = ssz.ReadOffset(buf[292:296]); o15 > size || o12 > o15 {
 		return ssz.ErrOffset
 	}
 
-	// Field (15) 'TotalSlashing'
-	b.TotalSlashing = math.Gwei(ssz.UnmarshallUint64(buf[292:300]))
+	// Field (16) 'TotalSlashing'
+	b.TotalSlashing = math.Gwei(ssz.UnmarshallUint64(buf[296:304]))
 
 	// Field (4) 'BlockRoots'
 	{
@@ -263,7 +283,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 
 	// Field (5) 'StateRoots'
 	{
-		buf = tail[o5:o8]
+		buf = tail[o5:o7]
 		num, err := ssz.DivideInt2(len(buf), 32, 8192)
 		if err != nil {
 			return err
@@ -274,9 +294,27 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (8) 'LatestExecutionPayloadHeader'
+	// Field (7) 'Eth1DataVotes'
+	{
+		buf = tail[o7:o9]
+		num, err := ssz.DivideInt2(len(buf), 72, 2048)
+		if err != nil {
+			return err
+		}
+		b.Eth1DataVotes = make([]*types.Eth1Data, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Eth1DataVotes[ii] == nil {
+				b.Eth1DataVotes[ii] = new(types.Eth1Data)
+			}
+			if err = b.Eth1DataVotes[ii].UnmarshalSSZ(buf[ii*72 : (ii+1)*72]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (9) 'LatestExecutionPayloadHeader'
 	{
-		buf = tail[o8:o9]
+		buf = tail[o9:o10]
 		if b.LatestExecutionPayloadHeader == nil {
 			b.LatestExecutionPayloadHeader = new(types.ExecutionPayloadHeaderDeneb)
 		}
@@ -285,9 +323,9 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (9) 'Validators'
+	// Field (10) 'Validators'
 	{
-		buf = tail[o9:o10]
+		buf = tail[o10:o11]
 		num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
 		if err != nil {
 			return err
@@ -303,9 +341,9 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (10) 'Balances'
+	// Field (11) 'Balances'
 	{
-		buf = tail[o10:o11]
+		buf = tail[o11:o12]
 		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
 		if err != nil {
 			return err
@@ -316,9 +354,9 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (11) 'RandaoMixes'
+	// Field (12) 'RandaoMixes'
 	{
-		buf = tail[o11:o14]
+		buf = tail[o12:o15]
 		num, err := ssz.DivideInt2(len(buf), 32, 65536)
 		if err != nil {
 			return err
@@ -329,9 +367,9 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (14) 'Slashings'
+	// Field (15) 'Slashings'
 	{
-		buf = tail[o14:]
+		buf = tail[o15:]
 		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
 		if err != nil {
 			return err
@@ -346,7 +384,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 
 // SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
 func (b *BeaconState) SizeSSZ() (size int) {
-	size = 300
+	size = 304
 
 	// Field (4) 'BlockRoots'
 	size += len(b.BlockRoots) * 32
@@ -354,22 +392,25 @@ func (b *BeaconState) SizeSSZ() (size int) {
 	// Field (5) 'StateRoots'
 	size += len(b.StateRoots) * 32
 
-	// Field (8) 'LatestExecutionPayloadHeader'
+	// Field (7) 'Eth1DataVotes'
+	size += len(b.Eth1DataVotes) * 72
+
+	// Field (9) 'LatestExecutionPayloadHeader'
 	if b.LatestExecutionPayloadHeader == nil {
 		b.LatestExecutionPayloadHeader = new(types.ExecutionPayloadHeaderDeneb)
 	}
 	size += b.LatestExecutionPayloadHeader.SizeSSZ()
 
-	// Field (9) 'Validators'
+	// Field (10) 'Validators'
 	size += len(b.Validators) * 121
 
-	// Field (10) 'Balances'
+	// Field (11) 'Balances'
 	size += len(b.Balances) * 8
 
-	// Field (11) 'RandaoMixes'
+	// Field (12) 'RandaoMixes'
 	size += len(b.RandaoMixes) * 32
 
-	// Field (14) 'Slashings'
+	// Field (15) 'Slashings'
 	size += len(b.Slashings) * 8
 
 	return
@@ -442,15 +483,31 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		return
 	}
 
-	// Field (7) 'Eth1DepositIndex'
+	// Field (7) 'Eth1DataVotes'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Eth1DataVotes))
+		if num > 2048 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Eth1DataVotes {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2048)
+	}
+
+	// Field (8) 'Eth1DepositIndex'
 	hh.PutUint64(b.Eth1DepositIndex)
 
-	// Field (8) 'LatestExecutionPayloadHeader'
+	// Field (9) 'LatestExecutionPayloadHeader'
 	if err = b.LatestExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (9) 'Validators'
+	// Field (10) 'Validators'
 	{
 		subIndx := hh.Index()
 		num := uint64(len(b.Validators))
@@ -466,7 +523,7 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
 	}
 
-	// Field (10) 'Balances'
+	// Field (11) 'Balances'
 	{
 		if size := len(b.Balances); size > 1099511627776 {
 			err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
@@ -481,7 +538,7 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
 	}
 
-	// Field (11) 'RandaoMixes'
+	// Field (12) 'RandaoMixes'
 	{
 		if size := len(b.RandaoMixes); size > 65536 {
 			err = ssz.ErrListTooBigFn("BeaconState.RandaoMixes", size, 65536)
@@ -495,13 +552,13 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		hh.MerkleizeWithMixin(subIndx, numItems, 65536)
 	}
 
-	// Field (12) 'NextWithdrawalIndex'
+	// Field (13) 'NextWithdrawalIndex'
 	hh.PutUint64(b.NextWithdrawalIndex)
 
-	// Field (13) 'NextWithdrawalValidatorIndex'
+	// Field (14) 'NextWithdrawalValidatorIndex'
 	hh.PutUint64(uint64(b.NextWithdrawalValidatorIndex))
 
-	// Field (14) 'Slashings'
+	// Field (15) 'Slashings'
 	{
 		if size := len(b.Slashings); size > 1099511627776 {
 			err = ssz.ErrListTooBigFn("BeaconState.Slashings", size, 1099511627776)
@@ -516,7 +573,7 @@ func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
 	}
 
-	// Field (15) 'TotalSlashing'
+	// Field (16) 'TotalSlashing'
 	hh.PutUint64(uint64(b.TotalSlashing))
 
 	hh.Merkleize(indx)
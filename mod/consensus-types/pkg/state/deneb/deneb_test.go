@@ -40,12 +40,13 @@ import (
 func generateValidBeaconState() *deneb.BeaconState {
 	var byteArray [256]byte
 	return &deneb.BeaconState{
-		BlockRoots:  []common.Root{},
-		StateRoots:  []common.Root{},
-		Validators:  []*types.Validator{},
-		Balances:    []uint64{},
-		RandaoMixes: []common.Bytes32{},
-		Slashings:   []uint64{},
+		BlockRoots:    []common.Root{},
+		StateRoots:    []common.Root{},
+		Eth1DataVotes: []*types.Eth1Data{},
+		Validators:    []*types.Validator{},
+		Balances:      []uint64{},
+		RandaoMixes:   []common.Bytes32{},
+		Slashings:     []uint64{},
 		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeaderDeneb{
 			LogsBloom: byteArray[:],
 			ExtraData: []byte{},
@@ -122,6 +123,17 @@ func TestBeaconState_MarshalSSZFields(t *testing.T) {
 	_, err = state.MarshalSSZ()
 	require.NoError(t, err)
 
+	// Test Eth1DataVotes field
+	state.Eth1DataVotes = make([]*types.Eth1Data, 2049) // Exceeding the limit
+	_, err = state.MarshalSSZ()
+	require.Error(t, err)
+	state.Eth1DataVotes = make([]*types.Eth1Data, 2048) // Within the limit
+	for i := range state.Eth1DataVotes {
+		state.Eth1DataVotes[i] = &types.Eth1Data{}
+	}
+	_, err = state.MarshalSSZ()
+	require.NoError(t, err)
+
 	// Test LatestExecutionPayloadHeader field
 	state.LatestExecutionPayloadHeader = &types.ExecutionPayloadHeaderDeneb{
 		LogsBloom: make([]byte, 256), // Initialize LogsBloom with 256 bytes
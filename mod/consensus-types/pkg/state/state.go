@@ -59,6 +59,7 @@ func (st *BeaconStateMarshallable[
 	blockRoots []common.Root,
 	stateRoots []common.Root,
 	eth1Data Eth1DataT,
+	eth1DataVotes []Eth1DataT,
 	eth1DepositIndex uint64,
 	latestExecutionPayloadHeader ExecutionPayloadHeaderT,
 	validators []ValidatorT,
@@ -100,6 +101,8 @@ func (st *BeaconStateMarshallable[
 					InnerExecutionPayloadHeader.(*types.ExecutionPayloadHeaderDeneb),
 				Eth1Data: reflect.ValueOf(eth1Data).
 					Interface().(*types.Eth1Data),
+				Eth1DataVotes: reflect.ValueOf(eth1DataVotes).
+					Interface().([]*types.Eth1Data),
 				Eth1DepositIndex: eth1DepositIndex,
 				Validators: reflect.ValueOf(validators).
 					Interface().([]*types.Validator),
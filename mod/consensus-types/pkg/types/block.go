@@ -151,3 +151,21 @@ func (b BeaconBlockDeneb) GetHeader() *BeaconBlockHeader {
 		BodyRoot: bodyRoot,
 	}
 }
+
+// BlindedHashTreeRoot returns the hash tree root of the blinded block, i.e.
+// the block with its execution payload replaced by the execution payload
+// header with the given root. Proposers sign this root when the payload is
+// built by an external builder.
+func (b *BeaconBlockDeneb) BlindedHashTreeRoot(
+	payloadHeaderRoot common.Root,
+) (common.Root, error) {
+	bodyRoot, err := b.Body.BlindedHashTreeRoot(payloadHeaderRoot)
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	return (&BeaconBlockHeader{
+		BeaconBlockHeaderBase: b.BeaconBlockHeaderBase,
+		BodyRoot:              bodyRoot,
+	}).HashTreeRoot()
+}
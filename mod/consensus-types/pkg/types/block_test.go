@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
@@ -78,6 +79,8 @@ func TestEmptyBeaconBlockInvalidForkVersion(t *testing.T) {
 func TestBeaconBlockFromSSZ(t *testing.T) {
 	originalBlock := generateValidBeaconBlockDeneb()
 
+	originalBlock.Body.ProposerSlashings = []*types.ProposerSlashing{}
+	originalBlock.Body.VoluntaryExits = []*types.SignedVoluntaryExit{}
 	originalBlock.Body.Deposits = []*types.Deposit{}
 
 	sszBlock, err := originalBlock.MarshalSSZ()
@@ -128,6 +131,8 @@ func TestBeaconBlockDeneb(t *testing.T) {
 
 func TestBeaconBlockDeneb_MarshalUnmarshalSSZ(t *testing.T) {
 	block := *generateValidBeaconBlockDeneb()
+	block.Body.ProposerSlashings = []*types.ProposerSlashing{}
+	block.Body.VoluntaryExits = []*types.SignedVoluntaryExit{}
 	block.Body.Deposits = []*types.Deposit{}
 
 	sszBlock, err := block.MarshalSSZ()
@@ -211,3 +216,37 @@ func TestBeaconBlockDeneb_GetTree(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, tree)
 }
+
+func TestBeaconBlockDeneb_BlindedHashTreeRoot(t *testing.T) {
+	block := generateValidBeaconBlockDeneb()
+	block.Body.BlobKzgCommitments = []eip4844.KZGCommitment{{1}, {2}}
+	payloadRoot, err := block.Body.ExecutionPayload.HashTreeRoot()
+	require.NoError(t, err)
+
+	// The blinded block committing to the payload of the block has the
+	// root of the block itself.
+	blindedRoot, err := block.BlindedHashTreeRoot(payloadRoot)
+	require.NoError(t, err)
+	blockRoot, err := block.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, common.Root(blockRoot), blindedRoot)
+
+	otherRoot, err := block.BlindedHashTreeRoot(common.Root{1})
+	require.NoError(t, err)
+	require.NotEqual(t, blindedRoot, otherRoot)
+}
+
+func TestBeaconBlockDeneb_BlindedHashTreeRootWithoutPayload(t *testing.T) {
+	block := generateValidBeaconBlockDeneb()
+	payloadRoot, err := block.Body.ExecutionPayload.HashTreeRoot()
+	require.NoError(t, err)
+	expected, err := block.HashTreeRoot()
+	require.NoError(t, err)
+
+	// Blinded blocks are built before the payload is revealed.
+	block.Body.ExecutionPayload = nil
+	blindedRoot, err := block.BlindedHashTreeRoot(payloadRoot)
+	require.NoError(t, err)
+	require.Equal(t, common.Root(expected), blindedRoot)
+	require.Nil(t, block.Body.ExecutionPayload)
+}
@@ -40,14 +40,18 @@ import (
 const (
 	// BodyLengthDeneb is the number of fields in the BeaconBlockBodyDeneb
 	// struct.
-	BodyLengthDeneb uint64 = 6
+	BodyLengthDeneb uint64 = 8
 
 	// KZGPosition is the position of BlobKzgCommitments in the block body.
 	KZGPositionDeneb = BodyLengthDeneb - 1
 
+	// ExecutionPayloadPositionDeneb is the position of ExecutionPayload in
+	// the block body.
+	ExecutionPayloadPositionDeneb = KZGPositionDeneb - 1
+
 	// KZGMerkleIndexDeneb is the merkle index of BlobKzgCommitments' root
 	// in the merkle tree built from the block body.
-	KZGMerkleIndexDeneb = 26
+	KZGMerkleIndexDeneb = 30
 
 	// Size of LogsBloom in bytes.
 	LogsBloomSize = 256
@@ -100,8 +104,13 @@ type BeaconBlockBodyBase struct {
 	Eth1Data *Eth1Data
 	// Graffiti is for a fun message or meme.
 	Graffiti [32]byte `ssz-size:"32"`
+	// ProposerSlashings is the list of proposer slashings included in the
+	// body.
+	ProposerSlashings []*ProposerSlashing `              ssz-max:"16"`
 	// Deposits is the list of deposits included in the body.
 	Deposits []*Deposit `              ssz-max:"16"`
+	// VoluntaryExits is the list of voluntary exits included in the body.
+	VoluntaryExits []*SignedVoluntaryExit `              ssz-max:"16"`
 }
 
 // GetRandaoReveal returns the RandaoReveal of the Body.
@@ -135,6 +144,19 @@ func (b *BeaconBlockBodyBase) SetGraffiti(graffiti common.Bytes32) {
 	b.Graffiti = graffiti
 }
 
+// GetProposerSlashings returns the ProposerSlashings of the
+// BeaconBlockBodyBase.
+func (b *BeaconBlockBodyBase) GetProposerSlashings() []*ProposerSlashing {
+	return b.ProposerSlashings
+}
+
+// SetProposerSlashings sets the ProposerSlashings of the BeaconBlockBodyBase.
+func (b *BeaconBlockBodyBase) SetProposerSlashings(
+	proposerSlashings []*ProposerSlashing,
+) {
+	b.ProposerSlashings = proposerSlashings
+}
+
 // GetDeposits returns the Deposits of the BeaconBlockBodyBase.
 func (b *BeaconBlockBodyBase) GetDeposits() []*Deposit {
 	return b.Deposits
@@ -145,10 +167,22 @@ func (b *BeaconBlockBodyBase) SetDeposits(deposits []*Deposit) {
 	b.Deposits = deposits
 }
 
+// GetVoluntaryExits returns the VoluntaryExits of the BeaconBlockBodyBase.
+func (b *BeaconBlockBodyBase) GetVoluntaryExits() []*SignedVoluntaryExit {
+	return b.VoluntaryExits
+}
+
+// SetVoluntaryExits sets the VoluntaryExits of the BeaconBlockBodyBase.
+func (b *BeaconBlockBodyBase) SetVoluntaryExits(
+	voluntaryExits []*SignedVoluntaryExit,
+) {
+	b.VoluntaryExits = voluntaryExits
+}
+
 // BeaconBlockBodyDeneb represents the body of a beacon block in the Deneb
 // chain.
 //
-//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./body.go -objs BeaconBlockBodyDeneb -include ../../../primitives/pkg/crypto,./payload.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./deposit.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,./withdrawal_credentials.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output body.ssz.go
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./body.go -objs BeaconBlockBodyDeneb -include ../../../primitives/pkg/crypto,./payload.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./header.go,./proposer_slashing.go,./deposit.go,./voluntary_exit.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,./withdrawal_credentials.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output body.ssz.go
 type BeaconBlockBodyDeneb struct {
 	BeaconBlockBodyBase
 	// ExecutionPayload is the execution payload of the body.
@@ -220,12 +254,24 @@ func (b *BeaconBlockBodyDeneb) GetTopLevelRoots() ([][32]byte, error) {
 
 	layer[2] = b.GetGraffiti()
 
-	layer[3], err = Deposits(b.GetDeposits()).HashTreeRoot()
+	layer[3], err = ProposerSlashings(
+		b.GetProposerSlashings(),
+	).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[4], err = Deposits(b.GetDeposits()).HashTreeRoot()
 	if err != nil {
 		return nil, err
 	}
 
-	layer[4], err = b.GetExecutionPayload().HashTreeRoot()
+	layer[5], err = VoluntaryExits(b.GetVoluntaryExits()).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[6], err = b.GetExecutionPayload().HashTreeRoot()
 	if err != nil {
 		return nil, err
 	}
@@ -235,6 +281,45 @@ func (b *BeaconBlockBodyDeneb) GetTopLevelRoots() ([][32]byte, error) {
 	return *(*[][32]byte)(unsafe.Pointer(&layer)), nil
 }
 
+// BlindedHashTreeRoot returns the hash tree root of the blinded body, i.e.
+// the body with its execution payload replaced by the execution payload
+// header with the given root.
+func (b *BeaconBlockBodyDeneb) BlindedHashTreeRoot(
+	payloadHeaderRoot common.Root,
+) (common.Root, error) {
+	// The payload of a blinded body is yet to be revealed, its root is
+	// replaced below anyway.
+	body := *b
+	if body.ExecutionPayload == nil {
+		body.ExecutionPayload = &ExecutableDataDeneb{
+			LogsBloom: make([]byte, LogsBloomSize),
+		}
+	}
+
+	tree, err := body.GetTree()
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	// The fields of the body are the leaves of the tree, which start at
+	// the generalized index BodyLengthDeneb.
+	layer := make([][32]byte, BodyLengthDeneb)
+	for i := range layer {
+		//#nosec:G701 // the body only has a handful of fields.
+		node, nodeErr := tree.Get(int(BodyLengthDeneb) + i)
+		if nodeErr != nil {
+			return common.Root{}, nodeErr
+		}
+		copy(layer[i][:], node.Hash())
+	}
+	layer[ExecutionPayloadPositionDeneb] = payloadHeaderRoot
+
+	root, err := merkleizer.New[
+		common.ChainSpec, [32]byte, common.Root,
+	]().Merkleize(layer)
+	return common.Root(root), err
+}
+
 // Length returns the number of fields in the BeaconBlockBodyDeneb struct.
 func (b *BeaconBlockBodyDeneb) Length() uint64 {
 	return BodyLengthDeneb
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: e293cae651a8337fcac72054a838d6f4cc17805bde0c039d08852acf34f571e1
+// Hash: 18d113e2a87bd85a77f6b73a62b33e2c0f5a913c5a214ffbade9da420cfc9578
 // Version: 0.1.3
 package types
 
@@ -16,7 +16,7 @@ func (b *BeaconBlockBodyDeneb) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the BeaconBlockBodyDeneb object to a target array
 func (b *BeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(212)
+	offset := int(220)
 
 	// Field (0) 'RandaoReveal'
 	dst = append(dst, b.RandaoReveal[:]...)
@@ -32,21 +32,40 @@ func (b *BeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error)
 	// Field (2) 'Graffiti'
 	dst = append(dst, b.Graffiti[:]...)
 
-	// Offset (3) 'Deposits'
+	// Offset (3) 'ProposerSlashings'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Deposits) * 192
+	offset += len(b.ProposerSlashings) * 416
 
-	// Offset (4) 'ExecutionPayload'
+	// Offset (4) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 1248
+
+	// Offset (5) 'VoluntaryExits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.VoluntaryExits) * 112
+
+	// Offset (6) 'ExecutionPayload'
 	dst = ssz.WriteOffset(dst, offset)
 	if b.ExecutionPayload == nil {
 		b.ExecutionPayload = new(ExecutableDataDeneb)
 	}
 	offset += b.ExecutionPayload.SizeSSZ()
 
-	// Offset (5) 'BlobKzgCommitments'
+	// Offset (7) 'BlobKzgCommitments'
 	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (3) 'Deposits'
+	// Field (3) 'ProposerSlashings'
+	if size := len(b.ProposerSlashings); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyDeneb.ProposerSlashings", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'Deposits'
 	if size := len(b.Deposits); size > 16 {
 		err = ssz.ErrListTooBigFn("BeaconBlockBodyDeneb.Deposits", size, 16)
 		return
@@ -57,12 +76,23 @@ func (b *BeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error)
 		}
 	}
 
-	// Field (4) 'ExecutionPayload'
+	// Field (5) 'VoluntaryExits'
+	if size := len(b.VoluntaryExits); size > 16 {
+		err = ssz.ErrListTooBigFn("BeaconBlockBodyDeneb.VoluntaryExits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'ExecutionPayload'
 	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
-	// Field (5) 'BlobKzgCommitments'
+	// Field (7) 'BlobKzgCommitments'
 	if size := len(b.BlobKzgCommitments); size > 16 {
 		err = ssz.ErrListTooBigFn("BeaconBlockBodyDeneb.BlobKzgCommitments", size, 16)
 		return
@@ -78,12 +108,12 @@ func (b *BeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error)
 func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 212 {
+	if size < 220 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o3, o4, o5 uint64
+	var o3, o4, o5, o6, o7 uint64
 
 	// Field (0) 'RandaoReveal'
 	copy(b.RandaoReveal[:], buf[0:96])
@@ -99,29 +129,57 @@ func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 	// Field (2) 'Graffiti'
 	copy(b.Graffiti[:], buf[168:200])
 
-	// Offset (3) 'Deposits'
+	// Offset (3) 'ProposerSlashings'
 	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
 		return ssz.ErrOffset
 	}
 
-	if o3 < 212 {
+	if o3 < 220 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
-	// Offset (4) 'ExecutionPayload'
+	// Offset (4) 'Deposits'
 	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
 		return ssz.ErrOffset
 	}
 
-	// Offset (5) 'BlobKzgCommitments'
+	// Offset (5) 'VoluntaryExits'
 	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
 		return ssz.ErrOffset
 	}
 
-	// Field (3) 'Deposits'
+	// Offset (6) 'ExecutionPayload'
+	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (7) 'BlobKzgCommitments'
+	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'ProposerSlashings'
 	{
 		buf = tail[o3:o4]
-		num, err := ssz.DivideInt2(len(buf), 192, 16)
+		num, err := ssz.DivideInt2(len(buf), 416, 16)
+		if err != nil {
+			return err
+		}
+		b.ProposerSlashings = make([]*ProposerSlashing, num)
+		for ii := 0; ii < num; ii++ {
+			if b.ProposerSlashings[ii] == nil {
+				b.ProposerSlashings[ii] = new(ProposerSlashing)
+			}
+			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'Deposits'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DivideInt2(len(buf), 1248, 16)
 		if err != nil {
 			return err
 		}
@@ -130,15 +188,33 @@ func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 			if b.Deposits[ii] == nil {
 				b.Deposits[ii] = new(Deposit)
 			}
-			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*192 : (ii+1)*192]); err != nil {
+			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1248 : (ii+1)*1248]); err != nil {
 				return err
 			}
 		}
 	}
 
-	// Field (4) 'ExecutionPayload'
+	// Field (5) 'VoluntaryExits'
 	{
-		buf = tail[o4:o5]
+		buf = tail[o5:o6]
+		num, err := ssz.DivideInt2(len(buf), 112, 16)
+		if err != nil {
+			return err
+		}
+		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.VoluntaryExits[ii] == nil {
+				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+			}
+			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (6) 'ExecutionPayload'
+	{
+		buf = tail[o6:o7]
 		if b.ExecutionPayload == nil {
 			b.ExecutionPayload = new(ExecutableDataDeneb)
 		}
@@ -147,9 +223,9 @@ func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (5) 'BlobKzgCommitments'
+	// Field (7) 'BlobKzgCommitments'
 	{
-		buf = tail[o5:]
+		buf = tail[o7:]
 		num, err := ssz.DivideInt2(len(buf), 48, 16)
 		if err != nil {
 			return err
@@ -164,18 +240,24 @@ func (b *BeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
 
 // SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyDeneb object
 func (b *BeaconBlockBodyDeneb) SizeSSZ() (size int) {
-	size = 212
+	size = 220
+
+	// Field (3) 'ProposerSlashings'
+	size += len(b.ProposerSlashings) * 416
 
-	// Field (3) 'Deposits'
-	size += len(b.Deposits) * 192
+	// Field (4) 'Deposits'
+	size += len(b.Deposits) * 1248
 
-	// Field (4) 'ExecutionPayload'
+	// Field (5) 'VoluntaryExits'
+	size += len(b.VoluntaryExits) * 112
+
+	// Field (6) 'ExecutionPayload'
 	if b.ExecutionPayload == nil {
 		b.ExecutionPayload = new(ExecutableDataDeneb)
 	}
 	size += b.ExecutionPayload.SizeSSZ()
 
-	// Field (5) 'BlobKzgCommitments'
+	// Field (7) 'BlobKzgCommitments'
 	size += len(b.BlobKzgCommitments) * 48
 
 	return
@@ -204,7 +286,23 @@ func (b *BeaconBlockBodyDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (2) 'Graffiti'
 	hh.PutBytes(b.Graffiti[:])
 
-	// Field (3) 'Deposits'
+	// Field (3) 'ProposerSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.ProposerSlashings))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.ProposerSlashings {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (4) 'Deposits'
 	{
 		subIndx := hh.Index()
 		num := uint64(len(b.Deposits))
@@ -220,12 +318,28 @@ func (b *BeaconBlockBodyDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 		hh.MerkleizeWithMixin(subIndx, num, 16)
 	}
 
-	// Field (4) 'ExecutionPayload'
+	// Field (5) 'VoluntaryExits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.VoluntaryExits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (6) 'ExecutionPayload'
 	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (5) 'BlobKzgCommitments'
+	// Field (7) 'BlobKzgCommitments'
 	{
 		if size := len(b.BlobKzgCommitments); size > 16 {
 			err = ssz.ErrListTooBigFn("BeaconBlockBodyDeneb.BlobKzgCommitments", size, 16)
@@ -21,6 +21,9 @@
 package types
 
 import (
+	"encoding/json"
+
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -31,7 +34,7 @@ import (
 // Deposit into the consensus layer from the deposit contract in the execution
 // layer.
 //
-//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./deposit.go -objs Deposit -include ../../../primitives/pkg/common,./withdrawal_credentials.go,../../../primitives/pkg/math,../../../primitives/pkg/bytes,../../../primitives/pkg/crypto,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output deposit.ssz.go
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./deposit.go -objs Deposit,DepositData -include ../../../primitives/pkg/common,./withdrawal_credentials.go,../../../primitives/pkg/math,../../../primitives/pkg/bytes,../../../primitives/pkg/crypto,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output deposit.ssz.go
 //nolint:lll // struct tags.
 type Deposit struct {
 	// Public key of the validator specified in the deposit.
@@ -45,6 +48,23 @@ type Deposit struct {
 	Signature crypto.BLSSignature `json:"signature"   ssz-max:"96"`
 	// Index of the deposit in the deposit contract.
 	Index uint64 `json:"index"`
+	// Proof is the Merkle proof of the deposit data against the deposit
+	// root, including the length mix-in.
+	Proof []common.Root `json:"proof"       ssz-size:"33,32"`
+}
+
+// DepositData is the data of a deposit committed to by the leaves of the
+// deposit tree.
+type DepositData struct {
+	// Public key of the validator specified in the deposit.
+	Pubkey crypto.BLSPubkey `json:"pubkey"      ssz-max:"48"`
+	// A staking credentials with
+	// 1 byte prefix + 11 bytes padding + 20 bytes address = 32 bytes.
+	Credentials WithdrawalCredentials `json:"credentials"              ssz-size:"32"`
+	// Deposit amount in gwei.
+	Amount math.Gwei `json:"amount"`
+	// Signature of the deposit data.
+	Signature crypto.BLSSignature `json:"signature"   ssz-max:"96"`
 }
 
 // NewDeposit creates a new Deposit instance.
@@ -61,6 +81,9 @@ func NewDeposit(
 		Amount:      amount,
 		Signature:   signature,
 		Index:       index,
+		Proof: make(
+			[]common.Root, constants.DepositContractTreeDepth+1,
+		),
 	}
 }
 
@@ -77,6 +100,30 @@ func (d *Deposit) New(
 	)
 }
 
+// UnmarshalJSON unmarshals the Deposit from JSON. A deposit without a proof,
+// such as a genesis deposit, is given an empty proof so that it can be SSZ
+// encoded.
+func (d *Deposit) UnmarshalJSON(input []byte) error {
+	type deposit Deposit
+	if err := json.Unmarshal(input, (*deposit)(d)); err != nil {
+		return err
+	}
+
+	switch len(d.Proof) {
+	case 0:
+		d.Proof = make(
+			[]common.Root, constants.DepositContractTreeDepth+1,
+		)
+	case constants.DepositContractTreeDepth + 1:
+	default:
+		return errors.Wrapf(
+			ErrInvalidDepositProof, "expected %d roots, got %d",
+			constants.DepositContractTreeDepth+1, len(d.Proof),
+		)
+	}
+	return nil
+}
+
 // Deposits is a typealias for a list of Deposits.
 type Deposits []*Deposit
 
@@ -127,6 +174,27 @@ func (d *Deposit) GetSignature() crypto.BLSSignature {
 	return d.Signature
 }
 
+// GetProof returns the Merkle proof of the deposit.
+func (d *Deposit) GetProof() []common.Root {
+	return d.Proof
+}
+
+// SetProof sets the Merkle proof of the deposit.
+func (d *Deposit) SetProof(proof []common.Root) {
+	d.Proof = proof
+}
+
+// GetDataRoot returns the hash tree root of the deposit data, which is the
+// leaf of the deposit in the deposit tree.
+func (d *Deposit) GetDataRoot() (common.Root, error) {
+	return (&DepositData{
+		Pubkey:      d.Pubkey,
+		Credentials: d.Credentials,
+		Amount:      d.Amount,
+		Signature:   d.Signature,
+	}).HashTreeRoot()
+}
+
 // GetWithdrawalCredentials returns the staking credentials of the deposit.
 func (d *Deposit) GetWithdrawalCredentials() WithdrawalCredentials {
 	return d.Credentials
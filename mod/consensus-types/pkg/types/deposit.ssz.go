@@ -1,9 +1,10 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 1894a0a3ed0d8d9ae610398b43919c1bfa3d279b12cde69a7fc139dd6ac9310d
+// Hash: 2be4f3d69b39ee92705f097e271b8d7b26c0d8b378f81c409567e755096ef52a
 // Version: 0.1.3
 package types
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	ssz "github.com/ferranbt/fastssz"
 )
@@ -32,6 +33,15 @@ func (d *Deposit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	// Field (4) 'Index'
 	dst = ssz.MarshalUint64(dst, d.Index)
 
+	// Field (5) 'Proof'
+	if size := len(d.Proof); size != 33 {
+		err = ssz.ErrVectorLengthFn("Deposit.Proof", size, 33)
+		return
+	}
+	for ii := 0; ii < 33; ii++ {
+		dst = append(dst, d.Proof[ii][:]...)
+	}
+
 	return
 }
 
@@ -39,7 +49,7 @@ func (d *Deposit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (d *Deposit) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 192 {
+	if size != 1248 {
 		return ssz.ErrSize
 	}
 
@@ -58,12 +68,18 @@ func (d *Deposit) UnmarshalSSZ(buf []byte) error {
 	// Field (4) 'Index'
 	d.Index = ssz.UnmarshallUint64(buf[184:192])
 
+	// Field (5) 'Proof'
+	d.Proof = make([]common.Root, 33)
+	for ii := 0; ii < 33; ii++ {
+		copy(d.Proof[ii][:], buf[192:1248][ii*32:(ii+1)*32])
+	}
+
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the Deposit object
 func (d *Deposit) SizeSSZ() (size int) {
-	size = 192
+	size = 1248
 	return
 }
 
@@ -91,6 +107,19 @@ func (d *Deposit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	// Field (4) 'Index'
 	hh.PutUint64(d.Index)
 
+	// Field (5) 'Proof'
+	{
+		if size := len(d.Proof); size != 33 {
+			err = ssz.ErrVectorLengthFn("Deposit.Proof", size, 33)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range d.Proof {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
 	hh.Merkleize(indx)
 	return
 }
@@ -99,3 +128,86 @@ func (d *Deposit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 func (d *Deposit) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(d)
 }
+
+// MarshalSSZ ssz marshals the DepositData object
+func (d *DepositData) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(d)
+}
+
+// MarshalSSZTo ssz marshals the DepositData object to a target array
+func (d *DepositData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Pubkey'
+	dst = append(dst, d.Pubkey[:]...)
+
+	// Field (1) 'Credentials'
+	dst = append(dst, d.Credentials[:]...)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, uint64(d.Amount))
+
+	// Field (3) 'Signature'
+	dst = append(dst, d.Signature[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the DepositData object
+func (d *DepositData) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 184 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Pubkey'
+	copy(d.Pubkey[:], buf[0:48])
+
+	// Field (1) 'Credentials'
+	copy(d.Credentials[:], buf[48:80])
+
+	// Field (2) 'Amount'
+	d.Amount = math.Gwei(ssz.UnmarshallUint64(buf[80:88]))
+
+	// Field (3) 'Signature'
+	copy(d.Signature[:], buf[88:184])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the DepositData object
+func (d *DepositData) SizeSSZ() (size int) {
+	size = 184
+	return
+}
+
+// HashTreeRoot ssz hashes the DepositData object
+func (d *DepositData) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
+
+// HashTreeRootWith ssz hashes the DepositData object with a hasher
+func (d *DepositData) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	hh.PutBytes(d.Pubkey[:])
+
+	// Field (1) 'Credentials'
+	hh.PutBytes(d.Credentials[:])
+
+	// Field (2) 'Amount'
+	hh.PutUint64(uint64(d.Amount))
+
+	// Field (3) 'Signature'
+	hh.PutBytes(d.Signature[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the DepositData object
+func (d *DepositData) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(d)
+}
@@ -21,10 +21,12 @@
 package types_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	ssz "github.com/ferranbt/fastssz"
@@ -45,6 +47,9 @@ func generateValidDeposit() *types.Deposit {
 		Amount:      amount,
 		Signature:   signature,
 		Index:       index,
+		Proof: make(
+			[]common.Root, constants.DepositContractTreeDepth+1,
+		),
 	}
 }
 
@@ -93,7 +98,7 @@ func TestDeposit_HashTreeRoot(t *testing.T) {
 func TestDeposit_SizeSSZ(t *testing.T) {
 	deposit := generateValidDeposit()
 
-	require.Equal(t, 192, deposit.SizeSSZ())
+	require.Equal(t, 1248, deposit.SizeSSZ())
 }
 
 func TestDeposit_HashTreeRootWith(t *testing.T) {
@@ -113,7 +118,7 @@ func TestDeposit_GetTree(t *testing.T) {
 
 func TestDeposit_UnmarshalSSZ_ErrSize(t *testing.T) {
 	// Create a byte slice of incorrect size
-	buf := make([]byte, 10) // size less than 192
+	buf := make([]byte, 10) // size less than 1248
 
 	var unmarshalledDeposit types.Deposit
 	err := unmarshalledDeposit.UnmarshalSSZ(buf)
@@ -149,4 +154,75 @@ func TestDeposit_Getters(t *testing.T) {
 	require.Equal(t, deposit.Amount, deposit.GetAmount())
 	require.Equal(t, deposit.Signature, deposit.GetSignature())
 	require.Equal(t, deposit.Index, deposit.GetIndex())
+	require.Equal(t, deposit.Proof, deposit.GetProof())
+}
+
+func TestDeposit_SetProof(t *testing.T) {
+	deposit := generateValidDeposit()
+	proof := make([]common.Root, constants.DepositContractTreeDepth+1)
+	proof[0] = common.Root{0x01}
+
+	deposit.SetProof(proof)
+	require.Equal(t, proof, deposit.GetProof())
+}
+
+func TestDeposit_GetDataRoot(t *testing.T) {
+	deposit := generateValidDeposit()
+	deposit.Pubkey = crypto.BLSPubkey{0x01}
+	deposit.Signature = crypto.BLSSignature{0x02}
+
+	dataRoot, err := deposit.GetDataRoot()
+	require.NoError(t, err)
+
+	expectedRoot, err := (&types.DepositData{
+		Pubkey:      deposit.Pubkey,
+		Credentials: deposit.Credentials,
+		Amount:      deposit.Amount,
+		Signature:   deposit.Signature,
+	}).HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, common.Root(expectedRoot), dataRoot)
+
+	// The leaf of the deposit commits to neither its index nor its proof.
+	deposit.Index++
+	deposit.Proof[0] = common.Root{0x03}
+	newDataRoot, err := deposit.GetDataRoot()
+	require.NoError(t, err)
+	require.Equal(t, dataRoot, newDataRoot)
+
+	// But it does commit to the deposit data.
+	deposit.Amount++
+	newDataRoot, err = deposit.GetDataRoot()
+	require.NoError(t, err)
+	require.NotEqual(t, dataRoot, newDataRoot)
+}
+
+func TestDeposit_UnmarshalJSON(t *testing.T) {
+	original := generateValidDeposit()
+	bz, err := json.Marshal(original)
+	require.NoError(t, err)
+
+	var deposit types.Deposit
+	require.NoError(t, json.Unmarshal(bz, &deposit))
+	require.Equal(t, original, &deposit)
+
+	// A deposit without a proof is given an empty one and can be encoded.
+	original.Proof = nil
+	bz, err = json.Marshal(original)
+	require.NoError(t, err)
+	deposit = types.Deposit{}
+	require.NoError(t, json.Unmarshal(bz, &deposit))
+	require.Len(t, deposit.Proof, constants.DepositContractTreeDepth+1)
+	_, err = deposit.MarshalSSZ()
+	require.NoError(t, err)
+	_, err = deposit.HashTreeRoot()
+	require.NoError(t, err)
+
+	// A deposit with a proof of the wrong depth is rejected.
+	original.Proof = make([]common.Root, constants.DepositContractTreeDepth)
+	bz, err = json.Marshal(original)
+	require.NoError(t, err)
+	require.ErrorIs(
+		t, json.Unmarshal(bz, &types.Deposit{}), types.ErrInvalidDepositProof,
+	)
 }
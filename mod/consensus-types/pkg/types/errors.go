@@ -27,6 +27,10 @@ var (
 	// match.
 	ErrDepositMessage = errors.New("invalid deposit message")
 
+	// ErrInvalidDepositProof is an error for when the Merkle proof of a
+	// deposit does not have the depth of the deposit tree.
+	ErrInvalidDepositProof = errors.New("invalid deposit proof length")
+
 	// ErrInvalidWithdrawalCredentials is an error for when the.
 	ErrInvalidWithdrawalCredentials = errors.New(
 		"invalid withdrawal credentials",
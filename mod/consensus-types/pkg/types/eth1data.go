@@ -49,7 +49,24 @@ func (e *Eth1Data) New(
 	return e
 }
 
+// GetDepositRoot returns the deposit root.
+func (e *Eth1Data) GetDepositRoot() common.Root {
+	return e.DepositRoot
+}
+
 // GetDepositCount returns the deposit count.
 func (e *Eth1Data) GetDepositCount() math.U64 {
 	return math.U64(e.DepositCount)
 }
+
+// GetBlockHash returns the block hash.
+func (e *Eth1Data) GetBlockHash() common.ExecutionHash {
+	return e.BlockHash
+}
+
+// Equals returns true if the Eth1Data is equal to the other.
+func (e *Eth1Data) Equals(other *Eth1Data) bool {
+	return e.DepositRoot == other.DepositRoot &&
+		e.DepositCount == other.DepositCount &&
+		e.BlockHash == other.BlockHash
+}
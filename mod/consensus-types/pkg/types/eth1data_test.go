@@ -98,3 +98,35 @@ func TestEth1Data_GetDepositCount(t *testing.T) {
 
 	require.Equal(t, uint64(10), count.Unwrap())
 }
+
+func TestEth1Data_GetBlockHash(t *testing.T) {
+	eth1Data := &types.Eth1Data{
+		DepositRoot:  common.Root{},
+		DepositCount: 10,
+		BlockHash:    common.ExecutionHash{0x01},
+	}
+
+	require.Equal(t, common.ExecutionHash{0x01}, eth1Data.GetBlockHash())
+}
+
+func TestEth1Data_Equals(t *testing.T) {
+	eth1Data := &types.Eth1Data{
+		DepositRoot:  common.Root{0x01},
+		DepositCount: 10,
+		BlockHash:    common.ExecutionHash{0x02},
+	}
+
+	other := *eth1Data
+	require.True(t, eth1Data.Equals(&other))
+
+	other.DepositCount = 11
+	require.False(t, eth1Data.Equals(&other))
+
+	other = *eth1Data
+	other.DepositRoot = common.Root{0x03}
+	require.False(t, eth1Data.Equals(&other))
+
+	other = *eth1Data
+	other.BlockHash = common.ExecutionHash{0x03}
+	require.False(t, eth1Data.Equals(&other))
+}
@@ -95,3 +95,16 @@ func (fd *ForkData) ComputeRandaoSigningRoot(
 	}
 	return signingRoot, nil
 }
+
+// ComputeSigningRoot computes the signing root of the object with the given
+// root over the domain of the given type.
+func (fd *ForkData) ComputeSigningRoot(
+	domainType common.DomainType,
+	objectRoot common.Root,
+) (common.Root, error) {
+	signingDomain, err := fd.ComputeDomain(domainType)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return ComputeSigningRoot(objectRoot, signingDomain)
+}
@@ -131,3 +131,24 @@ func TestNew(t *testing.T) {
 	require.Equal(t, currentVersion, newForkData.CurrentVersion)
 	require.Equal(t, genesisValidatorsRoot, newForkData.GenesisValidatorsRoot)
 }
+
+func TestForkData_ComputeSigningRoot(t *testing.T) {
+	fd := &types.ForkData{
+		CurrentVersion:        common.Version{1},
+		GenesisValidatorsRoot: common.Root{2},
+	}
+	domainType := common.DomainType{0, 0, 0, 0}
+	objectRoot := common.Root{3}
+
+	signingRoot, err := fd.ComputeSigningRoot(domainType, objectRoot)
+	require.NoError(t, err)
+
+	domain, err := fd.ComputeDomain(domainType)
+	require.NoError(t, err)
+	expected, err := (&types.SigningData{
+		ObjectRoot: objectRoot,
+		Domain:     domain,
+	}).HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, common.Root(expected), signingRoot)
+}
@@ -38,6 +38,8 @@ type RawBeaconBlockBody interface {
 // WriteOnlyBeaconBlockBody is the interface for a write-only beacon block body.
 type WriteOnlyBeaconBlockBody interface {
 	SetDeposits([]*Deposit)
+	SetProposerSlashings([]*ProposerSlashing)
+	SetVoluntaryExits([]*SignedVoluntaryExit)
 	SetEth1Data(*Eth1Data)
 	SetExecutionData(*ExecutionPayload) error
 	SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
@@ -53,6 +55,8 @@ type ReadOnlyBeaconBlockBody interface {
 
 	// Execution returns the execution data of the block.
 	GetDeposits() []*Deposit
+	GetProposerSlashings() []*ProposerSlashing
+	GetVoluntaryExits() []*SignedVoluntaryExit
 	GetEth1Data() *Eth1Data
 	GetGraffiti() common.Bytes32
 	GetRandaoReveal() crypto.BLSSignature
@@ -73,6 +77,7 @@ type RawBeaconBlock[BeaconBlockBodyT RawBeaconBlockBody] interface {
 	GetParentBlockRoot() common.Root
 	GetBody() BeaconBlockBodyT
 	GetHeader() *BeaconBlockHeader
+	BlindedHashTreeRoot(common.Root) (common.Root, error)
 }
 
 // executionPayloadBody is the interface for the execution data of a block.
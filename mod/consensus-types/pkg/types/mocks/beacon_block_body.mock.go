@@ -261,6 +261,53 @@ func (_c *BeaconBlockBody_GetGraffiti_Call) RunAndReturn(run func() bytes.B32) *
 	return _c
 }
 
+// GetProposerSlashings provides a mock function with given fields:
+func (_m *BeaconBlockBody) GetProposerSlashings() []*types.ProposerSlashing {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProposerSlashings")
+	}
+
+	var r0 []*types.ProposerSlashing
+	if rf, ok := ret.Get(0).(func() []*types.ProposerSlashing); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.ProposerSlashing)
+		}
+	}
+
+	return r0
+}
+
+// BeaconBlockBody_GetProposerSlashings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProposerSlashings'
+type BeaconBlockBody_GetProposerSlashings_Call struct {
+	*mock.Call
+}
+
+// GetProposerSlashings is a helper method to define mock.On call
+func (_e *BeaconBlockBody_Expecter) GetProposerSlashings() *BeaconBlockBody_GetProposerSlashings_Call {
+	return &BeaconBlockBody_GetProposerSlashings_Call{Call: _e.mock.On("GetProposerSlashings")}
+}
+
+func (_c *BeaconBlockBody_GetProposerSlashings_Call) Run(run func()) *BeaconBlockBody_GetProposerSlashings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BeaconBlockBody_GetProposerSlashings_Call) Return(_a0 []*types.ProposerSlashing) *BeaconBlockBody_GetProposerSlashings_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BeaconBlockBody_GetProposerSlashings_Call) RunAndReturn(run func() []*types.ProposerSlashing) *BeaconBlockBody_GetProposerSlashings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRandaoReveal provides a mock function with given fields:
 func (_m *BeaconBlockBody) GetRandaoReveal() bytes.B96 {
 	ret := _m.Called()
@@ -365,6 +412,53 @@ func (_c *BeaconBlockBody_GetTopLevelRoots_Call) RunAndReturn(run func() ([][32]
 	return _c
 }
 
+// GetVoluntaryExits provides a mock function with given fields:
+func (_m *BeaconBlockBody) GetVoluntaryExits() []*types.SignedVoluntaryExit {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVoluntaryExits")
+	}
+
+	var r0 []*types.SignedVoluntaryExit
+	if rf, ok := ret.Get(0).(func() []*types.SignedVoluntaryExit); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.SignedVoluntaryExit)
+		}
+	}
+
+	return r0
+}
+
+// BeaconBlockBody_GetVoluntaryExits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVoluntaryExits'
+type BeaconBlockBody_GetVoluntaryExits_Call struct {
+	*mock.Call
+}
+
+// GetVoluntaryExits is a helper method to define mock.On call
+func (_e *BeaconBlockBody_Expecter) GetVoluntaryExits() *BeaconBlockBody_GetVoluntaryExits_Call {
+	return &BeaconBlockBody_GetVoluntaryExits_Call{Call: _e.mock.On("GetVoluntaryExits")}
+}
+
+func (_c *BeaconBlockBody_GetVoluntaryExits_Call) Run(run func()) *BeaconBlockBody_GetVoluntaryExits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BeaconBlockBody_GetVoluntaryExits_Call) Return(_a0 []*types.SignedVoluntaryExit) *BeaconBlockBody_GetVoluntaryExits_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BeaconBlockBody_GetVoluntaryExits_Call) RunAndReturn(run func() []*types.SignedVoluntaryExit) *BeaconBlockBody_GetVoluntaryExits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HashTreeRoot provides a mock function with given fields:
 func (_m *BeaconBlockBody) HashTreeRoot() ([32]byte, error) {
 	ret := _m.Called()
@@ -772,6 +866,39 @@ func (_c *BeaconBlockBody_SetExecutionData_Call) RunAndReturn(run func(*types.Ex
 	return _c
 }
 
+// SetProposerSlashings provides a mock function with given fields: _a0
+func (_m *BeaconBlockBody) SetProposerSlashings(_a0 []*types.ProposerSlashing) {
+	_m.Called(_a0)
+}
+
+// BeaconBlockBody_SetProposerSlashings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProposerSlashings'
+type BeaconBlockBody_SetProposerSlashings_Call struct {
+	*mock.Call
+}
+
+// SetProposerSlashings is a helper method to define mock.On call
+//   - _a0 []*types.ProposerSlashing
+func (_e *BeaconBlockBody_Expecter) SetProposerSlashings(_a0 interface{}) *BeaconBlockBody_SetProposerSlashings_Call {
+	return &BeaconBlockBody_SetProposerSlashings_Call{Call: _e.mock.On("SetProposerSlashings", _a0)}
+}
+
+func (_c *BeaconBlockBody_SetProposerSlashings_Call) Run(run func(_a0 []*types.ProposerSlashing)) *BeaconBlockBody_SetProposerSlashings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*types.ProposerSlashing))
+	})
+	return _c
+}
+
+func (_c *BeaconBlockBody_SetProposerSlashings_Call) Return() *BeaconBlockBody_SetProposerSlashings_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *BeaconBlockBody_SetProposerSlashings_Call) RunAndReturn(run func([]*types.ProposerSlashing)) *BeaconBlockBody_SetProposerSlashings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetRandaoReveal provides a mock function with given fields: _a0
 func (_m *BeaconBlockBody) SetRandaoReveal(_a0 bytes.B96) {
 	_m.Called(_a0)
@@ -805,6 +932,39 @@ func (_c *BeaconBlockBody_SetRandaoReveal_Call) RunAndReturn(run func(bytes.B96)
 	return _c
 }
 
+// SetVoluntaryExits provides a mock function with given fields: _a0
+func (_m *BeaconBlockBody) SetVoluntaryExits(_a0 []*types.SignedVoluntaryExit) {
+	_m.Called(_a0)
+}
+
+// BeaconBlockBody_SetVoluntaryExits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetVoluntaryExits'
+type BeaconBlockBody_SetVoluntaryExits_Call struct {
+	*mock.Call
+}
+
+// SetVoluntaryExits is a helper method to define mock.On call
+//   - _a0 []*types.SignedVoluntaryExit
+func (_e *BeaconBlockBody_Expecter) SetVoluntaryExits(_a0 interface{}) *BeaconBlockBody_SetVoluntaryExits_Call {
+	return &BeaconBlockBody_SetVoluntaryExits_Call{Call: _e.mock.On("SetVoluntaryExits", _a0)}
+}
+
+func (_c *BeaconBlockBody_SetVoluntaryExits_Call) Run(run func(_a0 []*types.SignedVoluntaryExit)) *BeaconBlockBody_SetVoluntaryExits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*types.SignedVoluntaryExit))
+	})
+	return _c
+}
+
+func (_c *BeaconBlockBody_SetVoluntaryExits_Call) Return() *BeaconBlockBody_SetVoluntaryExits_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *BeaconBlockBody_SetVoluntaryExits_Call) RunAndReturn(run func([]*types.SignedVoluntaryExit)) *BeaconBlockBody_SetVoluntaryExits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SizeSSZ provides a mock function with given fields:
 func (_m *BeaconBlockBody) SizeSSZ() int {
 	ret := _m.Called()
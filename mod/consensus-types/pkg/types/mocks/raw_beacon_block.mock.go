@@ -24,6 +24,64 @@ func (_m *RawBeaconBlock[BeaconBlockBodyT]) EXPECT() *RawBeaconBlock_Expecter[Be
 	return &RawBeaconBlock_Expecter[BeaconBlockBodyT]{mock: &_m.Mock}
 }
 
+// BlindedHashTreeRoot provides a mock function with given fields: _a0
+func (_m *RawBeaconBlock[BeaconBlockBodyT]) BlindedHashTreeRoot(_a0 bytes.B32) (bytes.B32, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlindedHashTreeRoot")
+	}
+
+	var r0 bytes.B32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(bytes.B32) (bytes.B32, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(bytes.B32) bytes.B32); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(bytes.B32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(bytes.B32) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RawBeaconBlock_BlindedHashTreeRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlindedHashTreeRoot'
+type RawBeaconBlock_BlindedHashTreeRoot_Call[BeaconBlockBodyT types.RawBeaconBlockBody] struct {
+	*mock.Call
+}
+
+// BlindedHashTreeRoot is a helper method to define mock.On call
+//   - _a0 bytes.B32
+func (_e *RawBeaconBlock_Expecter[BeaconBlockBodyT]) BlindedHashTreeRoot(_a0 interface{}) *RawBeaconBlock_BlindedHashTreeRoot_Call[BeaconBlockBodyT] {
+	return &RawBeaconBlock_BlindedHashTreeRoot_Call[BeaconBlockBodyT]{Call: _e.mock.On("BlindedHashTreeRoot", _a0)}
+}
+
+func (_c *RawBeaconBlock_BlindedHashTreeRoot_Call[BeaconBlockBodyT]) Run(run func(_a0 bytes.B32)) *RawBeaconBlock_BlindedHashTreeRoot_Call[BeaconBlockBodyT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bytes.B32))
+	})
+	return _c
+}
+
+func (_c *RawBeaconBlock_BlindedHashTreeRoot_Call[BeaconBlockBodyT]) Return(_a0 bytes.B32, _a1 error) *RawBeaconBlock_BlindedHashTreeRoot_Call[BeaconBlockBodyT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RawBeaconBlock_BlindedHashTreeRoot_Call[BeaconBlockBodyT]) RunAndReturn(run func(bytes.B32) (bytes.B32, error)) *RawBeaconBlock_BlindedHashTreeRoot_Call[BeaconBlockBodyT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBody provides a mock function with given fields:
 func (_m *RawBeaconBlock[BeaconBlockBodyT]) GetBody() BeaconBlockBodyT {
 	ret := _m.Called()
@@ -261,6 +261,53 @@ func (_c *RawBeaconBlockBody_GetGraffiti_Call) RunAndReturn(run func() bytes.B32
 	return _c
 }
 
+// GetProposerSlashings provides a mock function with given fields:
+func (_m *RawBeaconBlockBody) GetProposerSlashings() []*types.ProposerSlashing {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProposerSlashings")
+	}
+
+	var r0 []*types.ProposerSlashing
+	if rf, ok := ret.Get(0).(func() []*types.ProposerSlashing); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.ProposerSlashing)
+		}
+	}
+
+	return r0
+}
+
+// RawBeaconBlockBody_GetProposerSlashings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProposerSlashings'
+type RawBeaconBlockBody_GetProposerSlashings_Call struct {
+	*mock.Call
+}
+
+// GetProposerSlashings is a helper method to define mock.On call
+func (_e *RawBeaconBlockBody_Expecter) GetProposerSlashings() *RawBeaconBlockBody_GetProposerSlashings_Call {
+	return &RawBeaconBlockBody_GetProposerSlashings_Call{Call: _e.mock.On("GetProposerSlashings")}
+}
+
+func (_c *RawBeaconBlockBody_GetProposerSlashings_Call) Run(run func()) *RawBeaconBlockBody_GetProposerSlashings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_GetProposerSlashings_Call) Return(_a0 []*types.ProposerSlashing) *RawBeaconBlockBody_GetProposerSlashings_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_GetProposerSlashings_Call) RunAndReturn(run func() []*types.ProposerSlashing) *RawBeaconBlockBody_GetProposerSlashings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRandaoReveal provides a mock function with given fields:
 func (_m *RawBeaconBlockBody) GetRandaoReveal() bytes.B96 {
 	ret := _m.Called()
@@ -365,6 +412,53 @@ func (_c *RawBeaconBlockBody_GetTopLevelRoots_Call) RunAndReturn(run func() ([][
 	return _c
 }
 
+// GetVoluntaryExits provides a mock function with given fields:
+func (_m *RawBeaconBlockBody) GetVoluntaryExits() []*types.SignedVoluntaryExit {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVoluntaryExits")
+	}
+
+	var r0 []*types.SignedVoluntaryExit
+	if rf, ok := ret.Get(0).(func() []*types.SignedVoluntaryExit); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.SignedVoluntaryExit)
+		}
+	}
+
+	return r0
+}
+
+// RawBeaconBlockBody_GetVoluntaryExits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVoluntaryExits'
+type RawBeaconBlockBody_GetVoluntaryExits_Call struct {
+	*mock.Call
+}
+
+// GetVoluntaryExits is a helper method to define mock.On call
+func (_e *RawBeaconBlockBody_Expecter) GetVoluntaryExits() *RawBeaconBlockBody_GetVoluntaryExits_Call {
+	return &RawBeaconBlockBody_GetVoluntaryExits_Call{Call: _e.mock.On("GetVoluntaryExits")}
+}
+
+func (_c *RawBeaconBlockBody_GetVoluntaryExits_Call) Run(run func()) *RawBeaconBlockBody_GetVoluntaryExits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_GetVoluntaryExits_Call) Return(_a0 []*types.SignedVoluntaryExit) *RawBeaconBlockBody_GetVoluntaryExits_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_GetVoluntaryExits_Call) RunAndReturn(run func() []*types.SignedVoluntaryExit) *RawBeaconBlockBody_GetVoluntaryExits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HashTreeRoot provides a mock function with given fields:
 func (_m *RawBeaconBlockBody) HashTreeRoot() ([32]byte, error) {
 	ret := _m.Called()
@@ -805,6 +899,39 @@ func (_c *RawBeaconBlockBody_SetGraffiti_Call) RunAndReturn(run func(bytes.B32))
 	return _c
 }
 
+// SetProposerSlashings provides a mock function with given fields: _a0
+func (_m *RawBeaconBlockBody) SetProposerSlashings(_a0 []*types.ProposerSlashing) {
+	_m.Called(_a0)
+}
+
+// RawBeaconBlockBody_SetProposerSlashings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProposerSlashings'
+type RawBeaconBlockBody_SetProposerSlashings_Call struct {
+	*mock.Call
+}
+
+// SetProposerSlashings is a helper method to define mock.On call
+//   - _a0 []*types.ProposerSlashing
+func (_e *RawBeaconBlockBody_Expecter) SetProposerSlashings(_a0 interface{}) *RawBeaconBlockBody_SetProposerSlashings_Call {
+	return &RawBeaconBlockBody_SetProposerSlashings_Call{Call: _e.mock.On("SetProposerSlashings", _a0)}
+}
+
+func (_c *RawBeaconBlockBody_SetProposerSlashings_Call) Run(run func(_a0 []*types.ProposerSlashing)) *RawBeaconBlockBody_SetProposerSlashings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*types.ProposerSlashing))
+	})
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_SetProposerSlashings_Call) Return() *RawBeaconBlockBody_SetProposerSlashings_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_SetProposerSlashings_Call) RunAndReturn(run func([]*types.ProposerSlashing)) *RawBeaconBlockBody_SetProposerSlashings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetRandaoReveal provides a mock function with given fields: _a0
 func (_m *RawBeaconBlockBody) SetRandaoReveal(_a0 bytes.B96) {
 	_m.Called(_a0)
@@ -838,6 +965,39 @@ func (_c *RawBeaconBlockBody_SetRandaoReveal_Call) RunAndReturn(run func(bytes.B
 	return _c
 }
 
+// SetVoluntaryExits provides a mock function with given fields: _a0
+func (_m *RawBeaconBlockBody) SetVoluntaryExits(_a0 []*types.SignedVoluntaryExit) {
+	_m.Called(_a0)
+}
+
+// RawBeaconBlockBody_SetVoluntaryExits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetVoluntaryExits'
+type RawBeaconBlockBody_SetVoluntaryExits_Call struct {
+	*mock.Call
+}
+
+// SetVoluntaryExits is a helper method to define mock.On call
+//   - _a0 []*types.SignedVoluntaryExit
+func (_e *RawBeaconBlockBody_Expecter) SetVoluntaryExits(_a0 interface{}) *RawBeaconBlockBody_SetVoluntaryExits_Call {
+	return &RawBeaconBlockBody_SetVoluntaryExits_Call{Call: _e.mock.On("SetVoluntaryExits", _a0)}
+}
+
+func (_c *RawBeaconBlockBody_SetVoluntaryExits_Call) Run(run func(_a0 []*types.SignedVoluntaryExit)) *RawBeaconBlockBody_SetVoluntaryExits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*types.SignedVoluntaryExit))
+	})
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_SetVoluntaryExits_Call) Return() *RawBeaconBlockBody_SetVoluntaryExits_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_SetVoluntaryExits_Call) RunAndReturn(run func([]*types.SignedVoluntaryExit)) *RawBeaconBlockBody_SetVoluntaryExits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SizeSSZ provides a mock function with given fields:
 func (_m *RawBeaconBlockBody) SizeSSZ() int {
 	ret := _m.Called()
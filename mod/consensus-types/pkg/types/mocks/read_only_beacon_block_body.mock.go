@@ -261,6 +261,53 @@ func (_c *ReadOnlyBeaconBlockBody_GetGraffiti_Call) RunAndReturn(run func() byte
 	return _c
 }
 
+// GetProposerSlashings provides a mock function with given fields:
+func (_m *ReadOnlyBeaconBlockBody) GetProposerSlashings() []*types.ProposerSlashing {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProposerSlashings")
+	}
+
+	var r0 []*types.ProposerSlashing
+	if rf, ok := ret.Get(0).(func() []*types.ProposerSlashing); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.ProposerSlashing)
+		}
+	}
+
+	return r0
+}
+
+// ReadOnlyBeaconBlockBody_GetProposerSlashings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProposerSlashings'
+type ReadOnlyBeaconBlockBody_GetProposerSlashings_Call struct {
+	*mock.Call
+}
+
+// GetProposerSlashings is a helper method to define mock.On call
+func (_e *ReadOnlyBeaconBlockBody_Expecter) GetProposerSlashings() *ReadOnlyBeaconBlockBody_GetProposerSlashings_Call {
+	return &ReadOnlyBeaconBlockBody_GetProposerSlashings_Call{Call: _e.mock.On("GetProposerSlashings")}
+}
+
+func (_c *ReadOnlyBeaconBlockBody_GetProposerSlashings_Call) Run(run func()) *ReadOnlyBeaconBlockBody_GetProposerSlashings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ReadOnlyBeaconBlockBody_GetProposerSlashings_Call) Return(_a0 []*types.ProposerSlashing) *ReadOnlyBeaconBlockBody_GetProposerSlashings_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReadOnlyBeaconBlockBody_GetProposerSlashings_Call) RunAndReturn(run func() []*types.ProposerSlashing) *ReadOnlyBeaconBlockBody_GetProposerSlashings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRandaoReveal provides a mock function with given fields:
 func (_m *ReadOnlyBeaconBlockBody) GetRandaoReveal() bytes.B96 {
 	ret := _m.Called()
@@ -365,6 +412,53 @@ func (_c *ReadOnlyBeaconBlockBody_GetTopLevelRoots_Call) RunAndReturn(run func()
 	return _c
 }
 
+// GetVoluntaryExits provides a mock function with given fields:
+func (_m *ReadOnlyBeaconBlockBody) GetVoluntaryExits() []*types.SignedVoluntaryExit {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVoluntaryExits")
+	}
+
+	var r0 []*types.SignedVoluntaryExit
+	if rf, ok := ret.Get(0).(func() []*types.SignedVoluntaryExit); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.SignedVoluntaryExit)
+		}
+	}
+
+	return r0
+}
+
+// ReadOnlyBeaconBlockBody_GetVoluntaryExits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVoluntaryExits'
+type ReadOnlyBeaconBlockBody_GetVoluntaryExits_Call struct {
+	*mock.Call
+}
+
+// GetVoluntaryExits is a helper method to define mock.On call
+func (_e *ReadOnlyBeaconBlockBody_Expecter) GetVoluntaryExits() *ReadOnlyBeaconBlockBody_GetVoluntaryExits_Call {
+	return &ReadOnlyBeaconBlockBody_GetVoluntaryExits_Call{Call: _e.mock.On("GetVoluntaryExits")}
+}
+
+func (_c *ReadOnlyBeaconBlockBody_GetVoluntaryExits_Call) Run(run func()) *ReadOnlyBeaconBlockBody_GetVoluntaryExits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ReadOnlyBeaconBlockBody_GetVoluntaryExits_Call) Return(_a0 []*types.SignedVoluntaryExit) *ReadOnlyBeaconBlockBody_GetVoluntaryExits_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReadOnlyBeaconBlockBody_GetVoluntaryExits_Call) RunAndReturn(run func() []*types.SignedVoluntaryExit) *ReadOnlyBeaconBlockBody_GetVoluntaryExits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HashTreeRoot provides a mock function with given fields:
 func (_m *ReadOnlyBeaconBlockBody) HashTreeRoot() ([32]byte, error) {
 	ret := _m.Called()
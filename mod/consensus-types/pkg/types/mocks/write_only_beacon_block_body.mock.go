@@ -204,6 +204,39 @@ func (_c *WriteOnlyBeaconBlockBody_SetGraffiti_Call) RunAndReturn(run func(bytes
 	return _c
 }
 
+// SetProposerSlashings provides a mock function with given fields: _a0
+func (_m *WriteOnlyBeaconBlockBody) SetProposerSlashings(_a0 []*types.ProposerSlashing) {
+	_m.Called(_a0)
+}
+
+// WriteOnlyBeaconBlockBody_SetProposerSlashings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProposerSlashings'
+type WriteOnlyBeaconBlockBody_SetProposerSlashings_Call struct {
+	*mock.Call
+}
+
+// SetProposerSlashings is a helper method to define mock.On call
+//   - _a0 []*types.ProposerSlashing
+func (_e *WriteOnlyBeaconBlockBody_Expecter) SetProposerSlashings(_a0 interface{}) *WriteOnlyBeaconBlockBody_SetProposerSlashings_Call {
+	return &WriteOnlyBeaconBlockBody_SetProposerSlashings_Call{Call: _e.mock.On("SetProposerSlashings", _a0)}
+}
+
+func (_c *WriteOnlyBeaconBlockBody_SetProposerSlashings_Call) Run(run func(_a0 []*types.ProposerSlashing)) *WriteOnlyBeaconBlockBody_SetProposerSlashings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*types.ProposerSlashing))
+	})
+	return _c
+}
+
+func (_c *WriteOnlyBeaconBlockBody_SetProposerSlashings_Call) Return() *WriteOnlyBeaconBlockBody_SetProposerSlashings_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *WriteOnlyBeaconBlockBody_SetProposerSlashings_Call) RunAndReturn(run func([]*types.ProposerSlashing)) *WriteOnlyBeaconBlockBody_SetProposerSlashings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetRandaoReveal provides a mock function with given fields: _a0
 func (_m *WriteOnlyBeaconBlockBody) SetRandaoReveal(_a0 bytes.B96) {
 	_m.Called(_a0)
@@ -237,6 +270,39 @@ func (_c *WriteOnlyBeaconBlockBody_SetRandaoReveal_Call) RunAndReturn(run func(b
 	return _c
 }
 
+// SetVoluntaryExits provides a mock function with given fields: _a0
+func (_m *WriteOnlyBeaconBlockBody) SetVoluntaryExits(_a0 []*types.SignedVoluntaryExit) {
+	_m.Called(_a0)
+}
+
+// WriteOnlyBeaconBlockBody_SetVoluntaryExits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetVoluntaryExits'
+type WriteOnlyBeaconBlockBody_SetVoluntaryExits_Call struct {
+	*mock.Call
+}
+
+// SetVoluntaryExits is a helper method to define mock.On call
+//   - _a0 []*types.SignedVoluntaryExit
+func (_e *WriteOnlyBeaconBlockBody_Expecter) SetVoluntaryExits(_a0 interface{}) *WriteOnlyBeaconBlockBody_SetVoluntaryExits_Call {
+	return &WriteOnlyBeaconBlockBody_SetVoluntaryExits_Call{Call: _e.mock.On("SetVoluntaryExits", _a0)}
+}
+
+func (_c *WriteOnlyBeaconBlockBody_SetVoluntaryExits_Call) Run(run func(_a0 []*types.SignedVoluntaryExit)) *WriteOnlyBeaconBlockBody_SetVoluntaryExits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*types.SignedVoluntaryExit))
+	})
+	return _c
+}
+
+func (_c *WriteOnlyBeaconBlockBody_SetVoluntaryExits_Call) Return() *WriteOnlyBeaconBlockBody_SetVoluntaryExits_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *WriteOnlyBeaconBlockBody_SetVoluntaryExits_Call) RunAndReturn(run func([]*types.SignedVoluntaryExit)) *WriteOnlyBeaconBlockBody_SetVoluntaryExits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewWriteOnlyBeaconBlockBody creates a new instance of WriteOnlyBeaconBlockBody. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWriteOnlyBeaconBlockBody(t interface {
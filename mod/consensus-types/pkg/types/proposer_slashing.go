@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz/merkleizer"
+)
+
+// SignedBeaconBlockHeader as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#signedbeaconblockheader
+//
+//nolint:lll
+type SignedBeaconBlockHeader struct {
+	// Header is the beacon block header that was signed.
+	Header *BeaconBlockHeader `json:"message"`
+	// Signature is the signature of the proposer over the header.
+	Signature crypto.BLSSignature `json:"signature" ssz-size:"96"`
+}
+
+// VerifySignature verifies the signature of the proposer over the header.
+func (h *SignedBeaconBlockHeader) VerifySignature(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	domain, err := forkData.ComputeDomain(domainType)
+	if err != nil {
+		return err
+	}
+
+	signingRoot, err := ComputeSigningRoot(h.Header, domain)
+	if err != nil {
+		return err
+	}
+
+	return signatureVerificationFn(pubkey, signingRoot[:], h.Signature)
+}
+
+// ProposerSlashing as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#proposerslashing
+//
+//nolint:lll
+//go:generate go run github.com/ferranbt/fastssz/sszgen -path proposer_slashing.go -objs SignedBeaconBlockHeader,ProposerSlashing -include ./header.go,../../../primitives/pkg/crypto,../../../primitives/pkg/common,../../../primitives/pkg/math,../../../primitives/pkg/bytes,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output proposer_slashing.ssz.go
+type ProposerSlashing struct {
+	// SignedHeader1 is the first of the two conflicting signed headers.
+	SignedHeader1 *SignedBeaconBlockHeader `json:"signed_header_1"`
+	// SignedHeader2 is the second of the two conflicting signed headers.
+	SignedHeader2 *SignedBeaconBlockHeader `json:"signed_header_2"`
+}
+
+// NewProposerSlashing creates a new ProposerSlashing instance.
+func NewProposerSlashing(
+	signedHeader1, signedHeader2 *SignedBeaconBlockHeader,
+) *ProposerSlashing {
+	return &ProposerSlashing{
+		SignedHeader1: signedHeader1,
+		SignedHeader2: signedHeader2,
+	}
+}
+
+// IsNil returns true if the ProposerSlashing is missing either of its
+// signed headers.
+func (p *ProposerSlashing) IsNil() bool {
+	return p == nil ||
+		p.SignedHeader1 == nil || p.SignedHeader1.Header == nil ||
+		p.SignedHeader2 == nil || p.SignedHeader2.Header == nil
+}
+
+// GetHeader1 returns the first of the two conflicting headers, nil if it is
+// missing.
+func (p *ProposerSlashing) GetHeader1() *BeaconBlockHeader {
+	if p == nil || p.SignedHeader1 == nil {
+		return nil
+	}
+	return p.SignedHeader1.Header
+}
+
+// GetHeader2 returns the second of the two conflicting headers, nil if it is
+// missing.
+func (p *ProposerSlashing) GetHeader2() *BeaconBlockHeader {
+	if p == nil || p.SignedHeader2 == nil {
+		return nil
+	}
+	return p.SignedHeader2.Header
+}
+
+// GetProposerIndex returns the index of the proposer being slashed, zero if
+// the first header is missing.
+func (p *ProposerSlashing) GetProposerIndex() math.ValidatorIndex {
+	header := p.GetHeader1()
+	if header == nil {
+		return 0
+	}
+	return header.GetProposerIndex()
+}
+
+// VerifySignatures verifies that both conflicting headers were signed by the
+// given proposer.
+func (p *ProposerSlashing) VerifySignatures(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	for _, signedHeader := range []*SignedBeaconBlockHeader{
+		p.SignedHeader1, p.SignedHeader2,
+	} {
+		if err := signedHeader.VerifySignature(
+			forkData, domainType, pubkey, signatureVerificationFn,
+		); err != nil {
+			return errors.Newf(
+				"invalid proposer slashing signature: %w", err,
+			)
+		}
+	}
+	return nil
+}
+
+// ProposerSlashings is a typealias for a list of ProposerSlashings.
+type ProposerSlashings []*ProposerSlashing
+
+// HashTreeRoot returns the hash tree root of the ProposerSlashings list.
+func (ps ProposerSlashings) HashTreeRoot() (common.Root, error) {
+	merkleizer := merkleizer.New[
+		common.ChainSpec, [32]byte, *ProposerSlashing,
+	]()
+	return merkleizer.MerkleizeListComposite(
+		ps, constants.MaxProposerSlashingsPerBlock,
+	)
+}
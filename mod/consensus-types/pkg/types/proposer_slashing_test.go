@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+// generateProposerSlashing generates a proposer slashing with two
+// conflicting headers for the same slot.
+func generateProposerSlashing() *types.ProposerSlashing {
+	return types.NewProposerSlashing(
+		&types.SignedBeaconBlockHeader{
+			Header: types.NewBeaconBlockHeader(
+				math.Slot(10),
+				math.ValidatorIndex(3),
+				common.Root{1},
+				common.Root{2},
+				common.Root{3},
+			),
+			Signature: crypto.BLSSignature{1},
+		},
+		&types.SignedBeaconBlockHeader{
+			Header: types.NewBeaconBlockHeader(
+				math.Slot(10),
+				math.ValidatorIndex(3),
+				common.Root{1},
+				common.Root{2},
+				common.Root{4},
+			),
+			Signature: crypto.BLSSignature{2},
+		},
+	)
+}
+
+func TestProposerSlashing_MarshalUnmarshalSSZ(t *testing.T) {
+	original := generateProposerSlashing()
+
+	data, err := original.MarshalSSZ()
+	require.NoError(t, err)
+	require.NotNil(t, data)
+
+	var unmarshalled types.ProposerSlashing
+	err = unmarshalled.UnmarshalSSZ(data)
+	require.NoError(t, err)
+	require.Equal(t, original, &unmarshalled)
+}
+
+func TestProposerSlashing_SizeSSZ(t *testing.T) {
+	slashing := generateProposerSlashing()
+	require.Equal(t, 416, slashing.SizeSSZ())
+}
+
+func TestProposerSlashing_UnmarshalSSZ_ErrSize(t *testing.T) {
+	var slashing types.ProposerSlashing
+	err := slashing.UnmarshalSSZ(make([]byte, 100))
+	require.ErrorIs(t, err, ssz.ErrSize)
+}
+
+func TestProposerSlashing_GetHeaders(t *testing.T) {
+	slashing := generateProposerSlashing()
+	require.Equal(t, slashing.SignedHeader1.Header, slashing.GetHeader1())
+	require.Equal(t, slashing.SignedHeader2.Header, slashing.GetHeader2())
+	require.Equal(t, math.ValidatorIndex(3), slashing.GetProposerIndex())
+}
+
+func TestProposerSlashing_MissingHeaders(t *testing.T) {
+	slashing := generateProposerSlashing()
+	require.False(t, slashing.IsNil())
+
+	slashing.SignedHeader1 = nil
+	require.True(t, slashing.IsNil())
+	require.Nil(t, slashing.GetHeader1())
+
+	slashing = generateProposerSlashing()
+	slashing.SignedHeader2.Header = nil
+	require.True(t, slashing.IsNil())
+	require.Nil(t, slashing.GetHeader2())
+
+	var nilSlashing *types.ProposerSlashing
+	require.True(t, nilSlashing.IsNil())
+	require.Nil(t, nilSlashing.GetHeader1())
+	require.Zero(t, nilSlashing.GetProposerIndex())
+}
+
+func TestProposerSlashings_HashTreeRoot(t *testing.T) {
+	empty, err := types.ProposerSlashings{}.HashTreeRoot()
+	require.NoError(t, err)
+
+	root, err := types.ProposerSlashings{
+		generateProposerSlashing(),
+	}.HashTreeRoot()
+	require.NoError(t, err)
+	require.NotEqual(t, empty, root)
+}
+
+func TestProposerSlashing_VerifySignatures(t *testing.T) {
+	slashing := generateProposerSlashing()
+	forkData := types.NewForkData(common.Version{}, common.Root{})
+	pubkey := crypto.BLSPubkey{9}
+
+	tests := []struct {
+		name       string
+		verifyFn   func(crypto.BLSPubkey, []byte, crypto.BLSSignature) error
+		wantErr    error
+		wantCalled int
+	}{
+		{
+			name: "valid signatures",
+			verifyFn: func(
+				crypto.BLSPubkey, []byte, crypto.BLSSignature,
+			) error {
+				return nil
+			},
+			wantCalled: 2,
+		},
+		{
+			name: "invalid second signature",
+			verifyFn: func(
+				_ crypto.BLSPubkey, _ []byte, sig crypto.BLSSignature,
+			) error {
+				if sig == slashing.SignedHeader2.Signature {
+					return errors.New("bad signature")
+				}
+				return nil
+			},
+			wantErr:    errors.New("bad signature"),
+			wantCalled: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				called       int
+				signingRoots [][]byte
+			)
+			err := slashing.VerifySignatures(
+				forkData, common.DomainType{}, pubkey,
+				func(
+					pk crypto.BLSPubkey, msg []byte, sig crypto.BLSSignature,
+				) error {
+					called++
+					require.Equal(t, pubkey, pk)
+					signingRoots = append(signingRoots, msg)
+					return tt.verifyFn(pk, msg, sig)
+				},
+			)
+			if tt.wantErr != nil {
+				require.ErrorContains(t, err, tt.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantCalled, called)
+			require.NotEqual(t, signingRoots[0], signingRoots[1])
+		})
+	}
+}
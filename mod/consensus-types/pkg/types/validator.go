@@ -199,11 +199,41 @@ func (v *Validator) SetEffectiveBalance(balance math.Gwei) {
 	v.EffectiveBalance = balance
 }
 
+// SetSlashed sets whether the validator has been slashed.
+func (v *Validator) SetSlashed(slashed bool) {
+	v.Slashed = slashed
+}
+
+// GetActivationEpoch returns the epoch in which the validator activates.
+func (v Validator) GetActivationEpoch() math.Epoch {
+	return v.ActivationEpoch
+}
+
+// SetActivationEpoch sets the epoch in which the validator activates.
+func (v *Validator) SetActivationEpoch(epoch math.Epoch) {
+	v.ActivationEpoch = epoch
+}
+
+// GetExitEpoch returns the epoch in which the validator exits.
+func (v Validator) GetExitEpoch() math.Epoch {
+	return v.ExitEpoch
+}
+
+// SetExitEpoch sets the epoch in which the validator exits.
+func (v *Validator) SetExitEpoch(epoch math.Epoch) {
+	v.ExitEpoch = epoch
+}
+
 // GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 func (v Validator) GetWithdrawableEpoch() math.Epoch {
 	return v.WithdrawableEpoch
 }
 
+// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+func (v *Validator) SetWithdrawableEpoch(epoch math.Epoch) {
+	v.WithdrawableEpoch = epoch
+}
+
 // GetWithdrawalCredentials returns the withdrawal credentials of the validator.
 func (v Validator) GetWithdrawalCredentials() WithdrawalCredentials {
 	return v.WithdrawalCredentials
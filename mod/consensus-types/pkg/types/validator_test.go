@@ -816,6 +816,44 @@ func TestValidator_GetWithdrawableEpoch(t *testing.T) {
 	}
 }
 
+func TestValidator_SetExitAndWithdrawableEpoch(t *testing.T) {
+	tests := []struct {
+		name              string
+		exitEpoch         math.Epoch
+		withdrawableEpoch math.Epoch
+		validator         *types.Validator
+	}{
+		{
+			name:              "initiate exit",
+			exitEpoch:         5,
+			withdrawableEpoch: 261,
+			validator: &types.Validator{
+				ExitEpoch:         math.Epoch(constants.FarFutureEpoch),
+				WithdrawableEpoch: math.Epoch(constants.FarFutureEpoch),
+			},
+		},
+		{
+			name:              "extend withdrawable epoch",
+			exitEpoch:         5,
+			withdrawableEpoch: 8197,
+			validator: &types.Validator{
+				ExitEpoch:         5,
+				WithdrawableEpoch: 261,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.validator.SetExitEpoch(tt.exitEpoch)
+			tt.validator.SetWithdrawableEpoch(tt.withdrawableEpoch)
+			require.Equal(t, tt.exitEpoch, tt.validator.GetExitEpoch(),
+				"Test case: %s", tt.name)
+			require.Equal(t, tt.withdrawableEpoch,
+				tt.validator.GetWithdrawableEpoch(), "Test case: %s", tt.name)
+		})
+	}
+}
+
 func TestValidator_GetWithdrawalCredentials(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -878,6 +916,27 @@ func TestValidator_IsSlashed(t *testing.T) {
 	}
 }
 
+func TestValidator_SetSlashed(t *testing.T) {
+	validator := &types.Validator{}
+	require.False(t, validator.IsSlashed())
+
+	validator.SetSlashed(true)
+	require.True(t, validator.IsSlashed())
+}
+
+func TestValidator_SetActivationEpoch(t *testing.T) {
+	validator := &types.Validator{
+		ActivationEpoch: math.Epoch(constants.FarFutureEpoch),
+		ExitEpoch:       math.Epoch(constants.FarFutureEpoch),
+	}
+	require.False(t, validator.IsActive(5))
+
+	validator.SetActivationEpoch(5)
+	require.Equal(t, math.Epoch(5), validator.GetActivationEpoch())
+	require.False(t, validator.IsActive(4))
+	require.True(t, validator.IsActive(5))
+}
+
 func TestValidator_New(t *testing.T) {
 	tests := []struct {
 		name                      string
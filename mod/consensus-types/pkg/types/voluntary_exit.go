@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz/merkleizer"
+)
+
+// VoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#voluntaryexit
+//
+//nolint:lll
+//go:generate go run github.com/ferranbt/fastssz/sszgen -path voluntary_exit.go -objs VoluntaryExit,SignedVoluntaryExit -include ../../../primitives/pkg/crypto,../../../primitives/pkg/common,../../../primitives/pkg/math,../../../primitives/pkg/bytes,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output voluntary_exit.ssz.go
+type VoluntaryExit struct {
+	// Epoch is the earliest epoch in which the exit can be processed.
+	Epoch math.Epoch `json:"epoch"`
+	// ValidatorIndex is the index of the exiting validator.
+	ValidatorIndex math.ValidatorIndex `json:"validatorIndex"`
+}
+
+// SignedVoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#signedvoluntaryexit
+//
+//nolint:lll
+type SignedVoluntaryExit struct {
+	// Message is the voluntary exit that was signed.
+	Message *VoluntaryExit `json:"message"`
+	// Signature is the signature of the exiting validator over the message.
+	Signature crypto.BLSSignature `json:"signature" ssz-size:"96"`
+}
+
+// CreateAndSignVoluntaryExit constructs and signs a voluntary exit for the
+// validator at the given index.
+func CreateAndSignVoluntaryExit(
+	forkData *ForkData,
+	domainType common.DomainType,
+	signer crypto.BLSSigner,
+	epoch math.Epoch,
+	validatorIndex math.ValidatorIndex,
+) (*SignedVoluntaryExit, error) {
+	domain, err := forkData.ComputeDomain(domainType)
+	if err != nil {
+		return nil, err
+	}
+
+	exit := &VoluntaryExit{
+		Epoch:          epoch,
+		ValidatorIndex: validatorIndex,
+	}
+
+	signingRoot, err := ComputeSigningRoot(exit, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := signer.Sign(signingRoot[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignedVoluntaryExit{
+		Message:   exit,
+		Signature: signature,
+	}, nil
+}
+
+// GetEpoch returns the earliest epoch in which the exit can be processed.
+func (e *SignedVoluntaryExit) GetEpoch() math.Epoch {
+	return e.Message.Epoch
+}
+
+// GetValidatorIndex returns the index of the exiting validator.
+func (e *SignedVoluntaryExit) GetValidatorIndex() math.ValidatorIndex {
+	return e.Message.ValidatorIndex
+}
+
+// VerifySignature verifies the signature of the exiting validator over the
+// voluntary exit.
+func (e *SignedVoluntaryExit) VerifySignature(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	domain, err := forkData.ComputeDomain(domainType)
+	if err != nil {
+		return err
+	}
+
+	signingRoot, err := ComputeSigningRoot(e.Message, domain)
+	if err != nil {
+		return err
+	}
+
+	return signatureVerificationFn(pubkey, signingRoot[:], e.Signature)
+}
+
+// VoluntaryExits is a typealias for a list of SignedVoluntaryExits.
+type VoluntaryExits []*SignedVoluntaryExit
+
+// HashTreeRoot returns the hash tree root of the VoluntaryExits list.
+func (ve VoluntaryExits) HashTreeRoot() (common.Root, error) {
+	// TODO: read max voluntary exits from the chain spec.
+	merkleizer := merkleizer.New[
+		common.ChainSpec, [32]byte, *SignedVoluntaryExit,
+	]()
+	return merkleizer.MerkleizeListComposite(
+		ve, constants.MaxVoluntaryExitsPerBlock,
+	)
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// generateSignedVoluntaryExit generates a signed voluntary exit for the
+// validator at index 3.
+func generateSignedVoluntaryExit() *types.SignedVoluntaryExit {
+	return &types.SignedVoluntaryExit{
+		Message: &types.VoluntaryExit{
+			Epoch:          math.Epoch(10),
+			ValidatorIndex: math.ValidatorIndex(3),
+		},
+		Signature: crypto.BLSSignature{1},
+	}
+}
+
+func TestSignedVoluntaryExit_MarshalUnmarshalSSZ(t *testing.T) {
+	original := generateSignedVoluntaryExit()
+
+	data, err := original.MarshalSSZ()
+	require.NoError(t, err)
+	require.NotNil(t, data)
+
+	var unmarshalled types.SignedVoluntaryExit
+	err = unmarshalled.UnmarshalSSZ(data)
+	require.NoError(t, err)
+	require.Equal(t, original, &unmarshalled)
+}
+
+func TestSignedVoluntaryExit_SizeSSZ(t *testing.T) {
+	exit := generateSignedVoluntaryExit()
+	require.Equal(t, 112, exit.SizeSSZ())
+}
+
+func TestSignedVoluntaryExit_UnmarshalSSZ_ErrSize(t *testing.T) {
+	var exit types.SignedVoluntaryExit
+	err := exit.UnmarshalSSZ(make([]byte, 100))
+	require.ErrorIs(t, err, ssz.ErrSize)
+}
+
+func TestSignedVoluntaryExit_Getters(t *testing.T) {
+	exit := generateSignedVoluntaryExit()
+	require.Equal(t, math.Epoch(10), exit.GetEpoch())
+	require.Equal(t, math.ValidatorIndex(3), exit.GetValidatorIndex())
+}
+
+func TestVoluntaryExits_HashTreeRoot(t *testing.T) {
+	empty, err := types.VoluntaryExits{}.HashTreeRoot()
+	require.NoError(t, err)
+
+	root, err := types.VoluntaryExits{
+		generateSignedVoluntaryExit(),
+	}.HashTreeRoot()
+	require.NoError(t, err)
+	require.NotEqual(t, empty, root)
+}
+
+func TestCreateAndSignVoluntaryExit(t *testing.T) {
+	var (
+		forkData   = types.NewForkData(common.Version{}, common.Root{})
+		domainType = common.DomainType{4}
+		pubkey     = crypto.BLSPubkey{9}
+		signature  = crypto.BLSSignature{7}
+		signer     = &mocks.BLSSigner{}
+	)
+	signer.On("Sign", mock.Anything).Return(signature, nil)
+
+	exit, err := types.CreateAndSignVoluntaryExit(
+		forkData, domainType, signer, math.Epoch(5), math.ValidatorIndex(2),
+	)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(5), exit.GetEpoch())
+	require.Equal(t, math.ValidatorIndex(2), exit.GetValidatorIndex())
+	require.Equal(t, signature, exit.Signature)
+
+	// The exit is verified against the root the signer was asked to sign.
+	signedRoot, ok := signer.Calls[0].Arguments.Get(0).([]byte)
+	require.True(t, ok)
+	require.NoError(t, exit.VerifySignature(
+		forkData, domainType, pubkey,
+		func(
+			pk crypto.BLSPubkey, msg []byte, sig crypto.BLSSignature,
+		) error {
+			require.Equal(t, pubkey, pk)
+			require.Equal(t, signedRoot, msg)
+			require.Equal(t, signature, sig)
+			return nil
+		},
+	))
+
+	// A signature over another domain is rejected.
+	err = exit.VerifySignature(
+		forkData, common.DomainType{5}, pubkey,
+		func(_ crypto.BLSPubkey, msg []byte, _ crypto.BLSSignature) error {
+			if string(msg) != string(signedRoot) {
+				return errors.New("bad signature")
+			}
+			return nil
+		},
+	)
+	require.ErrorContains(t, err, "bad signature")
+}
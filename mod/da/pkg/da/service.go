@@ -22,6 +22,7 @@ package da
 
 import (
 	"context"
+	"sync"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -46,6 +47,8 @@ type Service[
 	]
 	sidecarsBroker EventPublisherSubscriberT
 	logger         log.Logger[any]
+	// wg waits for the goroutine of the service to exit.
+	wg sync.WaitGroup
 }
 
 // NewService returns a new DA service.
@@ -95,7 +98,22 @@ func (s *Service[_, _, _, _, _]) Start(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	go s.start(ctx, subSidecarsCh)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.start(ctx, subSidecarsCh)
+	}()
+	return nil
+}
+
+// Stop waits for the service to finish processing its current sidecars.
+func (s *Service[_, _, _, _, _]) Stop(context.Context) error {
+	s.wg.Wait()
+	return nil
+}
+
+// Status returns the status of the service.
+func (s *Service[_, _, _, _, _]) Status() error {
 	return nil
 }
 
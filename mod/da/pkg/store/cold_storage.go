@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/spf13/afero"
+)
+
+const (
+	// coldStorageExtension is the extension of the files of the cold
+	// storage.
+	coldStorageExtension = ".ssz.gz"
+	// nextSlotFile is the name of the file holding the first slot that has
+	// not been moved to the cold storage yet.
+	nextSlotFile = "next_slot"
+)
+
+// ColdStorage keeps the blob sidecars that expired from the availability
+// store, in one gzip compressed SSZ file per slot.
+type ColdStorage struct {
+	fs  afero.Fs
+	dir string
+}
+
+// NewColdStorage creates a new cold storage in the given directory.
+func NewColdStorage(fs afero.Fs, dir string) *ColdStorage {
+	return &ColdStorage{
+		fs:  fs,
+		dir: dir,
+	}
+}
+
+// Put stores the given sidecars of the given slot, replacing any sidecar
+// previously stored for the slot.
+func (c *ColdStorage) Put(slot math.Slot, sidecars *types.BlobSidecars) error {
+	bz, err := sidecars.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return c.writeFile(c.path(slot), func(w io.Writer) error {
+		zw := gzip.NewWriter(w)
+		if _, err = zw.Write(bz); err != nil {
+			return err
+		}
+		return zw.Close()
+	})
+}
+
+// Get returns the sidecars stored for the given slot, which are empty if
+// none are.
+func (c *ColdStorage) Get(slot math.Slot) (*types.BlobSidecars, error) {
+	sidecars := &types.BlobSidecars{Sidecars: []*types.BlobSidecar{}}
+	f, err := c.fs.Open(c.path(slot))
+	if errors.Is(err, fs.ErrNotExist) {
+		return sidecars, nil
+	} else if err != nil {
+		return nil, err
+	}
+	//#nosec:G307 // the file is only read from.
+	defer f.Close()
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+	if err = sidecars.UnmarshalSSZ(bz); err != nil {
+		return nil, err
+	}
+	return sidecars, nil
+}
+
+// NextSlot returns the first slot that has not been moved to the cold
+// storage yet, which is zero if no slot has been.
+func (c *ColdStorage) NextSlot() (math.Slot, error) {
+	bz, err := afero.ReadFile(c.fs, filepath.Join(c.dir, nextSlotFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	slot, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return math.Slot(slot), nil
+}
+
+// SetNextSlot records the first slot that has not been moved to the cold
+// storage yet, so that the slots before it are not moved again.
+func (c *ColdStorage) SetNextSlot(slot math.Slot) error {
+	return c.writeFile(
+		filepath.Join(c.dir, nextSlotFile),
+		func(w io.Writer) error {
+			_, err := io.WriteString(w, strconv.FormatUint(slot.Unwrap(), 10))
+			return err
+		},
+	)
+}
+
+// writeFile writes the file at the given path with the given write
+// function. The file is written to a temporary file first so that it is
+// never left half written.
+func (c *ColdStorage) writeFile(
+	path string,
+	write func(w io.Writer) error,
+) error {
+	if err := c.fs.MkdirAll(c.dir, os.ModePerm); err != nil {
+		return err
+	}
+	f, err := c.fs.Create(path + ".tmp")
+	if err != nil {
+		return err
+	}
+	if err = write(f); err != nil {
+		return errors.Join(err, f.Close())
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return c.fs.Rename(path+".tmp", path)
+}
+
+// path returns the path of the file of the given slot.
+func (c *ColdStorage) path(slot math.Slot) string {
+	return filepath.Join(
+		c.dir, strconv.FormatUint(slot.Unwrap(), 10)+coldStorageExtension,
+	)
+}
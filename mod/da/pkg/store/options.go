@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+// Option is a functional option for the availability store.
+type Option func(*options)
+
+// options are the options of the availability store.
+type options struct {
+	// archive is true if sidecars from outside the data availability window
+	// are stored as well.
+	archive bool
+	// coldStorage is where pruned sidecars are moved to, nil if they are
+	// deleted.
+	coldStorage *ColdStorage
+}
+
+// WithArchive makes the store keep the sidecars it is given even when they
+// are outside of the data availability window.
+func WithArchive() Option {
+	return func(o *options) {
+		o.archive = true
+	}
+}
+
+// WithColdStorage makes the store move the sidecars it prunes to the given
+// cold storage, from which they can still be retrieved.
+func WithColdStorage(coldStorage *ColdStorage) Option {
+	return func(o *options) {
+		o.coldStorage = coldStorage
+	}
+}
@@ -22,6 +22,9 @@ package store
 
 import "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 
+// BuildPruneRangeFn builds a function that returns the range of slots to
+// prune for a finalized block, keeping the sidecars within the data
+// availability window.
 func BuildPruneRangeFn[
 	BeaconBlockT BeaconBlock,
 	BlockEventT BlockEvent[BeaconBlockT],
@@ -35,3 +38,27 @@ func BuildPruneRangeFn[
 		return 0, event.Data().GetSlot().Unwrap() - window
 	}
 }
+
+// BuildArchivePruneRangeFn builds a function that returns the range of slots
+// to prune for a finalized block in archive mode, keeping the sidecars of the
+// given number of most recent epochs, and never less than the data
+// availability window. A retention of zero keeps every sidecar.
+func BuildArchivePruneRangeFn[
+	BeaconBlockT BeaconBlock,
+	BlockEventT BlockEvent[BeaconBlockT],
+](
+	cs common.ChainSpec,
+	retentionEpochs uint64,
+) func(BlockEventT) (uint64, uint64) {
+	if retentionEpochs == 0 {
+		return func(BlockEventT) (uint64, uint64) { return 0, 0 }
+	}
+	epochs := max(retentionEpochs, cs.MinEpochsForBlobsSidecarsRequest())
+	return func(event BlockEventT) (uint64, uint64) {
+		window := epochs * cs.SlotsPerEpoch()
+		if event.Data().GetSlot().Unwrap() < window {
+			return 0, 0
+		}
+		return 0, event.Data().GetSlot().Unwrap() - window
+	}
+}
@@ -149,3 +149,44 @@ func TestBuildPruneRangeFn(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildArchivePruneRangeFn(t *testing.T) {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			bytes.B4, math.U64, common.Address, math.U64, any,
+		]{
+			SlotsPerEpoch:                    32,
+			MinEpochsForBlobsSidecarsRequest: 5,
+		},
+	)
+	event := MockBlockEvent{data: MockBeaconBlock{slot: math.U64(1000)}}
+
+	tests := []struct {
+		name            string
+		retentionEpochs uint64
+		expectedEnd     uint64
+	}{
+		{name: "Keeps every sidecar", retentionEpochs: 0, expectedEnd: 0},
+		{name: "Retention epochs", retentionEpochs: 10, expectedEnd: 680},
+		{
+			name:            "Never less than the DA window",
+			retentionEpochs: 2,
+			expectedEnd:     840,
+		},
+		{
+			name:            "Slot less than retention",
+			retentionEpochs: 100,
+			expectedEnd:     0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pruneFn := store.BuildArchivePruneRangeFn[
+				MockBeaconBlock, MockBlockEvent,
+			](cs, tt.retentionEpochs)
+			start, end := pruneFn(event)
+			require.Zero(t, start)
+			require.Equal(t, tt.expectedEnd, end)
+		})
+	}
+}
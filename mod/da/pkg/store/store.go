@@ -21,7 +21,9 @@
 package store
 
 import (
+	"cmp"
 	"context"
+	"slices"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -39,6 +41,8 @@ type Store[BeaconBlockBodyT BeaconBlockBody] struct {
 	logger log.Logger[any]
 	// chainSpec contains the chain specification.
 	chainSpec common.ChainSpec
+	// options are the archive and cold storage options of the store.
+	options
 }
 
 // New creates a new instance of the AvailabilityStore.
@@ -46,12 +50,17 @@ func New[BeaconBlockT BeaconBlockBody](
 	db IndexDB,
 	logger log.Logger[any],
 	chainSpec common.ChainSpec,
+	opts ...Option,
 ) *Store[BeaconBlockT] {
-	return &Store[BeaconBlockT]{
+	s := &Store[BeaconBlockT]{
 		IndexDB:   db,
 		chainSpec: chainSpec,
 		logger:    logger,
 	}
+	for _, opt := range opts {
+		opt(&s.options)
+	}
+	return s
 }
 
 // IsDataAvailable ensures that all blobs referenced in the block are
@@ -83,8 +92,9 @@ func (s *Store[BeaconBlockT]) Persist(
 	}
 
 	// Check to see if we are required to store the sidecar anymore, if
-	// this sidecar is from outside the required DA period, we can skip it.
-	if !s.chainSpec.WithinDAPeriod(
+	// this sidecar is from outside the required DA period, we can skip it,
+	// unless we archive every sidecar.
+	if !s.archive && !s.chainSpec.WithinDAPeriod(
 		// slot in which the sidecar was included.
 		// (Safe to assume all sidecars are in same slot at this point).
 		sidecars.Sidecars[0].BeaconBlockHeader.GetSlot(),
@@ -117,3 +127,120 @@ func (s *Store[BeaconBlockT]) Persist(
 	)
 	return nil
 }
+
+// GetBlobSidecars returns all the sidecars stored for the given slot, ordered
+// by index.
+func (s *Store[_]) GetBlobSidecars(
+	slot math.Slot,
+) (*types.BlobSidecars, error) {
+	return s.GetBlobSidecarsByIndices(slot, nil)
+}
+
+// GetBlobSidecarsByIndices returns the sidecars stored for the given slot
+// whose index is one of the given indices, ordered by index. All the
+// sidecars of the slot are returned if no index is given. Sidecars that
+// have been moved to the cold storage are looked up there.
+func (s *Store[_]) GetBlobSidecarsByIndices(
+	slot math.Slot,
+	indices []uint64,
+) (*types.BlobSidecars, error) {
+	stored, err := s.getStoredSidecars(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	sidecars := make([]*types.BlobSidecar, 0, len(stored))
+	for _, sc := range stored {
+		if len(indices) > 0 && !slices.Contains(indices, sc.Index) {
+			continue
+		}
+		sidecars = append(sidecars, sc)
+	}
+
+	slices.SortFunc(sidecars, func(a, b *types.BlobSidecar) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+	return &types.BlobSidecars{Sidecars: sidecars}, nil
+}
+
+// Prune removes the sidecars of the slots in [start, end) from the store,
+// moving them to the cold storage first if there is one.
+func (s *Store[_]) Prune(start, end uint64) error {
+	if s.coldStorage != nil {
+		if err := s.archiveSlots(start, end); err != nil {
+			return err
+		}
+	}
+	return s.IndexDB.Prune(start, end)
+}
+
+// archiveSlots moves the sidecars of the slots in [start, end) to the cold
+// storage. The slots that were already moved, including before a restart,
+// are skipped.
+func (s *Store[_]) archiveSlots(start, end uint64) error {
+	next, err := s.coldStorage.NextSlot()
+	if err != nil {
+		return err
+	}
+
+	slot := max(start, next.Unwrap())
+	if slot >= end {
+		return nil
+	}
+	for ; slot < end; slot++ {
+		if err = s.archiveSlot(math.Slot(slot)); err != nil {
+			break
+		}
+	}
+	// Record the progress made, even if a slot failed to be moved.
+	return errors.Join(err, s.coldStorage.SetNextSlot(math.Slot(slot)))
+}
+
+// archiveSlot moves the sidecars of the given slot to the cold storage.
+func (s *Store[_]) archiveSlot(slot math.Slot) error {
+	sidecars, err := s.getHotSidecars(slot)
+	if err != nil || len(sidecars) == 0 {
+		return err
+	}
+	slices.SortFunc(sidecars, func(a, b *types.BlobSidecar) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+	return s.coldStorage.Put(slot, &types.BlobSidecars{Sidecars: sidecars})
+}
+
+// getStoredSidecars returns the sidecars stored for the given slot, falling
+// back to the cold storage if none are in the store.
+func (s *Store[_]) getStoredSidecars(
+	slot math.Slot,
+) ([]*types.BlobSidecar, error) {
+	sidecars, err := s.getHotSidecars(slot)
+	if err != nil || len(sidecars) > 0 || s.coldStorage == nil {
+		return sidecars, err
+	}
+	archived, err := s.coldStorage.Get(slot)
+	if err != nil {
+		return nil, err
+	}
+	return archived.Sidecars, nil
+}
+
+// getHotSidecars returns the sidecars stored for the given slot in the
+// index database, in no particular order.
+func (s *Store[_]) getHotSidecars(
+	slot math.Slot,
+) ([]*types.BlobSidecar, error) {
+	values, err := s.IndexDB.GetByIndex(slot.Unwrap())
+	if err != nil {
+		return nil, err
+	}
+
+	sidecars := make([]*types.BlobSidecar, 0, len(values))
+	for _, bz := range values {
+		sc := new(types.BlobSidecar)
+		if err = sc.UnmarshalSSZ(bz); err != nil {
+			return nil, err
+		}
+		sidecars = append(sidecars, sc)
+	}
+	return sidecars, nil
+}
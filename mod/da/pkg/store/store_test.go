@@ -0,0 +1,262 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+// testIndexDB is an in-memory IndexDB.
+type testIndexDB struct {
+	mu     sync.Mutex
+	values map[uint64]map[string][]byte
+}
+
+func (db *testIndexDB) Has(index uint64, key []byte) (bool, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	_, ok := db.values[index][string(key)]
+	return ok, nil
+}
+
+func (db *testIndexDB) Set(index uint64, key []byte, value []byte) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.values[index] == nil {
+		db.values[index] = make(map[string][]byte)
+	}
+	db.values[index][string(key)] = value
+	return nil
+}
+
+func (db *testIndexDB) GetByIndex(index uint64) ([][]byte, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	values := make([][]byte, 0, len(db.values[index]))
+	for _, value := range db.values[index] {
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+func (db *testIndexDB) Prune(start, end uint64) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for index := start; index < end; index++ {
+		delete(db.values, index)
+	}
+	return nil
+}
+
+// testBlockBody is a block body without any commitment.
+type testBlockBody struct{}
+
+func (testBlockBody) GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash] {
+	return nil
+}
+
+func newTestSidecar(slot math.Slot, index uint64) *types.BlobSidecar {
+	return &types.BlobSidecar{
+		Index:         index,
+		KzgCommitment: eip4844.KZGCommitment{byte(index + 1)},
+		BeaconBlockHeader: &ctypes.BeaconBlockHeader{
+			BeaconBlockHeaderBase: ctypes.BeaconBlockHeaderBase{
+				Slot: slot.Unwrap(),
+			},
+		},
+		InclusionProof: make([][32]byte, 8),
+	}
+}
+
+func newTestChainSpec(minEpochs uint64) common.ChainSpec {
+	return chain.NewChainSpec(
+		chain.SpecData[
+			bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+		]{
+			SlotsPerEpoch:                    32,
+			MinEpochsForBlobsSidecarsRequest: minEpochs,
+		},
+	)
+}
+
+func newTestStore(
+	minEpochs uint64, opts ...store.Option,
+) *store.Store[testBlockBody] {
+	return store.New[testBlockBody](
+		&testIndexDB{values: make(map[uint64]map[string][]byte)},
+		noop.NewLogger(),
+		newTestChainSpec(minEpochs),
+		opts...,
+	)
+}
+
+func TestGetBlobSidecars(t *testing.T) {
+	s := newTestStore(4096)
+
+	slot := math.Slot(7)
+	require.NoError(t, s.Persist(slot, &types.BlobSidecars{
+		Sidecars: []*types.BlobSidecar{
+			newTestSidecar(slot, 2),
+			newTestSidecar(slot, 0),
+			newTestSidecar(slot, 1),
+		},
+	}))
+
+	tests := []struct {
+		name     string
+		slot     math.Slot
+		indices  []uint64
+		expected []uint64
+	}{
+		{name: "all", slot: slot, expected: []uint64{0, 1, 2}},
+		{
+			name:     "filtered",
+			slot:     slot,
+			indices:  []uint64{2, 0, 5},
+			expected: []uint64{0, 2},
+		},
+		{name: "empty slot", slot: slot + 1, expected: []uint64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sidecars, err := s.GetBlobSidecarsByIndices(tt.slot, tt.indices)
+			require.NoError(t, err)
+
+			indices := make([]uint64, 0, sidecars.Len())
+			for _, sc := range sidecars.Sidecars {
+				require.Equal(t, tt.slot, sc.BeaconBlockHeader.GetSlot(),
+					fmt.Sprintf("sidecar %d", sc.Index))
+				indices = append(indices, sc.Index)
+			}
+			require.Equal(t, tt.expected, indices)
+		})
+	}
+
+	all, err := s.GetBlobSidecars(slot)
+	require.NoError(t, err)
+	require.Equal(t, 3, all.Len())
+}
+
+func TestPersistArchive(t *testing.T) {
+	// The sidecar is outside of the data availability window of one epoch
+	// at the slot it is persisted at.
+	slot := math.Slot(100)
+	sidecars := &types.BlobSidecars{
+		Sidecars: []*types.BlobSidecar{newTestSidecar(0, 0)},
+	}
+
+	s := newTestStore(1)
+	require.NoError(t, s.Persist(slot, sidecars))
+	stored, err := s.GetBlobSidecars(slot)
+	require.NoError(t, err)
+	require.Zero(t, stored.Len())
+
+	s = newTestStore(1, store.WithArchive())
+	require.NoError(t, s.Persist(slot, sidecars))
+	stored, err = s.GetBlobSidecars(slot)
+	require.NoError(t, err)
+	require.Equal(t, 1, stored.Len())
+}
+
+func TestPruneToColdStorage(t *testing.T) {
+	coldStorage := store.NewColdStorage(afero.NewMemMapFs(), "cold")
+	s := newTestStore(4096, store.WithColdStorage(coldStorage))
+	for slot := range math.Slot(3) {
+		require.NoError(t, s.Persist(slot, &types.BlobSidecars{
+			Sidecars: []*types.BlobSidecar{
+				newTestSidecar(slot, 1),
+				newTestSidecar(slot, 0),
+			},
+		}))
+	}
+
+	require.NoError(t, s.Prune(0, 2))
+	for slot := range math.Slot(2) {
+		// The pruned sidecars are only left in the cold storage, from which
+		// the store still serves them.
+		has, err := s.Has(slot.Unwrap(), []byte{1})
+		require.NoError(t, err)
+		require.False(t, has)
+
+		archived, err := coldStorage.Get(slot)
+		require.NoError(t, err)
+		require.Equal(t, 2, archived.Len())
+
+		sidecars, err := s.GetBlobSidecarsByIndices(slot, []uint64{1})
+		require.NoError(t, err)
+		require.Equal(t, 1, sidecars.Len())
+		require.Equal(t, uint64(1), sidecars.Sidecars[0].Index)
+	}
+
+	archived, err := coldStorage.Get(2)
+	require.NoError(t, err)
+	require.Zero(t, archived.Len())
+}
+
+func TestPruneToColdStorageResumes(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	s := newTestStore(4096, store.WithColdStorage(
+		store.NewColdStorage(fs, "cold"),
+	))
+	require.NoError(t, s.Persist(0, &types.BlobSidecars{
+		Sidecars: []*types.BlobSidecar{newTestSidecar(0, 0)},
+	}))
+	require.NoError(t, s.Prune(0, 1))
+
+	// After a restart, the slots already moved to the cold storage are not
+	// moved again, even if their sidecars are still in the store.
+	coldStorage := store.NewColdStorage(fs, "cold")
+	s = newTestStore(4096, store.WithColdStorage(coldStorage))
+	for slot := range math.Slot(2) {
+		require.NoError(t, s.Persist(slot, &types.BlobSidecars{
+			Sidecars: []*types.BlobSidecar{
+				newTestSidecar(slot, 1),
+				newTestSidecar(slot, 0),
+			},
+		}))
+	}
+	require.NoError(t, s.Prune(0, 2))
+
+	archived, err := coldStorage.Get(0)
+	require.NoError(t, err)
+	require.Equal(t, 1, archived.Len())
+	archived, err = coldStorage.Get(1)
+	require.NoError(t, err)
+	require.Equal(t, 2, archived.Len())
+
+	next, err := coldStorage.NextSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(2), next)
+}
@@ -40,17 +40,40 @@ func (s *EngineClient[
 			ticker.Stop()
 			return
 		case <-ticker.C:
-			if err := s.dialExecutionRPCClient(ctx); err != nil {
-				s.logger.Error(
-					"Failed to refresh engine auth token",
-					"err",
-					err,
-				)
-			}
+			s.refreshBackends(ctx)
 		}
 	}
 }
 
+// refreshBackends re-dials every connected HTTP(S) backend so that its
+// requests carry a fresh JWT token.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) refreshBackends(ctx context.Context) {
+	for _, backend := range s.backends {
+		if !backend.dialURL.IsHTTP() && !backend.dialURL.IsHTTPS() {
+			continue
+		}
+
+		client, err := s.dialExecutionRPCClient(ctx, backend.dialURL)
+		if err != nil {
+			s.logger.Error(
+				"Failed to refresh engine auth token",
+				"dial_url", backend,
+				"err", err,
+			)
+			continue
+		}
+
+		s.mu.Lock()
+		backend.client = client
+		if s.backends[s.active] == backend {
+			s.eth1Client.Store(client)
+		}
+		s.mu.Unlock()
+	}
+}
+
 // buildJWTHeader builds an http.Header that has the JWT token
 // attached for authorization.
 func (s *EngineClient[
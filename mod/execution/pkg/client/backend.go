@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+)
+
+// engineBackend is a single execution client that the EngineClient can
+// route engine API calls to.
+type engineBackend[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+] struct {
+	// dialURL is the url of the execution client JSON-RPC endpoint.
+	dialURL *url.ConnectionURL
+	// client is the connection to the execution client, nil until the
+	// backend has been dialed successfully.
+	client *ethclient.Eth1Client[ExecutionPayloadT]
+	// healthy reports whether the execution client answered the last
+	// call or health check.
+	healthy bool
+	// syncing reports whether the execution client answered the last
+	// payload with a SYNCING or ACCEPTED status.
+	syncing bool
+	// lastPayloadSeq is the sequence number of the last buffered payload
+	// the execution client has been sent.
+	lastPayloadSeq uint64
+}
+
+// newEngineBackends returns the configured backends, the primary first
+// followed by the fallbacks in order of preference.
+func newEngineBackends[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+](cfg *Config) []*engineBackend[ExecutionPayloadT] {
+	backends := make(
+		[]*engineBackend[ExecutionPayloadT],
+		0, len(cfg.RPCFallbackDialURLs)+1,
+	)
+	backends = append(backends, &engineBackend[ExecutionPayloadT]{
+		dialURL: cfg.RPCDialURL,
+	})
+	for _, dialURL := range cfg.RPCFallbackDialURLs {
+		backends = append(backends, &engineBackend[ExecutionPayloadT]{
+			dialURL: dialURL,
+		})
+	}
+	return backends
+}
+
+// String returns the dial url of the backend.
+func (b *engineBackend[_]) String() string {
+	return b.dialURL.String()
+}
+
+// setPayloadStatus records the sync state reported by the execution client.
+func (b *engineBackend[_]) setPayloadStatus(
+	status *engineprimitives.PayloadStatusV1,
+) {
+	b.syncing = status.Status == engineprimitives.PayloadStatusSyncing ||
+		status.Status == engineprimitives.PayloadStatusAccepted
+}
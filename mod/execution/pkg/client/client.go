@@ -25,6 +25,8 @@ import (
 	"math/big"
 	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
@@ -34,17 +36,21 @@ import (
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/service"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 )
 
-// EngineClient is a struct that holds a pointer to an Eth1Client.
+// EngineClient routes engine API and eth calls to the active execution
+// client, failing over to a standby execution client when it goes away.
 type EngineClient[
 	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
 	PayloadAttributesT engineprimitives.PayloadAttributer,
 ] struct {
-	// Eth1Client is a struct that holds the Ethereum 1 client and
-	// its configuration.
-	*ethclient.Eth1Client[ExecutionPayloadT]
+	// eth1Client is the connection to the active backend, which serves the
+	// eth namespace calls. It is swapped whenever another backend becomes
+	// active, so it must only be read through eth1.
+	eth1Client atomic.Pointer[ethclient.Eth1Client[ExecutionPayloadT]]
 	// cfg is the supplied configuration for the engine client.
 	cfg *Config
 	// logger is the logger for the engine client.
@@ -60,6 +66,30 @@ type EngineClient[
 	// engineCache is an all-in-one cache for data
 	// that are retrieved by the EngineClient.
 	engineCache *cache.EngineCache
+
+	// failoverMu serializes catching up and promoting backends, which is
+	// done without holding mu.
+	failoverMu sync.Mutex
+	// mu guards the backends and the failover state below. It is never
+	// held while calling an execution client.
+	mu sync.Mutex
+	// backends are the execution clients engine API calls can be routed
+	// to, the primary first followed by the fallbacks.
+	backends []*engineBackend[ExecutionPayloadT]
+	// active is the index of the backend engine API calls are routed to.
+	active int
+	// payloads are the most recent payloads, retained for replay.
+	payloads []*bufferedPayload[ExecutionPayloadT]
+	// payloadSeq is the sequence number of the last buffered payload.
+	payloadSeq uint64
+	// forkchoice is the latest forkchoice update, retained for replay.
+	forkchoice *forkchoiceUpdate[ExecutionPayloadT, PayloadAttributesT]
+	// build is the latest forkchoice update that started a payload build,
+	// retained to restart the build on a newly promoted backend.
+	build *forkchoiceUpdate[ExecutionPayloadT, PayloadAttributesT]
+
+	// loops runs the background loops of the engine client.
+	loops service.Loops
 }
 
 // New creates a new engine client EngineClient.
@@ -77,16 +107,18 @@ func New[
 ) *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
 ] {
-	return &EngineClient[ExecutionPayloadT, PayloadAttributesT]{
+	s := &EngineClient[ExecutionPayloadT, PayloadAttributesT]{
 		cfg:          cfg,
 		logger:       logger,
 		jwtSecret:    jwtSecret,
-		Eth1Client:   new(ethclient.Eth1Client[ExecutionPayloadT]),
 		capabilities: make(map[string]struct{}),
 		engineCache:  cache.NewEngineCacheWithDefaultConfig(),
 		eth1ChainID:  eth1ChainID,
 		metrics:      newClientMetrics(telemetrySink, logger),
+		backends:     newEngineBackends[ExecutionPayloadT](cfg),
 	}
+	s.eth1Client.Store(new(ethclient.Eth1Client[ExecutionPayloadT]))
+	return s
 }
 
 // Name returns the name of the engine client.
@@ -102,7 +134,8 @@ func (s *EngineClient[
 ]) Start(
 	ctx context.Context,
 ) error {
-	if s.cfg.RPCDialURL.IsHTTP() || s.cfg.RPCDialURL.IsHTTPS() {
+	s.loops.Reset()
+	if s.dialsHTTP() {
 		// If we are dialing with HTTP(S), start the JWT refresh loop.
 		defer func() {
 			if s.jwtSecret == nil {
@@ -112,13 +145,20 @@ func (s *EngineClient[
 				)
 				return
 			}
-			go s.jwtRefreshLoop(ctx)
+			s.loops.Go(ctx, "jwt-refresh", s.jwtRefreshLoop)
 		}()
 	}
 
+	if len(s.backends) > 1 {
+		// If there are standby execution clients, keep track of which
+		// execution clients are healthy.
+		defer s.loops.Go(ctx, "health-check", s.healthCheckLoop)
+	}
+
 	s.logger.Info(
 		"Initializing connection to the execution client...",
 		"dial_url", s.cfg.RPCDialURL.String(),
+		"fallbacks", len(s.cfg.RPCFallbackDialURLs),
 	)
 
 	// If the connection connection succeeds, we can skip the
@@ -147,35 +187,140 @@ func (s *EngineClient[
 	}
 }
 
+// Stop waits for the background loops of the engine client to exit.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) Stop(context.Context) error {
+	s.loops.Wait()
+	return nil
+}
+
+// Close closes the connections to the execution clients.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, backend := range s.backends {
+		if backend.client != nil {
+			backend.client.Close()
+		}
+	}
+	return nil
+}
+
+// Status returns an error if a background loop of the engine client died or
+// no execution client is connected and healthy.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) Status() error {
+	if err := s.loops.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	started := false
+	for _, backend := range s.backends {
+		if backend.healthy {
+			return nil
+		}
+		started = started || backend.client != nil
+	}
+	if !started {
+		return ErrNotStarted
+	}
+	return ErrNoHealthyExecutionClient
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                   Helpers                                  */
 /* -------------------------------------------------------------------------- */
 
-// setupConnection dials the execution client and
-// ensures the chain ID is correct.
+// initializeConnection connects to every backend that is not connected yet
+// and activates the most preferred one. It fails only if no backend could be
+// connected to.
 func (s *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
 ]) initializeConnection(
 	ctx context.Context,
 ) error {
-	var (
-		err     error
-		chainID *big.Int
-	)
+	var err error
+	for _, backend := range s.backends {
+		if backend.healthy {
+			continue
+		}
+
+		var client *ethclient.Eth1Client[ExecutionPayloadT]
+		if client, err = s.connect(ctx, backend.dialURL); err != nil {
+			continue
+		}
+
+		s.mu.Lock()
+		backend.client, backend.healthy = client, true
+		s.mu.Unlock()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for idx, backend := range s.backends {
+		if backend.healthy {
+			s.setActive(idx)
+			return nil
+		}
+	}
+	return err
+}
+
+// connect dials the execution client at dialURL, ensures the chain ID is
+// correct and exchanges capabilities with it.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) connect(
+	ctx context.Context,
+	dialURL *url.ConnectionURL,
+) (*ethclient.Eth1Client[ExecutionPayloadT], error) {
+	// Dial the execution client.
+	client, err := s.dialExecutionRPCClient(ctx, dialURL)
+	if err != nil {
+		return nil, err
+	}
 
 	defer func() {
 		if err != nil {
-			s.Client.Close()
+			client.Close()
 		}
 	}()
 
-	// Dial the execution client.
-	if err = s.dialExecutionRPCClient(ctx); err != nil {
-		return err
+	// After the initial dial, check to make sure the chain ID is correct.
+	if err = s.verifyChainID(ctx, client); err != nil {
+		return nil, err
 	}
 
-	// After the initial dial, check to make sure the chain ID is correct.
-	chainID, err = s.Client.ChainID(ctx)
+	// Log the chain ID.
+	s.logger.Info(
+		"Connected to execution client 🔌",
+		"dial_url",
+		dialURL.String(),
+		"required_chain_id",
+		s.eth1ChainID,
+	)
+
+	// Exchange capabilities with the execution client.
+	if _, err = s.exchangeCapabilities(ctx, client); err != nil {
+		s.logger.Error("failed to exchange capabilities", "err", err)
+		return nil, err
+	}
+	return client, nil
+}
+
+// verifyChainID ensures the execution client is on the expected chain.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) verifyChainID(
+	ctx context.Context,
+	client *ethclient.Eth1Client[ExecutionPayloadT],
+) error {
+	chainID, err := client.ChainID(ctx)
 	if err != nil {
 		if strings.Contains(err.Error(), "401 Unauthorized") {
 			// We always log this error as it is a critical error.
@@ -185,32 +330,26 @@ func (s *EngineClient[
 	}
 
 	if chainID.Uint64() != s.eth1ChainID.Uint64() {
-		err = errors.Wrapf(
+		return errors.Wrapf(
 			ErrMismatchedEth1ChainID,
 			"wanted chain ID %d, got %d",
 			s.eth1ChainID,
 			chainID.Uint64(),
 		)
-		return err
 	}
+	return nil
+}
 
-	// Log the chain ID.
-	s.logger.Info(
-		"Connected to execution client 🔌",
-		"dial_url",
-		s.cfg.RPCDialURL.String(),
-		"chain_id",
-		chainID.Uint64(),
-		"required_chain_id",
-		s.eth1ChainID,
-	)
-
-	// Exchange capabilities with the execution client.
-	if _, err = s.ExchangeCapabilities(ctx); err != nil {
-		s.logger.Error("failed to exchange capabilities", "err", err)
-		return err
+// dialsHTTP reports whether any backend is dialed over HTTP(S).
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) dialsHTTP() bool {
+	for _, backend := range s.backends {
+		if backend.dialURL.IsHTTP() || backend.dialURL.IsHTTPS() {
+			return true
+		}
 	}
-	return nil
+	return false
 }
 
 /* -------------------------------------------------------------------------- */
@@ -222,7 +361,8 @@ func (s *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
 ]) dialExecutionRPCClient(
 	ctx context.Context,
-) error {
+	dialURL *url.ConnectionURL,
+) (*ethclient.Eth1Client[ExecutionPayloadT], error) {
 	var (
 		client *ethrpc.Client
 		err    error
@@ -230,40 +370,36 @@ func (s *EngineClient[
 
 	// Dial the execution client based on the URL scheme.
 	switch {
-	case s.cfg.RPCDialURL.IsHTTP(), s.cfg.RPCDialURL.IsHTTPS():
+	case dialURL.IsHTTP(), dialURL.IsHTTPS():
 		// Build an http.Header with the JWT token attached.
 		if s.jwtSecret != nil {
 			var header http.Header
 			if header, err = s.buildJWTHeader(); err != nil {
-				return err
+				return nil, err
 			}
 			if client, err = ethrpc.DialOptions(
-				ctx, s.cfg.RPCDialURL.String(), ethrpc.WithHeaders(header),
+				ctx, dialURL.String(), ethrpc.WithHeaders(header),
 			); err != nil {
-				return err
+				return nil, err
 			}
 		} else {
 			if client, err = ethrpc.DialContext(
-				ctx, s.cfg.RPCDialURL.String()); err != nil {
-				return err
+				ctx, dialURL.String()); err != nil {
+				return nil, err
 			}
 		}
-	case s.cfg.RPCDialURL.IsIPC():
+	case dialURL.IsIPC():
 		if client, err = ethrpc.DialIPC(
-			ctx, s.cfg.RPCDialURL.Path); err != nil {
+			ctx, dialURL.Path); err != nil {
 			s.logger.Error("failed to dial IPC", "err", err)
-			return err
+			return nil, err
 		}
 	default:
-		return errors.Newf(
+		return nil, errors.Newf(
 			"no known transport for URL scheme %q",
-			s.cfg.RPCDialURL.Scheme,
+			dialURL.Scheme,
 		)
 	}
 
-	// Refresh the execution client with the new client.
-	s.Eth1Client, err = ethclient.NewFromRPCClient[ExecutionPayloadT](
-		client,
-	)
-	return err
+	return ethclient.NewFromRPCClient[ExecutionPayloadT](client)
 }
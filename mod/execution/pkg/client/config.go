@@ -32,6 +32,7 @@ const (
 	defaultRPCTimeout              = 2 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
 	defaultRPCJWTRefreshInterval   = 20 * time.Second
+	defaultRPCHealthCheckInterval  = 5 * time.Second
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
 )
@@ -40,12 +41,17 @@ const (
 func DefaultConfig() Config {
 	//#nosec:G703 // ignoring on purpose since it is the default URL.
 	dialURL, _ := url.NewFromRaw(defaultDialURL)
+	//#nosec:G703 // an empty URL always parses.
+	shadowDialURL, _ := url.NewFromRaw("")
 	return Config{
 		RPCDialURL:              dialURL,
+		RPCFallbackDialURLs:     make([]*url.ConnectionURL, 0),
+		RPCShadowDialURL:        shadowDialURL,
 		RPCRetries:              defaultRPCRetries,
 		RPCTimeout:              defaultRPCTimeout,
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
+		RPCHealthCheckInterval:  defaultRPCHealthCheckInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
 	}
 }
@@ -56,6 +62,13 @@ func DefaultConfig() Config {
 type Config struct {
 	// RPCDialURL is the HTTP url of the execution client JSON-RPC endpoint.
 	RPCDialURL *url.ConnectionURL `mapstructure:"rpc-dial-url"`
+	// RPCFallbackDialURLs are the urls of the standby execution clients,
+	// in order of preference, that are promoted when the primary fails.
+	RPCFallbackDialURLs []*url.ConnectionURL `mapstructure:"rpc-fallback-dial-urls"`
+	// RPCShadowDialURL is the url of an optional shadow execution client,
+	// which is sent the same calls as the primary so that its responses can
+	// be compared. It is left empty to disable the shadow execution client.
+	RPCShadowDialURL *url.ConnectionURL `mapstructure:"rpc-shadow-dial-url"`
 	// RPCRetries is the number of retries before shutting down consensus
 	// client.
 	RPCRetries uint64 `mapstructure:"rpc-retries"`
@@ -65,6 +78,22 @@ type Config struct {
 	RPCStartupCheckInterval time.Duration `mapstructure:"rpc-startup-check-interval"`
 	// JWTRefreshInterval is the Interval for the JWT refresh.
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
+	// RPCHealthCheckInterval is the Interval for the execution client
+	// health checks.
+	RPCHealthCheckInterval time.Duration `mapstructure:"rpc-health-check-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
 }
+
+// ShadowConfig returns the configuration for the shadow execution client,
+// or nil if no shadow execution client is configured.
+func (c *Config) ShadowConfig() *Config {
+	if c.RPCShadowDialURL == nil || c.RPCShadowDialURL.String() == "" {
+		return nil
+	}
+	shadow := *c
+	shadow.RPCDialURL = c.RPCShadowDialURL
+	shadow.RPCFallbackDialURLs = nil
+	shadow.RPCShadowDialURL = nil
+	return &shadow
+}
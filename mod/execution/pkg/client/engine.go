@@ -45,17 +45,35 @@ func (s *EngineClient[
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
 ) (*common.ExecutionHash, error) {
-	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
-	)
+	startTime := time.Now()
 	defer s.metrics.measureNewPayloadDuration(startTime)
-	defer cancel()
 
 	// Call the appropriate RPC method based on the payload version.
-	result, err := s.Eth1Client.NewPayload(
-		cctx, payload, versionedHashes, parentBeaconBlockRoot,
-	)
+	var result *engineprimitives.PayloadStatusV1
+	err := s.callWithFailover(ctx, func(
+		cctx context.Context,
+		backend *engineBackend[ExecutionPayloadT],
+		client *ethclient.Eth1Client[ExecutionPayloadT],
+	) error {
+		var err error
+		result, err = client.NewPayload(
+			cctx, payload, versionedHashes, parentBeaconBlockRoot,
+		)
+		if err != nil || result == nil {
+			return err
+		}
+
+		// Retain the payload so it can be replayed to a standby backend.
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		backend.setPayloadStatus(result)
+		if result.Status != engineprimitives.PayloadStatusInvalid {
+			backend.lastPayloadSeq = s.bufferPayload(
+				payload, versionedHashes, parentBeaconBlockRoot,
+			)
+		}
+		return nil
+	})
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementNewPayloadTimeout()
@@ -91,12 +109,8 @@ func (s *EngineClient[
 	attrs PayloadAttributesT,
 	forkVersion uint32,
 ) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
-	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
-	)
+	startTime := time.Now()
 	defer s.metrics.measureForkchoiceUpdateDuration(startTime)
-	defer cancel()
 
 	// If the suggested fee recipient is not set, log a warning.
 	if !attrs.IsNil() &&
@@ -108,10 +122,39 @@ func (s *EngineClient[
 		)
 	}
 
-	result, err := s.Eth1Client.ForkchoiceUpdated(
-		cctx, state, attrs, forkVersion,
-	)
+	var result *engineprimitives.ForkchoiceResponseV1
+	err := s.callWithFailover(ctx, func(
+		cctx context.Context,
+		backend *engineBackend[ExecutionPayloadT],
+		client *ethclient.Eth1Client[ExecutionPayloadT],
+	) error {
+		var err error
+		result, err = client.ForkchoiceUpdated(
+			cctx, state, attrs, forkVersion,
+		)
+		if err != nil || result == nil {
+			return err
+		}
 
+		// Retain the update so it can be replayed to a standby backend.
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		backend.setPayloadStatus(&result.PayloadStatus)
+		update := &forkchoiceUpdate[ExecutionPayloadT, PayloadAttributesT]{
+			state:       state,
+			attrs:       attrs,
+			forkVersion: forkVersion,
+			payloadSeq:  s.payloadSeq,
+			backend:     backend,
+		}
+		s.forkchoice = update
+		if !attrs.IsNil() && result.PayloadID != nil {
+			update.payloadID = *result.PayloadID
+			update.backendPayloadID = *result.PayloadID
+			s.build = update
+		}
+		return nil
+	})
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementForkchoiceUpdateTimeout()
@@ -141,15 +184,23 @@ func (s *EngineClient[
 	payloadID engineprimitives.PayloadID,
 	forkVersion uint32,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
-	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
-	)
+	startTime := time.Now()
 	defer s.metrics.measureGetPayloadDuration(startTime)
-	defer cancel()
 
 	// Call and check for errors.
-	result, err := s.Eth1Client.GetPayload(cctx, payloadID, forkVersion)
+	var result engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT]
+	err := s.callWithFailover(ctx, func(
+		cctx context.Context,
+		backend *engineBackend[ExecutionPayloadT],
+		client *ethclient.Eth1Client[ExecutionPayloadT],
+	) error {
+		id, err := s.backendPayloadID(cctx, backend, client, payloadID)
+		if err != nil {
+			return err
+		}
+		result, err = client.GetPayload(cctx, id, forkVersion)
+		return err
+	})
 	switch {
 	case err != nil:
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
@@ -165,6 +216,46 @@ func (s *EngineClient[
 	return result, nil
 }
 
+// backendPayloadID returns the identifier the backend knows the payload
+// build by. If the build was started on a different backend before a
+// failover, it is restarted on this one.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) backendPayloadID(
+	ctx context.Context,
+	backend *engineBackend[ExecutionPayloadT],
+	client *ethclient.Eth1Client[ExecutionPayloadT],
+	payloadID engineprimitives.PayloadID,
+) (engineprimitives.PayloadID, error) {
+	s.mu.Lock()
+	build := s.build
+	if build == nil || build.payloadID != payloadID {
+		s.mu.Unlock()
+		return payloadID, nil
+	} else if build.backend == backend {
+		defer s.mu.Unlock()
+		return build.backendPayloadID, nil
+	}
+	state, attrs, forkVersion := build.state, build.attrs, build.forkVersion
+	s.mu.Unlock()
+
+	s.logger.Info(
+		"Restarting payload build on promoted execution client",
+		"dial_url", backend, "payload_id", payloadID,
+	)
+	result, err := client.ForkchoiceUpdated(ctx, state, attrs, forkVersion)
+	if err != nil {
+		return payloadID, err
+	} else if result == nil || result.PayloadID == nil {
+		return payloadID, ErrNilPayloadID
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	build.backend, build.backendPayloadID = backend, *result.PayloadID
+	return build.backendPayloadID, nil
+}
+
 // ExchangeCapabilities calls the engine_exchangeCapabilities method via
 // JSON-RPC.
 func (s *EngineClient[
@@ -172,13 +263,27 @@ func (s *EngineClient[
 ]) ExchangeCapabilities(
 	ctx context.Context,
 ) ([]string, error) {
-	result, err := s.Eth1Client.ExchangeCapabilities(
+	return s.exchangeCapabilities(ctx, s.eth1())
+}
+
+// exchangeCapabilities exchanges capabilities with the given execution
+// client.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) exchangeCapabilities(
+	ctx context.Context,
+	client *ethclient.Eth1Client[ExecutionPayloadT],
+) ([]string, error) {
+	result, err := client.ExchangeCapabilities(
 		ctx, ethclient.BeaconKitSupportedCapabilities(),
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	// Capture and log the capabilities that the execution client has.
 	for _, capability := range result {
 		s.logger.Info("Exchanged capability", "capability", capability)
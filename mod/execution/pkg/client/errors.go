@@ -54,6 +54,21 @@ var (
 	// ErrMismatchedEth1ChainID is returned when the chainID does not
 	// match the expected chain ID.
 	ErrMismatchedEth1ChainID = errors.New("mismatched chain ID")
+
+	// ErrNilPayloadID is returned when the execution client does not start
+	// a payload build in response to a forkchoice update.
+	ErrNilPayloadID = errors.New("nil payload ID")
+
+	// ErrNoHealthyExecutionClient is returned when none of the execution
+	// clients is healthy.
+	ErrNoHealthyExecutionClient = errors.New("no healthy execution client")
+
+	// ErrExecutionClientTooFarBehind is returned when an execution client
+	// missed payloads that can no longer be replayed to it, and has not
+	// synced the latest forkchoice state from its peers yet.
+	ErrExecutionClientTooFarBehind = errors.New(
+		"execution client too far behind",
+	)
 )
 
 // Handles errors received from the RPC server according to the specification.
@@ -25,9 +25,27 @@ import (
 	"math/big"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/ethereum/go-ethereum"
+	coretypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// eth1 returns the connection to the active execution client.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) eth1() *ethclient.Eth1Client[ExecutionPayloadT] {
+	return s.eth1Client.Load()
+}
+
+// BlockNumber returns the number of the latest block.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) BlockNumber(ctx context.Context) (uint64, error) {
+	return s.eth1().BlockNumber(ctx)
+}
+
 // HeaderByNumber retrieves the block header by its number.
 func (s *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
@@ -49,7 +67,7 @@ func (s *EngineClient[
 		return header, nil
 	}
 
-	header, err := s.Client.HeaderByNumber(ctx, number)
+	header, err := s.eth1().HeaderByNumber(ctx, number)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +78,23 @@ func (s *EngineClient[
 	return header, nil
 }
 
+// HasPendingTransactions returns whether the pending block of the execution
+// client holds any transaction.
+//
+// NOTE: The pending block is never cached, since it changes with the
+// transaction pool of the execution client.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) HasPendingTransactions(ctx context.Context) (bool, error) {
+	header, err := s.eth1().HeaderByNumber(
+		ctx, big.NewInt(rpc.PendingBlockNumber.Int64()),
+	)
+	if err != nil {
+		return false, err
+	}
+	return header.TxHash != coretypes.EmptyTxsHash, nil
+}
+
 // HeaderByHash retrieves the block header by its hash.
 func (s *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
@@ -72,10 +107,109 @@ func (s *EngineClient[
 	if ok {
 		return header, nil
 	}
-	header, err := s.Client.HeaderByHash(ctx, hash)
+	header, err := s.eth1().HeaderByHash(ctx, hash)
 	if err != nil {
 		return nil, err
 	}
 	s.engineCache.AddHeader(header)
 	return header, nil
 }
+
+// CodeAt returns the code of the given account at the given block number.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) CodeAt(
+	ctx context.Context,
+	account common.ExecutionAddress,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	return s.eth1().CodeAt(ctx, account, blockNumber)
+}
+
+// CallContract executes a message call at the given block number.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) CallContract(
+	ctx context.Context,
+	msg ethereum.CallMsg,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	return s.eth1().CallContract(ctx, msg, blockNumber)
+}
+
+// PendingCodeAt returns the code of the given account in the pending state.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) PendingCodeAt(
+	ctx context.Context,
+	account common.ExecutionAddress,
+) ([]byte, error) {
+	return s.eth1().PendingCodeAt(ctx, account)
+}
+
+// PendingNonceAt returns the nonce of the given account in the pending
+// state.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) PendingNonceAt(
+	ctx context.Context,
+	account common.ExecutionAddress,
+) (uint64, error) {
+	return s.eth1().PendingNonceAt(ctx, account)
+}
+
+// SuggestGasPrice returns the suggested gas price.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return s.eth1().SuggestGasPrice(ctx)
+}
+
+// SuggestGasTipCap returns the suggested gas tip cap.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return s.eth1().SuggestGasTipCap(ctx)
+}
+
+// EstimateGas estimates the gas needed to execute the given message call.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) EstimateGas(
+	ctx context.Context,
+	msg ethereum.CallMsg,
+) (uint64, error) {
+	return s.eth1().EstimateGas(ctx, msg)
+}
+
+// SendTransaction submits the given signed transaction.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) SendTransaction(
+	ctx context.Context,
+	tx *coretypes.Transaction,
+) error {
+	return s.eth1().SendTransaction(ctx, tx)
+}
+
+// FilterLogs returns the logs matching the given filter query.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) FilterLogs(
+	ctx context.Context,
+	query ethereum.FilterQuery,
+) ([]coretypes.Log, error) {
+	return s.eth1().FilterLogs(ctx, query)
+}
+
+// SubscribeFilterLogs subscribes to the logs matching the given filter
+// query.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) SubscribeFilterLogs(
+	ctx context.Context,
+	query ethereum.FilterQuery,
+	ch chan<- coretypes.Log,
+) (ethereum.Subscription, error) {
+	return s.eth1().SubscribeFilterLogs(ctx, query, ch)
+}
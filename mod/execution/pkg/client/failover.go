@@ -0,0 +1,449 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	jsonrpc "github.com/berachain/beacon-kit/mod/primitives/pkg/net/json-rpc"
+)
+
+// payloadReplayDepth is the number of most recent payloads retained to
+// catch up the standby execution clients.
+const payloadReplayDepth = 64
+
+// bufferedPayload is a payload previously sent via engine_newPayloadVX,
+// retained so that it can be replayed to a newly promoted execution client.
+type bufferedPayload[ExecutionPayloadT any] struct {
+	seq                   uint64
+	payload               ExecutionPayloadT
+	versionedHashes       []common.ExecutionHash
+	parentBeaconBlockRoot *common.Root
+}
+
+// forkchoiceUpdate is a forkchoice update previously sent via
+// engine_forkchoiceUpdatedVX.
+type forkchoiceUpdate[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+	PayloadAttributesT any,
+] struct {
+	state       *engineprimitives.ForkchoiceStateV1
+	attrs       PayloadAttributesT
+	forkVersion uint32
+	// payloadSeq is the sequence number of the last buffered payload when
+	// the update was sent, which the head of the update refers to.
+	payloadSeq uint64
+	// payloadID is the identifier handed back to the caller.
+	payloadID engineprimitives.PayloadID
+	// backend is the execution client building the payload, and
+	// backendPayloadID the identifier it assigned to the build.
+	backend          *engineBackend[ExecutionPayloadT]
+	backendPayloadID engineprimitives.PayloadID
+}
+
+// callWithFailover invokes fn against the active backend. Whenever the
+// active backend fails to respond, the next healthy backend is promoted and
+// the call is retried against it. It must be called without s.mu held.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) callWithFailover(
+	ctx context.Context,
+	fn func(
+		context.Context,
+		*engineBackend[ExecutionPayloadT],
+		*ethclient.Eth1Client[ExecutionPayloadT],
+	) error,
+) error {
+	var err error
+	for range s.backends {
+		s.mu.Lock()
+		active := s.active
+		backend := s.backends[active]
+		client := backend.client
+		s.mu.Unlock()
+		if client == nil {
+			return ErrNotStarted
+		}
+
+		cctx, cancel := s.createContextWithTimeout(ctx)
+		err = fn(cctx, backend, client)
+		cancel()
+		if !isTransportError(ctx, err) {
+			s.mu.Lock()
+			backend.healthy = true
+			s.mu.Unlock()
+			return err
+		}
+
+		s.logger.Warn(
+			"Execution client is not responding",
+			"dial_url", backend, "err", err,
+		)
+		s.mu.Lock()
+		backend.healthy = false
+		s.mu.Unlock()
+
+		s.failoverMu.Lock()
+		promoted := s.promoteNextHealthy(ctx, active)
+		s.failoverMu.Unlock()
+		if !promoted {
+			return err
+		}
+	}
+	return err
+}
+
+// promoteNextHealthy promotes the most preferred healthy backend other than
+// the failed one, favouring backends that are not syncing. It returns false
+// if no backend could be promoted. It must be called with s.failoverMu
+// held.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) promoteNextHealthy(ctx context.Context, failed int) bool {
+	s.mu.Lock()
+	// Another call may have failed over while this one was waiting.
+	if s.active != failed {
+		s.mu.Unlock()
+		return true
+	}
+	candidates := make([][]int, 2)
+	for idx, backend := range s.backends {
+		switch {
+		case idx == failed || !backend.healthy:
+		case backend.syncing:
+			candidates[1] = append(candidates[1], idx)
+		default:
+			candidates[0] = append(candidates[0], idx)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, idx := range append(candidates[0], candidates[1]...) {
+		if err := s.promote(ctx, idx); err != nil {
+			s.logger.Warn(
+				"Failed to promote execution client",
+				"dial_url", s.backends[idx], "err", err,
+			)
+			s.mu.Lock()
+			s.backends[idx].healthy = false
+			s.mu.Unlock()
+			continue
+		}
+		return true
+	}
+	s.logger.Error("No healthy execution client left to fail over to")
+	return false
+}
+
+// promote catches the backend at idx up with the active backend and makes
+// it the active backend. It must be called with s.failoverMu held.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) promote(ctx context.Context, idx int) error {
+	backend := s.backends[idx]
+	for {
+		if err := s.catchUp(ctx, backend); err != nil {
+			return err
+		}
+
+		// Payloads may have been sent to the active backend while the
+		// backend was being caught up, in which case it is caught up again.
+		s.mu.Lock()
+		if backend.lastPayloadSeq < s.payloadSeq {
+			s.mu.Unlock()
+			continue
+		}
+		previous := s.backends[s.active]
+		s.setActive(idx)
+		s.mu.Unlock()
+
+		s.logger.Info(
+			"Promoted execution client 🔀",
+			"dial_url", backend,
+			"previous_dial_url", previous,
+		)
+		s.metrics.incrementFailoverCounter()
+		return nil
+	}
+}
+
+// catchUp sends the buffered payloads the backend has not seen yet,
+// followed by the latest forkchoice state, so that it can take over from
+// the active backend at any time. A backend that missed payloads which are
+// no longer buffered is only caught up once it has synced the latest
+// forkchoice state from its peers. It must be called with s.failoverMu
+// held.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) catchUp(
+	ctx context.Context,
+	backend *engineBackend[ExecutionPayloadT],
+) error {
+	s.mu.Lock()
+	client, forkchoice := backend.client, s.forkchoice
+	behind := len(s.payloads) > 0 &&
+		s.payloads[0].seq > backend.lastPayloadSeq+1
+	s.mu.Unlock()
+	if client == nil {
+		return ErrNotStarted
+	}
+
+	if behind {
+		if err := s.syncForkchoice(ctx, backend, client, forkchoice); err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	payloads := make([]*bufferedPayload[ExecutionPayloadT], 0, len(s.payloads))
+	for _, p := range s.payloads {
+		if p.seq > backend.lastPayloadSeq {
+			payloads = append(payloads, p)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, p := range payloads {
+		cctx, cancel := s.createContextWithTimeout(ctx)
+		status, err := client.NewPayload(
+			cctx, p.payload, p.versionedHashes, p.parentBeaconBlockRoot,
+		)
+		cancel()
+		if err != nil {
+			return err
+		}
+		s.mu.Lock()
+		backend.lastPayloadSeq = p.seq
+		backend.setPayloadStatus(status)
+		s.mu.Unlock()
+	}
+
+	if forkchoice == nil || behind {
+		return nil
+	}
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+	result, err := client.ForkchoiceUpdated(
+		cctx, forkchoice.state, nil, forkchoice.forkVersion,
+	)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	backend.setPayloadStatus(&result.PayloadStatus)
+	s.mu.Unlock()
+	return nil
+}
+
+// syncForkchoice sends the latest forkchoice state to a backend that missed
+// payloads which are no longer buffered. Once the backend has synced the
+// head of the forkchoice state, it has seen every payload up to it.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) syncForkchoice(
+	ctx context.Context,
+	backend *engineBackend[ExecutionPayloadT],
+	client *ethclient.Eth1Client[ExecutionPayloadT],
+	forkchoice *forkchoiceUpdate[ExecutionPayloadT, PayloadAttributesT],
+) error {
+	if forkchoice == nil {
+		return ErrExecutionClientTooFarBehind
+	}
+
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+	result, err := client.ForkchoiceUpdated(
+		cctx, forkchoice.state, nil, forkchoice.forkVersion,
+	)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	backend.setPayloadStatus(&result.PayloadStatus)
+	if result.PayloadStatus.Status != engineprimitives.PayloadStatusValid {
+		return errors.Wrapf(
+			ErrExecutionClientTooFarBehind,
+			"forkchoice status: %s", result.PayloadStatus.Status,
+		)
+	}
+	backend.lastPayloadSeq = max(
+		backend.lastPayloadSeq, forkchoice.payloadSeq,
+	)
+	return nil
+}
+
+// setActive makes the backend at idx the active backend. It must be called
+// with s.mu held.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) setActive(idx int) {
+	s.active = idx
+	s.eth1Client.Store(s.backends[idx].client)
+}
+
+// bufferPayload retains a payload for replay and returns its sequence
+// number. It must be called with s.mu held.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) bufferPayload(
+	payload ExecutionPayloadT,
+	versionedHashes []common.ExecutionHash,
+	parentBeaconBlockRoot *common.Root,
+) uint64 {
+	s.payloadSeq++
+	if len(s.payloads) == payloadReplayDepth {
+		s.payloads = s.payloads[1:]
+	}
+	s.payloads = append(s.payloads, &bufferedPayload[ExecutionPayloadT]{
+		seq:                   s.payloadSeq,
+		payload:               payload,
+		versionedHashes:       versionedHashes,
+		parentBeaconBlockRoot: parentBeaconBlockRoot,
+	})
+	return s.payloadSeq
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                Health Checks                               */
+/* -------------------------------------------------------------------------- */
+
+// healthCheckLoop periodically checks every backend, reconnecting to the
+// ones that went away and switching back to the most preferred healthy
+// backend once it recovers.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) healthCheckLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.RPCHealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.checkBackends(ctx)
+		}
+	}
+}
+
+// checkBackends runs a single round of health checks. The healthy standby
+// backends are caught up with the active backend, so that they are ready to
+// take over from it.
+func (s *EngineClient[
+	ExecutionPayloadT, PayloadAttributesT,
+]) checkBackends(ctx context.Context) {
+	// Snapshot the clients so that the engine API is not blocked while the
+	// backends are being probed.
+	s.mu.Lock()
+	clients := make(
+		[]*ethclient.Eth1Client[ExecutionPayloadT], len(s.backends),
+	)
+	for idx, backend := range s.backends {
+		clients[idx] = backend.client
+	}
+	s.mu.Unlock()
+
+	healthy := make([]bool, len(clients))
+	dialed := make([]*ethclient.Eth1Client[ExecutionPayloadT], len(clients))
+	for idx, client := range clients {
+		if client != nil {
+			cctx, cancel := s.createContextWithTimeout(ctx)
+			healthy[idx] = s.verifyChainID(cctx, client) == nil
+			cancel()
+		}
+		if healthy[idx] {
+			continue
+		}
+
+		// Try to reconnect to a backend that is not responding.
+		client, err := s.connect(ctx, s.backends[idx].dialURL)
+		if err != nil {
+			continue
+		}
+		dialed[idx], healthy[idx] = client, true
+	}
+
+	s.failoverMu.Lock()
+	defer s.failoverMu.Unlock()
+
+	s.mu.Lock()
+	for idx, backend := range s.backends {
+		backend.healthy = healthy[idx]
+		if dialed[idx] != nil {
+			backend.client = dialed[idx]
+		}
+	}
+	active := s.active
+	s.eth1Client.Store(s.backends[active].client)
+	s.mu.Unlock()
+
+	for idx, backend := range s.backends {
+		if idx == active || !healthy[idx] {
+			continue
+		}
+		if err := s.catchUp(ctx, backend); err != nil {
+			s.logger.Warn(
+				"Failed to catch up standby execution client",
+				"dial_url", backend, "err", err,
+			)
+			healthy[idx] = false
+		}
+	}
+
+	// Switch back to a more preferred backend once it is healthy again.
+	for idx, backend := range s.backends[:active] {
+		if !healthy[idx] {
+			continue
+		}
+		if err := s.promote(ctx, idx); err != nil {
+			s.logger.Warn(
+				"Failed to restore execution client",
+				"dial_url", backend, "err", err,
+			)
+			continue
+		}
+		return
+	}
+
+	// Move away from the active backend if it stopped responding.
+	if !healthy[active] {
+		s.promoteNextHealthy(ctx, active)
+	}
+}
+
+// isTransportError reports whether err indicates that the execution client
+// could not be reached, as opposed to a JSON-RPC error returned by a
+// responsive execution client or the caller giving up.
+func isTransportError(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	//nolint:errorlint // from prysm.
+	_, ok := err.(jsonrpc.Error)
+	return !ok
+}
@@ -0,0 +1,394 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = 80087
+
+type testPayload struct {
+	Number math.U64 `json:"blockNumber"`
+}
+
+func (p *testPayload) Empty(uint32) *testPayload { return new(testPayload) }
+
+func (p *testPayload) Version() uint32 { return version.Deneb }
+
+func (p *testPayload) IsNil() bool { return p == nil }
+
+func (p *testPayload) MarshalJSON() ([]byte, error) {
+	type payload testPayload
+	return json.Marshal((*payload)(p))
+}
+
+func (p *testPayload) UnmarshalJSON(input []byte) error {
+	type payload testPayload
+	return json.Unmarshal(input, (*payload)(p))
+}
+
+type testAttributes struct {
+	Timestamp math.U64 `json:"timestamp"`
+}
+
+func (a *testAttributes) Version() uint32 { return version.Deneb }
+
+func (a *testAttributes) IsNil() bool { return a == nil }
+
+func (a *testAttributes) GetSuggestedFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{1}
+}
+
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string) {}
+
+func (noopSink) SetGauge(string, int64, ...string) {}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+// fakeEngine is an execution client answering the engine API calls made by
+// the EngineClient.
+type fakeEngine struct {
+	mu sync.Mutex
+	// payloads are the block numbers of the received payloads.
+	payloads []math.U64
+	// builds is the number of payload builds started.
+	builds uint64
+	// syncing makes forkchoice updates answer with a SYNCING status.
+	syncing bool
+}
+
+func (e *fakeEngine) ExchangeCapabilities(capabilities []string) []string {
+	return capabilities
+}
+
+func (e *fakeEngine) NewPayloadV3(
+	payload testPayload, _, _ json.RawMessage,
+) *engineprimitives.PayloadStatusV1 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.payloads = append(e.payloads, payload.Number)
+	return &engineprimitives.PayloadStatusV1{
+		Status: engineprimitives.PayloadStatusValid,
+	}
+}
+
+func (e *fakeEngine) ForkchoiceUpdatedV3(
+	_ json.RawMessage, attrs *testAttributes,
+) *engineprimitives.ForkchoiceResponseV1 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	result := &engineprimitives.ForkchoiceResponseV1{
+		PayloadStatus: engineprimitives.PayloadStatusV1{
+			Status: engineprimitives.PayloadStatusValid,
+		},
+	}
+	if e.syncing {
+		result.PayloadStatus.Status = engineprimitives.PayloadStatusSyncing
+	}
+	if attrs != nil {
+		e.builds++
+		result.PayloadID = &engineprimitives.PayloadID{byte(e.builds)}
+	}
+	return result
+}
+
+func (e *fakeEngine) GetPayloadV3(
+	payloadID engineprimitives.PayloadID,
+) (map[string]any, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if payloadID[0] == 0 || uint64(payloadID[0]) > e.builds {
+		return nil, &rpcError{code: -38001, msg: "unknown payload"}
+	}
+	return map[string]any{
+		"executionPayload": testPayload{Number: math.U64(payloadID[0])},
+		"blockValue":       "0x0",
+		"blobsBundle": map[string]any{
+			"commitments": []string{},
+			"proofs":      []string{},
+			"blobs":       []string{},
+		},
+		"shouldOverrideBuilder": false,
+	}, nil
+}
+
+func (e *fakeEngine) received() []math.U64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]math.U64(nil), e.payloads...)
+}
+
+type rpcError struct {
+	code int
+	msg  string
+}
+
+func (e *rpcError) Error() string { return e.msg }
+
+func (e *rpcError) ErrorCode() int { return e.code }
+
+type fakeEth struct{}
+
+func (fakeEth) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(testChainID))
+}
+
+func (fakeEth) BlockNumber() hexutil.Uint64 {
+	return 1
+}
+
+func newFakeExecutionClient(
+	t *testing.T,
+) (*fakeEngine, *httptest.Server) {
+	t.Helper()
+	engine := new(fakeEngine)
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("engine", engine))
+	require.NoError(t, server.RegisterName("eth", fakeEth{}))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	t.Cleanup(server.Stop)
+	return engine, httpServer
+}
+
+func newTestEngineClient(
+	t *testing.T, dialURLs ...string,
+) *client.EngineClient[*testPayload, *testAttributes] {
+	t.Helper()
+	return newHealthCheckedEngineClient(t, time.Hour, dialURLs...)
+}
+
+// newHealthCheckedEngineClient returns an engine client checking the health
+// of its execution clients at the given interval.
+func newHealthCheckedEngineClient(
+	t *testing.T, interval time.Duration, dialURLs ...string,
+) *client.EngineClient[*testPayload, *testAttributes] {
+	t.Helper()
+	cfg := client.DefaultConfig()
+	cfg.RPCTimeout = time.Second
+	cfg.RPCStartupCheckInterval = 10 * time.Millisecond
+	cfg.RPCHealthCheckInterval = interval
+
+	var err error
+	cfg.RPCDialURL, err = url.NewFromRaw(dialURLs[0])
+	require.NoError(t, err)
+	for _, raw := range dialURLs[1:] {
+		dialURL, err := url.NewFromRaw(raw)
+		require.NoError(t, err)
+		cfg.RPCFallbackDialURLs = append(cfg.RPCFallbackDialURLs, dialURL)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ec := client.New[*testPayload, *testAttributes](
+		&cfg, noop.NewLogger(), nil, noopSink{}, big.NewInt(testChainID),
+	)
+	require.NoError(t, ec.Start(ctx))
+	return ec
+}
+
+func newPayload(
+	t *testing.T,
+	ec *client.EngineClient[*testPayload, *testAttributes],
+	number math.U64,
+) error {
+	t.Helper()
+	_, err := ec.NewPayload(
+		context.Background(),
+		&testPayload{Number: number},
+		[]common.ExecutionHash{},
+		&common.Root{},
+	)
+	return err
+}
+
+func TestEngineClient_FailoverReplaysPayloads(t *testing.T) {
+	primary, primaryServer := newFakeExecutionClient(t)
+	standby, standbyServer := newFakeExecutionClient(t)
+	ec := newTestEngineClient(t, primaryServer.URL, standbyServer.URL)
+
+	require.NoError(t, newPayload(t, ec, 1))
+	require.NoError(t, newPayload(t, ec, 2))
+	require.Equal(t, []math.U64{1, 2}, primary.received())
+	require.Empty(t, standby.received())
+
+	primaryServer.Close()
+
+	require.NoError(t, newPayload(t, ec, 3))
+	require.Equal(t, []math.U64{1, 2}, primary.received())
+	require.Equal(t, []math.U64{1, 2, 3}, standby.received())
+
+	// Payloads are no longer sent to the failed execution client.
+	require.NoError(t, newPayload(t, ec, 4))
+	require.Equal(t, []math.U64{1, 2, 3, 4}, standby.received())
+}
+
+func TestEngineClient_FailoverRestartsPayloadBuild(t *testing.T) {
+	primary, primaryServer := newFakeExecutionClient(t)
+	standby, standbyServer := newFakeExecutionClient(t)
+	ec := newTestEngineClient(t, primaryServer.URL, standbyServer.URL)
+
+	// Start two builds on the primary so the identifiers differ between
+	// the execution clients.
+	state := &engineprimitives.ForkchoiceStateV1{}
+	for range 2 {
+		_, _, err := ec.ForkchoiceUpdated(
+			context.Background(), state, &testAttributes{}, version.Deneb,
+		)
+		require.NoError(t, err)
+	}
+	payloadID, _, err := ec.ForkchoiceUpdated(
+		context.Background(), state, &testAttributes{}, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, engineprimitives.PayloadID{3}, *payloadID)
+
+	primaryServer.Close()
+
+	envelope, err := ec.GetPayload(
+		context.Background(), *payloadID, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), primary.builds)
+	require.Equal(t, uint64(1), standby.builds)
+	require.Equal(
+		t, math.U64(1), envelope.GetExecutionPayload().Number,
+	)
+}
+
+func TestEngineClient_NoHealthyBackend(t *testing.T) {
+	_, primaryServer := newFakeExecutionClient(t)
+	_, standbyServer := newFakeExecutionClient(t)
+	ec := newTestEngineClient(t, primaryServer.URL, standbyServer.URL)
+
+	primaryServer.Close()
+	standbyServer.Close()
+
+	require.Error(t, newPayload(t, ec, 1))
+}
+
+func TestEngineClient_HealthCheckCatchesUpStandby(t *testing.T) {
+	_, primaryServer := newFakeExecutionClient(t)
+	standby, standbyServer := newFakeExecutionClient(t)
+	ec := newHealthCheckedEngineClient(
+		t, 10*time.Millisecond, primaryServer.URL, standbyServer.URL,
+	)
+
+	require.NoError(t, newPayload(t, ec, 1))
+	require.NoError(t, newPayload(t, ec, 2))
+	require.Eventually(t, func() bool {
+		return len(standby.received()) == 2
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []math.U64{1, 2}, standby.received())
+}
+
+func TestEngineClient_RefusesStandbyTooFarBehind(t *testing.T) {
+	_, primaryServer := newFakeExecutionClient(t)
+	standby, standbyServer := newFakeExecutionClient(t)
+	standby.syncing = true
+	ec := newTestEngineClient(t, primaryServer.URL, standbyServer.URL)
+
+	// Send more payloads than are retained for replay.
+	for number := range math.U64(65) {
+		require.NoError(t, newPayload(t, ec, number+1))
+	}
+	_, _, err := ec.ForkchoiceUpdated(
+		context.Background(), &engineprimitives.ForkchoiceStateV1{},
+		nil, version.Deneb,
+	)
+	require.NoError(t, err)
+
+	// The standby has not synced the head from its peers, so it can not
+	// take over.
+	primaryServer.Close()
+	require.Error(t, newPayload(t, ec, 66))
+	require.Empty(t, standby.received())
+}
+
+func TestEngineClient_PromotesSyncedStandby(t *testing.T) {
+	_, primaryServer := newFakeExecutionClient(t)
+	standby, standbyServer := newFakeExecutionClient(t)
+	ec := newTestEngineClient(t, primaryServer.URL, standbyServer.URL)
+
+	for number := range math.U64(65) {
+		require.NoError(t, newPayload(t, ec, number+1))
+	}
+	_, _, err := ec.ForkchoiceUpdated(
+		context.Background(), &engineprimitives.ForkchoiceStateV1{},
+		nil, version.Deneb,
+	)
+	require.NoError(t, err)
+
+	// The standby synced the head from its peers, so only the payloads
+	// after it are sent.
+	primaryServer.Close()
+	require.NoError(t, newPayload(t, ec, 66))
+	require.Equal(t, []math.U64{66}, standby.received())
+}
+
+func TestEngineClient_ConcurrentEth1Calls(t *testing.T) {
+	_, primaryServer := newFakeExecutionClient(t)
+	_, standbyServer := newFakeExecutionClient(t)
+	ec := newHealthCheckedEngineClient(
+		t, time.Millisecond, primaryServer.URL, standbyServer.URL,
+	)
+
+	// The health checks swap the active connection while eth calls and
+	// engine calls are made.
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for number := range math.U64(20) {
+			_ = newPayload(t, ec, number+1)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for range 20 {
+			_, err := ec.BlockNumber(context.Background())
+			if err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+	wg.Wait()
+}
@@ -94,6 +94,12 @@ func (cm *clientMetrics) incrementGetPayloadTimeout() {
 		"beacon_kit.execution.client.get_payload_duration")
 }
 
+// incrementFailoverCounter increments the counter for promotions of a
+// standby execution client.
+func (cm *clientMetrics) incrementFailoverCounter() {
+	cm.sink.IncrementCounter("beacon_kit.execution.client.failover")
+}
+
 // incrementHTTPTimeout increments the timeout counter for HTTP.
 func (cm *clientMetrics) incrementHTTPTimeoutCounter() {
 	cm.incrementTimeoutCounter("beacon_kit.execution.client.http")
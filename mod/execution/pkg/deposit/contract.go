@@ -23,6 +23,7 @@ package deposit
 import (
 	"context"
 	"errors"
+	"math/big"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -39,6 +40,8 @@ type WrappedBeaconDepositContract[
 ] struct {
 	// BeaconDepositContract is a pointer to the codegen ABI binding.
 	BeaconDepositContract
+	// client is the execution client the deposit contract is read from.
+	client bind.ContractBackend
 }
 
 // NewWrappedBeaconDepositContract creates a new BeaconDepositContract.
@@ -67,22 +70,25 @@ func NewWrappedBeaconDepositContract[
 		WithdrawalCredentialsT,
 	]{
 		BeaconDepositContract: *contract,
+		client:                client,
 	}, nil
 }
 
-// ReadDeposits reads deposits from the deposit contract.
+// ReadDeposits reads the deposits of the blocks in [from, to] from the
+// deposit contract.
 func (dc *WrappedBeaconDepositContract[
 	DepositT,
 	WithdrawalCredentialsT,
 ]) ReadDeposits(
 	ctx context.Context,
-	blkNum math.U64,
+	from math.U64,
+	to math.U64,
 ) ([]DepositT, error) {
 	logs, err := dc.FilterDeposit(
 		&bind.FilterOpts{
 			Context: ctx,
-			Start:   uint64(blkNum),
-			End:     (*uint64)(&blkNum),
+			Start:   uint64(from),
+			End:     (*uint64)(&to),
 		},
 	)
 	if err != nil {
@@ -104,3 +110,20 @@ func (dc *WrappedBeaconDepositContract[
 
 	return deposits, nil
 }
+
+// GetBlockHash returns the hash of the execution block with the given number.
+func (dc *WrappedBeaconDepositContract[
+	DepositT,
+	WithdrawalCredentialsT,
+]) GetBlockHash(
+	ctx context.Context,
+	number math.U64,
+) (common.ExecutionHash, error) {
+	header, err := dc.client.HeaderByNumber(
+		ctx, new(big.Int).SetUint64(uint64(number)),
+	)
+	if err != nil {
+		return common.ExecutionHash{}, err
+	}
+	return common.ExecutionHash(header.Hash()), nil
+}
@@ -40,10 +40,12 @@ func newMetrics(sink TelemetrySink) *metrics {
 }
 
 // markFailedToGetBlockLogs increments the counter for failed to get block logs.
-func (m *metrics) markFailedToGetBlockLogs(blockNum math.U64) {
+func (m *metrics) markFailedToGetBlockLogs(from, to math.U64) {
 	m.sink.IncrementCounter(
 		"beacon_kit.execution.deposit.failed_to_get_block_logs",
-		"block_num",
-		strconv.FormatUint(uint64(blockNum), 10),
+		"from_block_num",
+		strconv.FormatUint(uint64(from), 10),
+		"to_block_num",
+		strconv.FormatUint(uint64(to), 10),
 	)
 }
@@ -22,9 +22,11 @@ package deposit
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/service"
 )
 
 // Service represents the deposit service that processes deposit events.
@@ -34,7 +36,9 @@ type Service[
 	BlockEventT BlockEvent[
 		DepositT, BeaconBlockBodyT, BeaconBlockT, ExecutionPayloadT,
 	],
+	BeaconStateT BeaconState[Eth1DataT],
 	DepositT Deposit[DepositT, WithdrawalCredentialsT],
+	Eth1DataT Eth1Data,
 	ExecutionPayloadT ExecutionPayload,
 	WithdrawalCredentialsT any,
 ] struct {
@@ -46,13 +50,19 @@ type Service[
 	dc Contract[DepositT]
 	// ds is the deposit store that stores deposits.
 	ds Store[DepositT]
+	// ss is the state store the finalized beacon state is read from.
+	ss StateStore[BeaconStateT]
 	// feed is the block feed that provides block events.
 	feed chan BlockEventT
 	// metrics is the metrics for the deposit service.
 	metrics *metrics
-	// failedBlocks is a map of blocks that failed to be processed to be
-	// retried.
-	failedBlocks map[math.U64]struct{}
+	// targetBlock is the number of the latest execution block, at the
+	// follow distance of the finalized block, whose deposits can be synced.
+	targetBlock atomic.Uint64
+	// syncCh notifies the syncer that the target block has advanced.
+	syncCh chan struct{}
+	// loops runs the deposit fetcher and syncer.
+	loops service.Loops
 }
 
 // NewService creates a new instance of the Service struct.
@@ -67,21 +77,23 @@ func NewService[
 	ExecutionPayloadT ExecutionPayload,
 	WithdrawalCredentialsT any,
 	DepositT Deposit[DepositT, WithdrawalCredentialsT],
+	BeaconStateT BeaconState[Eth1DataT],
+	Eth1DataT Eth1Data,
 ](
 	logger log.Logger[any],
 	eth1FollowDistance math.U64,
 	telemetrySink TelemetrySink,
 	ds Store[DepositT],
+	ss StateStore[BeaconStateT],
 	dc Contract[DepositT],
 	feed chan BlockEventT,
 ) *Service[
-	BeaconBlockT, BeaconBlockBodyT, BlockEventT, DepositT,
-	ExecutionPayloadT, WithdrawalCredentialsT,
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT, BeaconStateT, DepositT,
+	Eth1DataT, ExecutionPayloadT, WithdrawalCredentialsT,
 ] {
 	return &Service[
-		BeaconBlockT, BeaconBlockBodyT, BlockEventT, DepositT,
-		ExecutionPayloadT,
-		WithdrawalCredentialsT,
+		BeaconBlockT, BeaconBlockBodyT, BlockEventT, BeaconStateT, DepositT,
+		Eth1DataT, ExecutionPayloadT, WithdrawalCredentialsT,
 	]{
 		feed:               feed,
 		logger:             logger,
@@ -89,22 +101,39 @@ func NewService[
 		metrics:            newMetrics(telemetrySink),
 		dc:                 dc,
 		ds:                 ds,
-		failedBlocks:       make(map[math.Slot]struct{}),
+		ss:                 ss,
+		syncCh:             make(chan struct{}, 1),
 	}
 }
 
 // Start starts the service and begins processing block events.
 func (s *Service[
-	_, _, _, _, _, _,
+	_, _, _, _, _, _, _, _,
 ]) Start(ctx context.Context) error {
-	go s.depositFetcher(ctx)
-	go s.depositCatchupFetcher(ctx)
+	s.loops.Reset()
+	s.loops.Go(ctx, "deposit-fetcher", s.depositFetcher)
+	s.loops.Go(ctx, "deposit-syncer", s.depositSyncer)
 	return nil
 }
 
+// Stop waits for the service to finish storing the deposits being synced.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) Stop(context.Context) error {
+	s.loops.Wait()
+	return nil
+}
+
+// Status returns an error if the deposit fetcher or syncer died.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) Status() error {
+	return s.loops.Err()
+}
+
 // Name returns the name of the service.
 func (s *Service[
-	_, _, _, _, _, _,
+	_, _, _, _, _, _, _, _,
 ]) Name() string {
 	return "deposit-handler"
 }
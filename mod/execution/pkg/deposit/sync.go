@@ -24,82 +24,194 @@ import (
 	"context"
 	"time"
 
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-// defaultRetryInterval processes a deposit event.
-const defaultRetryInterval = 20 * time.Second
+const (
+	// defaultRetryInterval is the interval at which syncing deposits is
+	// retried after a failure.
+	defaultRetryInterval = 20 * time.Second
+	// defaultBatchSize is the maximum number of blocks whose deposit logs
+	// are requested from the execution client at once.
+	defaultBatchSize = 1000
+)
 
-// depositFetcher processes a deposit event.
+// depositFetcher advances the target block of the deposit sync, and
+// finalizes the deposits processed so far, every time a block is finalized.
 func (s *Service[
-	_, _, _, _, _, _,
+	_, _, _, _, _, _, _, _,
 ]) depositFetcher(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case msg := <-s.feed:
-			if msg.Is(events.BeaconBlockFinalized) {
-				blockNum := msg.Data().
-					GetBody().GetExecutionPayload().GetNumber()
-				s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
+		case msg, ok := <-s.feed:
+			if !ok {
+				return
+			}
+			// The sync progress is persisted by the syncer itself, so the
+			// target is advanced regardless, but the event is only handled
+			// once its deposits are finalized, to be replayed otherwise.
+			s.advanceTarget(msg.Data())
+			if err := s.finalizeDeposits(ctx); err != nil {
+				s.logger.Error("Failed to finalize deposits", "error", err)
+				continue
+			}
+			if err := msg.Ack(); err != nil {
+				s.logger.Error(
+					"Failed to acknowledge finalized block", "error", err,
+				)
 			}
 		}
 	}
 }
 
-// depositCatchupFetcher fetches deposits for blocks that failed to be
-// processed.
+// advanceTarget advances the target block of the deposit sync to the given
+// finalized block, and notifies the syncer.
 func (s *Service[
-	_, _, _, _, _, _,
-]) depositCatchupFetcher(ctx context.Context) {
+	BeaconBlockT, _, _, _, _, _, _, _,
+]) advanceTarget(blk BeaconBlockT) {
+	// Only sync deposits eth1FollowDistance blocks behind the finalized
+	// block, so that they can never be reorged out.
+	blockNum := blk.GetBody().GetExecutionPayload().GetNumber()
+	if blockNum <= s.eth1FollowDistance {
+		return
+	}
+	target := uint64(blockNum - s.eth1FollowDistance)
+	if target <= s.targetBlock.Load() {
+		return
+	}
+	s.targetBlock.Store(target)
+
+	// Notify the syncer without blocking, a pending notification already
+	// covers the new target.
+	select {
+	case s.syncCh <- struct{}{}:
+	default:
+	}
+}
+
+// finalizeDeposits finalizes every deposit processed by the latest
+// committed, and thus finalized, state, as of the execution block its eth1
+// data refers to. Finalized deposits no longer need to be proven and make up
+// the deposit snapshot.
+//
+// NOTE: The deposits are only finalized once the state has processed every
+// deposit of its eth1 data, so that the execution block of the snapshot
+// covers exactly its deposit count, as EIP-4881 requires.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) finalizeDeposits(ctx context.Context) error {
+	st, err := s.ss.HeadState(ctx)
+	if err != nil {
+		return err
+	}
+	depositCount, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return err
+	}
+	if depositCount != uint64(eth1Data.GetDepositCount()) {
+		return nil
+	}
+
+	// The eth1 data of the genesis state refers to no synced block, its
+	// deposits are finalized once a vote has been adopted.
+	blockHeight, found, err := s.ds.GetEth1BlockNumber(
+		eth1Data.GetBlockHash(),
+	)
+	if err != nil || !found {
+		return err
+	}
+	return s.ds.FinalizeDeposits(
+		depositCount, eth1Data.GetBlockHash(), blockHeight,
+	)
+}
+
+// depositSyncer syncs deposits up to the target block whenever it advances,
+// and periodically retries in case a previous sync failed.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) depositSyncer(ctx context.Context) {
 	ticker := time.NewTicker(defaultRetryInterval)
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-s.syncCh:
+			s.syncDeposits(ctx)
 		case <-ticker.C:
-			if len(s.failedBlocks) == 0 {
-				continue
-			}
+			s.syncDeposits(ctx)
+		}
+	}
+}
+
+// syncDeposits fetches and stores the deposits of every block from the last
+// synced block up to the target block, in batches. The last synced block is
+// persisted after each batch so that no block is skipped, even across
+// restarts.
+func (s *Service[
+	_, _, _, _, _, _, _, _,
+]) syncDeposits(ctx context.Context) {
+	target := math.U64(s.targetBlock.Load())
+	if target == 0 {
+		return
+	}
+
+	lastSynced, found, err := s.ds.GetLastSyncedBlock()
+	if err != nil {
+		s.logger.Error("Failed to get last synced block", "error", err)
+		return
+	}
+
+	var from math.U64
+	if found {
+		from = math.U64(lastSynced) + 1
+	}
+	for from <= target {
+		to := min(from+defaultBatchSize-1, target)
+		if err = s.fetchAndStoreDeposits(ctx, from, to); err != nil {
 			s.logger.Warn(
-				"Failed to get deposits from block(s), retrying...",
-				"num_blocks",
-				s.failedBlocks,
+				"Failed to sync deposits from block range, retrying...",
+				"from_block", from, "to_block", to, "error", err,
 			)
-
-			// Fetch deposits for blocks that failed to be processed.
-			for blockNum := range s.failedBlocks {
-				s.fetchAndStoreDeposits(ctx, blockNum)
-			}
+			return
 		}
+		from = to + 1
 	}
 }
 
+// fetchAndStoreDeposits fetches and stores the deposits of the blocks in
+// [from, to], then marks the range as synced. The hash of the last block of
+// the range is recorded along with it, to be voted on as eth1 data.
 func (s *Service[
-	_, _, _, _, _, _,
-]) fetchAndStoreDeposits(ctx context.Context, blockNum math.U64) {
-	deposits, err := s.dc.ReadDeposits(ctx, blockNum)
+	_, _, _, _, _, _, _, _,
+]) fetchAndStoreDeposits(ctx context.Context, from, to math.U64) error {
+	deposits, err := s.dc.ReadDeposits(ctx, from, to)
 	if err != nil {
-		s.metrics.markFailedToGetBlockLogs(blockNum)
-		s.failedBlocks[blockNum] = struct{}{}
-		return
+		s.metrics.markFailedToGetBlockLogs(from, to)
+		return err
+	}
+	blockHash, err := s.dc.GetBlockHash(ctx, to)
+	if err != nil {
+		return err
 	}
 
 	if len(deposits) > 0 {
 		s.logger.Info(
 			"Found deposits on execution layer",
-			"block", blockNum, "deposits", len(deposits),
+			"from_block", from, "to_block", to, "deposits", len(deposits),
 		)
 	}
 
+	// Deposits are keyed by their index, so storing them again if the
+	// range is synced twice is harmless.
 	if err = s.ds.EnqueueDeposits(deposits); err != nil {
-		s.logger.Error("Failed to store deposits", "error", err)
-		s.failedBlocks[blockNum] = struct{}{}
-		return
+		return err
 	}
-
-	delete(s.failedBlocks, blockNum)
+	return s.ds.SetLastSyncedBlock(uint64(to), blockHash)
 }
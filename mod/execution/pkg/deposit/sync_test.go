@@ -0,0 +1,461 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+var errLogsUnavailable = errors.New("logs unavailable")
+
+type testPayload struct {
+	number math.U64
+	hash   common.ExecutionHash
+}
+
+func (p *testPayload) GetNumber() math.U64 { return p.number }
+
+func (p *testPayload) GetBlockHash() common.ExecutionHash { return p.hash }
+
+type testBody struct {
+	payload  *testPayload
+	deposits []*testDeposit
+}
+
+func (b *testBody) GetDeposits() []*testDeposit { return b.deposits }
+
+func (b *testBody) GetExecutionPayload() *testPayload { return b.payload }
+
+type testBlock struct{ body *testBody }
+
+func (b *testBlock) GetSlot() math.U64 { return 0 }
+
+func (b *testBlock) GetBody() *testBody { return b.body }
+
+type testEvent struct {
+	eventType asynctypes.EventID
+	block     *testBlock
+	acked     atomic.Bool
+}
+
+func (e *testEvent) Type() asynctypes.EventID { return e.eventType }
+
+func (e *testEvent) Data() *testBlock { return e.block }
+
+func (e *testEvent) Ack() error {
+	e.acked.Store(true)
+	return nil
+}
+
+func newFinalizedEvent(number math.U64) *testEvent {
+	return &testEvent{
+		eventType: events.BeaconBlockFinalized,
+		block: &testBlock{
+			body: &testBody{payload: &testPayload{number: number}},
+		},
+	}
+}
+
+type testDeposit struct{ index uint64 }
+
+func (d *testDeposit) New(
+	crypto.BLSPubkey, [32]byte, math.U64, crypto.BLSSignature, uint64,
+) *testDeposit {
+	return new(testDeposit)
+}
+
+func (d *testDeposit) GetIndex() uint64 { return d.index }
+
+// testContract serves one deposit per requested range, indexed by the first
+// block of the range, and fails the calls for which fail returns true.
+type testContract struct {
+	mu     sync.Mutex
+	ranges [][2]math.U64
+	fail   func(call int) bool
+}
+
+func (c *testContract) ReadDeposits(
+	_ context.Context, from, to math.U64,
+) ([]*testDeposit, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ranges = append(c.ranges, [2]math.U64{from, to})
+	if c.fail != nil && c.fail(len(c.ranges)) {
+		return nil, errLogsUnavailable
+	}
+	return []*testDeposit{{index: uint64(from)}}, nil
+}
+
+func (c *testContract) GetBlockHash(
+	_ context.Context, number math.U64,
+) (common.ExecutionHash, error) {
+	return testBlockHash(number), nil
+}
+
+// testBlockHash returns the hash the test contract serves for the execution
+// block with the given number.
+func testBlockHash(number math.U64) common.ExecutionHash {
+	var hash common.ExecutionHash
+	binary.BigEndian.PutUint64(hash[:], uint64(number))
+	return hash
+}
+
+type testEth1Data struct {
+	hash         common.ExecutionHash
+	depositCount math.U64
+}
+
+func (d *testEth1Data) GetBlockHash() common.ExecutionHash { return d.hash }
+
+func (d *testEth1Data) GetDepositCount() math.U64 { return d.depositCount }
+
+type testState struct {
+	eth1Data     *testEth1Data
+	depositIndex uint64
+}
+
+func (s *testState) GetEth1Data() (*testEth1Data, error) {
+	return s.eth1Data, nil
+}
+
+func (s *testState) GetEth1DepositIndex() (uint64, error) {
+	return s.depositIndex, nil
+}
+
+// testStateStore serves the head state it holds.
+type testStateStore struct {
+	mu   sync.Mutex
+	head *testState
+}
+
+func (s *testStateStore) HeadState(context.Context) (*testState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.head, nil
+}
+
+type finalization struct {
+	depositCount uint64
+	blockHash    common.ExecutionHash
+	blockHeight  uint64
+}
+
+type testStore struct {
+	mu         sync.Mutex
+	deposits   map[uint64]*testDeposit
+	lastSynced *uint64
+	lastHash   common.ExecutionHash
+	// blocks maps the hash of every synced block to its number.
+	blocks    map[common.ExecutionHash]uint64
+	finalized []finalization
+	// finalizeErr is returned by FinalizeDeposits, if set.
+	finalizeErr error
+}
+
+func (s *testStore) Prune(uint64, uint64) error { return nil }
+
+func (s *testStore) EnqueueDeposits(deposits []*testDeposit) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, d := range deposits {
+		s.deposits[d.GetIndex()] = d
+	}
+	return nil
+}
+
+func (s *testStore) GetLastSyncedBlock() (uint64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lastSynced == nil {
+		return 0, false, nil
+	}
+	return *s.lastSynced, true, nil
+}
+
+func (s *testStore) SetLastSyncedBlock(
+	blockNum uint64, blockHash common.ExecutionHash,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastSynced = &blockNum
+	s.lastHash = blockHash
+	if s.blocks != nil {
+		s.blocks[blockHash] = blockNum
+	}
+	return nil
+}
+
+func (s *testStore) GetEth1BlockNumber(
+	blockHash common.ExecutionHash,
+) (uint64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	blockNum, found := s.blocks[blockHash]
+	return blockNum, found, nil
+}
+
+func (s *testStore) FinalizeDeposits(
+	depositCount uint64, blockHash common.ExecutionHash, blockHeight uint64,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.finalizeErr != nil {
+		return s.finalizeErr
+	}
+	s.finalized = append(s.finalized, finalization{
+		depositCount: depositCount,
+		blockHash:    blockHash,
+		blockHeight:  blockHeight,
+	})
+	return nil
+}
+
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string) {}
+
+func newTestService(
+	dc *testContract, ds *testStore, feed chan *testEvent,
+) *Service[
+	*testBlock, *testBody, *testEvent, *testState, *testDeposit,
+	*testEth1Data, *testPayload, [32]byte,
+] {
+	return newTestServiceWithState(
+		dc, ds, &testStateStore{
+			head: &testState{eth1Data: new(testEth1Data)},
+		}, feed,
+	)
+}
+
+func newTestServiceWithState(
+	dc *testContract, ds *testStore, ss *testStateStore, feed chan *testEvent,
+) *Service[
+	*testBlock, *testBody, *testEvent, *testState, *testDeposit,
+	*testEth1Data, *testPayload, [32]byte,
+] {
+	return NewService[
+		*testBody, *testBlock, *testEvent, *testStore, *testPayload,
+		[32]byte, *testDeposit, *testState, *testEth1Data,
+	](noop.NewLogger(), 8, noopSink{}, ds, ss, dc, feed)
+}
+
+func TestService_SyncDepositsBackfill(t *testing.T) {
+	lastSynced := uint64(10)
+	ds := &testStore{
+		deposits:   make(map[uint64]*testDeposit),
+		lastSynced: &lastSynced,
+	}
+	dc := &testContract{}
+	s := newTestService(dc, ds, nil)
+
+	s.targetBlock.Store(2510)
+	s.syncDeposits(context.Background())
+
+	require.Equal(t, [][2]math.U64{
+		{11, 1010}, {1011, 2010}, {2011, 2510},
+	}, dc.ranges)
+	require.Equal(t, uint64(2510), *ds.lastSynced)
+	require.Equal(t, testBlockHash(2510), ds.lastHash)
+	require.Len(t, ds.deposits, 3)
+
+	// Nothing is fetched again once the target block has been synced.
+	s.syncDeposits(context.Background())
+	require.Len(t, dc.ranges, 3)
+}
+
+func TestService_SyncDepositsResumesAfterFailure(t *testing.T) {
+	ds := &testStore{deposits: make(map[uint64]*testDeposit)}
+	dc := &testContract{fail: func(call int) bool { return call == 2 }}
+	s := newTestService(dc, ds, nil)
+
+	s.targetBlock.Store(2500)
+	s.syncDeposits(context.Background())
+	require.Equal(t, uint64(999), *ds.lastSynced)
+
+	// The retry picks up right after the last synced block.
+	s.syncDeposits(context.Background())
+	require.Equal(t, [][2]math.U64{
+		{0, 999}, {1000, 1999}, {1000, 1999}, {2000, 2500},
+	}, dc.ranges)
+	require.Equal(t, uint64(2500), *ds.lastSynced)
+}
+
+func TestService_SyncsUpToFollowDistance(t *testing.T) {
+	ds := &testStore{deposits: make(map[uint64]*testDeposit)}
+	dc := &testContract{}
+	feed := make(chan *testEvent)
+	s := newTestService(dc, ds, feed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, s.Start(ctx))
+
+	// Blocks within the follow distance of genesis are not synced.
+	feed <- newFinalizedEvent(8)
+	event := newFinalizedEvent(20)
+	feed <- event
+
+	require.Eventually(t, func() bool {
+		lastSynced, found, err := ds.GetLastSyncedBlock()
+		return err == nil && found && lastSynced == 12
+	}, time.Second, 10*time.Millisecond)
+	require.Eventually(t, event.acked.Load, time.Second, 10*time.Millisecond)
+}
+
+func TestService_AdvancesTargetWhenFinalizationFails(t *testing.T) {
+	ds := &testStore{
+		deposits:    make(map[uint64]*testDeposit),
+		blocks:      map[common.ExecutionHash]uint64{testBlockHash(1): 1},
+		finalizeErr: errors.New("finalization failed"),
+	}
+	ss := &testStateStore{
+		head: &testState{
+			eth1Data: &testEth1Data{
+				hash: testBlockHash(1), depositCount: 1,
+			},
+			depositIndex: 1,
+		},
+	}
+	feed := make(chan *testEvent)
+	s := newTestServiceWithState(&testContract{}, ds, ss, feed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, s.Start(ctx))
+
+	failed := newFinalizedEvent(20)
+	feed <- failed
+	// The fetcher handles events in order, so the failed event is done
+	// with once the next one is received.
+	feed <- newFinalizedEvent(20)
+
+	require.Eventually(t, func() bool {
+		lastSynced, found, err := ds.GetLastSyncedBlock()
+		return err == nil && found && lastSynced == 12
+	}, time.Second, 10*time.Millisecond)
+	require.False(t, failed.acked.Load())
+}
+
+func TestService_FinalizesAsOfEth1Data(t *testing.T) {
+	ds := &testStore{
+		deposits: make(map[uint64]*testDeposit),
+		blocks:   make(map[common.ExecutionHash]uint64),
+	}
+	ss := &testStateStore{
+		head: &testState{
+			eth1Data: &testEth1Data{
+				hash: testBlockHash(1), depositCount: 2,
+			},
+			depositIndex: 2,
+		},
+	}
+	s := newTestServiceWithState(&testContract{}, ds, ss, nil)
+
+	// Nothing is finalized while the eth1 data refers to no synced block.
+	require.NoError(t, s.finalizeDeposits(context.Background()))
+	require.Empty(t, ds.finalized)
+
+	s.targetBlock.Store(12)
+	s.syncDeposits(context.Background())
+	ss.head = &testState{
+		eth1Data: &testEth1Data{
+			hash: testBlockHash(12), depositCount: 6,
+		},
+		depositIndex: 6,
+	}
+	require.NoError(t, s.finalizeDeposits(context.Background()))
+	require.Equal(t, []finalization{{
+		depositCount: 6,
+		blockHash:    testBlockHash(12),
+		blockHeight:  12,
+	}}, ds.finalized)
+}
+
+func TestService_FinalizesOnceEth1DataDepositsProcessed(t *testing.T) {
+	ds := &testStore{
+		deposits: make(map[uint64]*testDeposit),
+		blocks:   make(map[common.ExecutionHash]uint64),
+	}
+	ss := &testStateStore{
+		head: &testState{
+			eth1Data: &testEth1Data{
+				hash: testBlockHash(12), depositCount: 6,
+			},
+			depositIndex: 4,
+		},
+	}
+	s := newTestServiceWithState(&testContract{}, ds, ss, nil)
+	s.targetBlock.Store(12)
+	s.syncDeposits(context.Background())
+
+	// The execution block of the eth1 data covers deposits that are not
+	// processed yet, so nothing is finalized.
+	require.NoError(t, s.finalizeDeposits(context.Background()))
+	require.Empty(t, ds.finalized)
+
+	ss.head = &testState{
+		eth1Data: &testEth1Data{
+			hash: testBlockHash(12), depositCount: 6,
+		},
+		depositIndex: 6,
+	}
+	require.NoError(t, s.finalizeDeposits(context.Background()))
+	require.Equal(t, []finalization{{
+		depositCount: 6,
+		blockHash:    testBlockHash(12),
+		blockHeight:  12,
+	}}, ds.finalized)
+}
+
+func TestService_ReportsDeadFetcher(t *testing.T) {
+	ds := &testStore{deposits: make(map[uint64]*testDeposit)}
+	feed := make(chan *testEvent)
+	s := newTestService(&testContract{}, ds, feed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, s.Start(ctx))
+	require.NoError(t, s.Status())
+
+	// The fetcher dies once its feed is closed.
+	close(feed)
+	require.Eventually(t, func() bool {
+		return s.Status() != nil
+	}, time.Second, 10*time.Millisecond)
+	require.ErrorContains(t, s.Status(), "deposit-fetcher exited")
+
+	cancel()
+	require.NoError(t, s.Stop(context.Background()))
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -46,6 +47,15 @@ type BeaconBlock[
 	GetBody() BeaconBlockBodyT
 }
 
+// BeaconState is an interface for the beacon state.
+type BeaconState[Eth1DataT any] interface {
+	// GetEth1Data returns the eth1 data of the state.
+	GetEth1Data() (Eth1DataT, error)
+	// GetEth1DepositIndex returns the index of the next deposit to be
+	// processed.
+	GetEth1DepositIndex() (uint64, error)
+}
+
 // BlockEvent is an interface for block events.
 type BlockEvent[
 	DepositT any,
@@ -54,22 +64,40 @@ type BlockEvent[
 	ExecutionPayloadT ExecutionPayload,
 ] interface {
 	Type() asynctypes.EventID
-	Is(asynctypes.EventID) bool
 	Data() BeaconBlockT
+	Ack() error
+}
+
+// Eth1Data is an interface for eth1 data.
+type Eth1Data interface {
+	// GetBlockHash returns the hash of the execution block the eth1 data
+	// refers to.
+	GetBlockHash() common.ExecutionHash
+	// GetDepositCount returns the number of deposits made as of the
+	// execution block the eth1 data refers to.
+	GetDepositCount() math.U64
 }
 
 // ExecutionPayload is an interface for execution payloads.
 type ExecutionPayload interface {
 	GetNumber() math.U64
+	GetBlockHash() common.ExecutionHash
 }
 
 // Contract is the ABI for the deposit contract.
 type Contract[DepositT any] interface {
-	// ReadDeposits reads deposits from the deposit contract.
+	// ReadDeposits reads the deposits of the blocks in [from, to] from the
+	// deposit contract.
 	ReadDeposits(
 		ctx context.Context,
-		blockNumber math.U64,
+		from math.U64,
+		to math.U64,
 	) ([]DepositT, error)
+	// GetBlockHash returns the hash of the execution block with the given
+	// number.
+	GetBlockHash(ctx context.Context, number math.U64) (
+		common.ExecutionHash, error,
+	)
 }
 
 // Deposit is an interface for deposits.
@@ -92,6 +120,29 @@ type Store[DepositT any] interface {
 	Prune(index uint64, numPrune uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
+	// GetLastSyncedBlock returns the number of the last execution block
+	// whose deposits have all been stored, and false if there is none.
+	GetLastSyncedBlock() (uint64, bool, error)
+	// SetLastSyncedBlock sets the number of the last execution block whose
+	// deposits have all been stored, and records the block along with the
+	// number of deposits made up to and including it.
+	SetLastSyncedBlock(blockNum uint64, blockHash common.ExecutionHash) error
+	// GetEth1BlockNumber returns the number of the synced execution block
+	// with the given hash, and false if there is none.
+	GetEth1BlockNumber(blockHash common.ExecutionHash) (uint64, bool, error)
+	// FinalizeDeposits finalizes the first depositCount deposits as of the
+	// given execution block.
+	FinalizeDeposits(
+		depositCount uint64,
+		blockHash common.ExecutionHash,
+		blockHeight uint64,
+	) error
+}
+
+// StateStore is an interface for reading committed beacon states.
+type StateStore[BeaconStateT any] interface {
+	// HeadState returns the state at the latest committed height.
+	HeadState(ctx context.Context) (BeaconStateT, error)
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
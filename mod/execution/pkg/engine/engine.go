@@ -22,6 +22,7 @@ package engine
 
 import (
 	"context"
+	"sync"
 
 	broker "github.com/berachain/beacon-kit/mod/async/pkg/broker"
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
@@ -35,6 +36,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/service"
 )
 
+// engineName is the name of the engine service.
+const engineName = "execution-engine"
+
 // Engine is Beacon-Kit's implementation of the `ExecutionEngine`
 // from the Ethereum 2.0 Specification.
 type Engine[
@@ -54,6 +58,14 @@ type Engine[
 	metrics *engineMetrics
 	// statusPublisher is the status publishder for the engine.
 	statusPublisher *broker.Broker[*asynctypes.Event[*service.StatusEvent]]
+	// shadowClient is the engine client of the optional shadow execution
+	// client, nil if no shadow execution client is configured.
+	shadowClient *client.EngineClient[ExecutionPayloadT, PayloadAttributesT]
+	// shadow compares the responses of the shadow execution client against
+	// the primary, nil if no shadow execution client is configured.
+	shadow *shadowComparator
+	// shadowDone tracks the goroutine running the shadow comparator.
+	shadowDone sync.WaitGroup
 }
 
 // New creates a new Engine.
@@ -69,30 +81,70 @@ func New[
 	logger log.Logger[any],
 	statusPublisher *broker.Broker[*asynctypes.Event[*service.StatusEvent]],
 	telemtrySink TelemetrySink,
+	shadowClient *client.EngineClient[ExecutionPayloadT, PayloadAttributesT],
 ) *Engine[
 	ExecutionPayloadT, PayloadAttributesT, PayloadIDT, WithdrawalT,
 ] {
-	return &Engine[ExecutionPayloadT, PayloadAttributesT, PayloadIDT, WithdrawalT]{
+	ee := &Engine[ExecutionPayloadT, PayloadAttributesT, PayloadIDT, WithdrawalT]{
 		ec:              ec,
 		logger:          logger,
 		metrics:         newEngineMetrics(telemtrySink, logger),
 		statusPublisher: statusPublisher,
+		shadowClient:    shadowClient,
+	}
+	if shadowClient != nil {
+		ee.shadow = newShadowComparator(
+			shadowClient.Start, logger, ee.metrics,
+		)
 	}
+	return ee
 }
 
-// Start spawns any goroutines required by the service.
+// Start spawns any goroutines required by the service. The engine client is
+// a service of its own, only the shadow execution client is run here.
 func (ee *Engine[_, _, _, _]) Start(
 	ctx context.Context,
 ) error {
-	go func() {
-		// TODO: handle better
-		if err := ee.ec.Start(ctx); err != nil {
-			panic(err)
-		}
-	}()
+	// The shadow execution client runs independently of the primary, so
+	// that it can never hold up consensus.
+	if ee.shadow != nil {
+		ee.shadowDone.Add(1)
+		go func() {
+			defer ee.shadowDone.Done()
+			ee.shadow.run(ctx)
+		}()
+	}
+	return nil
+}
+
+// Stop waits for the shadow execution client to stop.
+func (ee *Engine[_, _, _, _]) Stop(ctx context.Context) error {
+	ee.shadowDone.Wait()
+	if ee.shadowClient != nil {
+		return ee.shadowClient.Stop(ctx)
+	}
 	return nil
 }
 
+// Close closes the connection to the shadow execution client.
+func (ee *Engine[_, _, _, _]) Close() error {
+	if ee.shadowClient != nil {
+		return ee.shadowClient.Close()
+	}
+	return nil
+}
+
+// Status returns the status of the engine. The shadow execution client
+// never affects the health of the node.
+func (ee *Engine[_, _, _, _]) Status() error {
+	return nil
+}
+
+// Name returns the name of the engine.
+func (ee *Engine[_, _, _, _]) Name() string {
+	return engineName
+}
+
 // GetPayload returns the payload and blobs bundle for the given slot.
 func (ee *Engine[
 	ExecutionPayloadT, _, _, _,
@@ -106,6 +158,24 @@ func (ee *Engine[
 	)
 }
 
+// IsPayloadReady returns true once the execution client will no longer
+// improve the payload with the given ID. The execution client improves a
+// payload with the transactions of its pool, so the payload is ready as soon
+// as the pending block of the execution client holds no transaction.
+//
+// NOTE: The pending block is shared by every payload being built on top of
+// the head of the execution client, so the payload ID is not used.
+func (ee *Engine[_, _, _, _]) IsPayloadReady(
+	ctx context.Context,
+	_ engineprimitives.PayloadID,
+) (bool, error) {
+	pending, err := ee.ec.HasPendingTransactions(ctx)
+	if err != nil {
+		return false, err
+	}
+	return !pending, nil
+}
+
 // NotifyForkchoiceUpdate notifies the execution client of a forkchoice update.
 func (ee *Engine[
 	_, PayloadAttributesT, _, _,
@@ -124,6 +194,7 @@ func (ee *Engine[
 		req.PayloadAttributes,
 		req.ForkVersion,
 	)
+	ee.shadowForkchoiceUpdate(req, latestValidHash, err)
 
 	switch {
 	// We do not bubble the error up, since we want to handle it
@@ -211,6 +282,7 @@ func (ee *Engine[
 		req.VersionedHashes,
 		req.ParentBeaconBlockRoot,
 	)
+	ee.shadowNewPayload(req, lastValidHash, err)
 
 	// We abstract away some of the complexity and categorize status codes
 	// to make it easier to reason about.
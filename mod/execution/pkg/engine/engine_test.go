@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	coretypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = 80087
+
+type (
+	testPayload struct {
+		ExecutionPayload[*testPayload, *testWithdrawal]
+	}
+	testWithdrawal struct{ Withdrawal[*testWithdrawal] }
+	testEngine     = Engine[
+		*testPayload, engineprimitives.PayloadAttributer,
+		engineprimitives.PayloadID, *testWithdrawal,
+	]
+)
+
+// The engine is registered with the service registry of the node, which
+// starts and stops it.
+var _ interface {
+	Start(context.Context) error
+	Stop(context.Context) error
+	Status() error
+	Name() string
+} = (*testEngine)(nil)
+
+// The payload builder retrieves a payload early once the engine reports it
+// ready.
+var _ interface {
+	IsPayloadReady(context.Context, engineprimitives.PayloadID) (bool, error)
+} = (*testEngine)(nil)
+
+func TestEngine_StartRunsShadowComparator(t *testing.T) {
+	sc, _ := newTestShadowComparator()
+	started := make(chan struct{})
+	sc.start = func(context.Context) error {
+		close(started)
+		return nil
+	}
+	ee := &testEngine{shadow: sc}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, ee.Start(ctx))
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("shadow execution client was not started")
+	}
+
+	replayed := make(chan struct{})
+	sc.enqueue(&shadowCall{
+		method: shadowMethodNewPayload,
+		call: func(context.Context) shadowResponse {
+			close(replayed)
+			return shadowResponse{}
+		},
+	})
+	select {
+	case <-replayed:
+	case <-time.After(time.Second):
+		t.Fatal("shadowed call was not replayed")
+	}
+
+	cancel()
+	require.NoError(t, ee.Stop(context.Background()))
+}
+
+func TestEngine_StartWithoutShadow(t *testing.T) {
+	ee := &testEngine{}
+	require.NoError(t, ee.Start(context.Background()))
+	require.NoError(t, ee.Stop(context.Background()))
+	require.NoError(t, ee.Close())
+}
+
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string) {}
+
+func (noopSink) SetGauge(string, int64, ...string) {}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+// fakeEngineAPI answers the engine API calls made when connecting.
+type fakeEngineAPI struct{}
+
+func (fakeEngineAPI) ExchangeCapabilities(capabilities []string) []string {
+	return capabilities
+}
+
+// fakeEth is an execution client whose pending block holds the transactions
+// of its pool.
+type fakeEth struct {
+	// pending is the number of transactions in the pool.
+	pending atomic.Int64
+	// requested is the block number last requested.
+	requested atomic.Value
+}
+
+func (*fakeEth) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(testChainID))
+}
+
+func (e *fakeEth) GetBlockByNumber(
+	number string, _ bool,
+) *coretypes.Header {
+	e.requested.Store(number)
+	header := &coretypes.Header{
+		Number:     big.NewInt(2),
+		Difficulty: new(big.Int),
+		TxHash:     coretypes.EmptyTxsHash,
+	}
+	if e.pending.Load() > 0 {
+		header.TxHash = common.ExecutionHash{0x01}
+	}
+	return header
+}
+
+func TestEngine_IsPayloadReady(t *testing.T) {
+	eth := new(fakeEth)
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("engine", fakeEngineAPI{}))
+	require.NoError(t, server.RegisterName("eth", eth))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	t.Cleanup(server.Stop)
+
+	cfg := client.DefaultConfig()
+	cfg.RPCTimeout = time.Second
+	cfg.RPCStartupCheckInterval = 10 * time.Millisecond
+	var err error
+	cfg.RPCDialURL, err = url.NewFromRaw(httpServer.URL)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ec := client.New[*testPayload, engineprimitives.PayloadAttributer](
+		&cfg, noop.NewLogger(), nil, noopSink{}, big.NewInt(testChainID),
+	)
+	require.NoError(t, ec.Start(ctx))
+	ee := New[
+		*testPayload, engineprimitives.PayloadAttributer,
+		engineprimitives.PayloadID, *testWithdrawal,
+	](ec, noop.NewLogger(), nil, noopSink{}, nil)
+
+	// The payload is still improved while the pool holds transactions.
+	eth.pending.Store(1)
+	ready, err := ee.IsPayloadReady(ctx, engineprimitives.PayloadID{1})
+	require.NoError(t, err)
+	require.False(t, ready)
+	require.Equal(t, "pending", eth.requested.Load())
+
+	// And is ready once the pending block is empty.
+	eth.pending.Store(0)
+	ready, err = ee.IsPayloadReady(ctx, engineprimitives.PayloadID{1})
+	require.NoError(t, err)
+	require.True(t, ready)
+}
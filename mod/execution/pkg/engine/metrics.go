@@ -273,6 +273,29 @@ func (em *engineMetrics) markForkchoiceUpdateUndefinedError(err error) {
 	)
 }
 
+// markShadowDivergence increments the counter for responses of the shadow
+// execution client that diverge from the primary.
+func (em *engineMetrics) markShadowDivergence(method, field string) {
+	em.sink.IncrementCounter(
+		"beacon_kit.execution.engine.shadow_divergence",
+		"method", method,
+		"field", field,
+	)
+}
+
+// markShadowCallDropped increments the counter for calls that were not sent
+// to the shadow execution client because it is falling behind.
+func (em *engineMetrics) markShadowCallDropped(method string) {
+	em.logger.Warn(
+		"Shadow execution client is falling behind, dropping call",
+		"method", method,
+	)
+	em.sink.IncrementCounter(
+		"beacon_kit.execution.engine.shadow_call_dropped",
+		"method", method,
+	)
+}
+
 // errorLoggerFn returns a logger fn based on the optimistic flag.
 func (em *engineMetrics) errorLoggerFn(
 	isOptimistic bool,
@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"context"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// shadowQueueSize is the number of calls that may be waiting for the shadow
+// execution client before further calls are dropped.
+const shadowQueueSize = 64
+
+const (
+	// shadowMethodNewPayload is the label of shadowed new payload calls.
+	shadowMethodNewPayload = "new_payload"
+	// shadowMethodForkchoiceUpdated is the label of shadowed forkchoice
+	// update calls.
+	shadowMethodForkchoiceUpdated = "forkchoice_updated"
+)
+
+// shadowResponse is the outcome of an engine API call.
+type shadowResponse struct {
+	latestValidHash *common.ExecutionHash
+	err             error
+}
+
+// shadowCall is an engine API call to replay against the shadow execution
+// client along with the response of the primary execution client.
+type shadowCall struct {
+	// method is the label of the engine API call.
+	method string
+	// blockHash is the execution block the call refers to.
+	blockHash common.ExecutionHash
+	// primary is the response of the primary execution client.
+	primary shadowResponse
+	// call issues the engine API call against the shadow execution client.
+	call func(context.Context) shadowResponse
+}
+
+// shadowComparator sends the engine API calls made against the primary
+// execution client to a shadow execution client and reports any divergence
+// between their responses. It runs off the critical path and never affects
+// consensus.
+type shadowComparator struct {
+	// start connects to the shadow execution client.
+	start func(context.Context) error
+	// logger is the logger for the comparator.
+	logger log.Logger[any]
+	// metrics is the metrics for the engine.
+	metrics *engineMetrics
+	// calls is the queue of calls waiting to be replayed.
+	calls chan *shadowCall
+}
+
+// newShadowComparator creates a new shadowComparator.
+func newShadowComparator(
+	start func(context.Context) error,
+	logger log.Logger[any],
+	metrics *engineMetrics,
+) *shadowComparator {
+	return &shadowComparator{
+		start:   start,
+		logger:  logger,
+		metrics: metrics,
+		calls:   make(chan *shadowCall, shadowQueueSize),
+	}
+}
+
+// run connects to the shadow execution client and replays the queued calls
+// against it, in order, until the context is cancelled.
+func (sc *shadowComparator) run(ctx context.Context) {
+	if err := sc.start(ctx); err != nil {
+		sc.logger.Error(
+			"Failed to start shadow execution client", "err", err,
+		)
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case call := <-sc.calls:
+			sc.compare(call, call.call(ctx))
+		}
+	}
+}
+
+// enqueue queues a call for the shadow execution client without blocking,
+// dropping it if the shadow execution client is falling behind.
+func (sc *shadowComparator) enqueue(call *shadowCall) {
+	select {
+	case sc.calls <- call:
+	default:
+		sc.metrics.markShadowCallDropped(call.method)
+	}
+}
+
+// compare reports a divergence between the responses of the primary and the
+// shadow execution client.
+func (sc *shadowComparator) compare(
+	call *shadowCall, shadow shadowResponse,
+) {
+	primaryStatus := payloadStatusFromError(call.primary.err)
+	shadowStatus := payloadStatusFromError(shadow.err)
+	if primaryStatus != shadowStatus {
+		sc.metrics.markShadowDivergence(call.method, "payload_status")
+		sc.logger.Warn(
+			"Shadow execution client diverged on payload status",
+			"method", call.method,
+			"block_hash", call.blockHash,
+			"primary_status", primaryStatus,
+			"shadow_status", shadowStatus,
+			"primary_err", call.primary.err,
+			"shadow_err", shadow.err,
+		)
+	}
+
+	if !equalHashes(call.primary.latestValidHash, shadow.latestValidHash) {
+		sc.metrics.markShadowDivergence(call.method, "latest_valid_hash")
+		sc.logger.Warn(
+			"Shadow execution client diverged on latest valid hash",
+			"method", call.method,
+			"block_hash", call.blockHash,
+			"primary_latest_valid_hash", call.primary.latestValidHash,
+			"shadow_latest_valid_hash", shadow.latestValidHash,
+		)
+	}
+}
+
+// shadowNewPayload queues a new payload call for the shadow execution
+// client, if one is configured.
+func (ee *Engine[
+	ExecutionPayloadT, _, _, WithdrawalT,
+]) shadowNewPayload(
+	req *engineprimitives.NewPayloadRequest[
+		ExecutionPayloadT, WithdrawalT,
+	],
+	latestValidHash *common.ExecutionHash,
+	err error,
+) {
+	if ee.shadow == nil {
+		return
+	}
+	ee.shadow.enqueue(&shadowCall{
+		method:    shadowMethodNewPayload,
+		blockHash: req.ExecutionPayload.GetBlockHash(),
+		primary:   shadowResponse{latestValidHash: latestValidHash, err: err},
+		call: func(ctx context.Context) shadowResponse {
+			hash, shadowErr := ee.shadowClient.NewPayload(
+				ctx,
+				req.ExecutionPayload,
+				req.VersionedHashes,
+				req.ParentBeaconBlockRoot,
+			)
+			return shadowResponse{latestValidHash: hash, err: shadowErr}
+		},
+	})
+}
+
+// shadowForkchoiceUpdate queues a forkchoice update call for the shadow
+// execution client, if one is configured.
+func (ee *Engine[
+	_, PayloadAttributesT, _, _,
+]) shadowForkchoiceUpdate(
+	req *engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT],
+	latestValidHash *common.ExecutionHash,
+	err error,
+) {
+	if ee.shadow == nil {
+		return
+	}
+	ee.shadow.enqueue(&shadowCall{
+		method:    shadowMethodForkchoiceUpdated,
+		blockHash: req.State.HeadBlockHash,
+		primary:   shadowResponse{latestValidHash: latestValidHash, err: err},
+		call: func(ctx context.Context) shadowResponse {
+			_, hash, shadowErr := ee.shadowClient.ForkchoiceUpdated(
+				ctx, req.State, req.PayloadAttributes, req.ForkVersion,
+			)
+			return shadowResponse{latestValidHash: hash, err: shadowErr}
+		},
+	})
+}
+
+// payloadStatusFromError recovers the payload status from the error
+// returned by the engine client.
+func payloadStatusFromError(err error) string {
+	switch {
+	case err == nil:
+		return engineprimitives.PayloadStatusValid
+	case errors.Is(err, engineerrors.ErrAcceptedPayloadStatus):
+		return engineprimitives.PayloadStatusAccepted
+	case errors.Is(err, engineerrors.ErrSyncingPayloadStatus):
+		return engineprimitives.PayloadStatusSyncing
+	case errors.IsAny(
+		err,
+		engineerrors.ErrInvalidPayloadStatus,
+		engineerrors.ErrInvalidBlockHashPayloadStatus,
+	):
+		return engineprimitives.PayloadStatusInvalid
+	default:
+		return "ERROR"
+	}
+}
+
+// equalHashes reports whether both hashes are nil or hold the same value.
+func equalHashes(a, b *common.ExecutionHash) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+type testSink struct {
+	mu       sync.Mutex
+	counters map[string]int
+}
+
+func (s *testSink) IncrementCounter(key string, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, arg := range args {
+		key += "." + arg
+	}
+	s.counters[key]++
+}
+
+func (s *testSink) SetGauge(string, int64, ...string) {}
+
+func (s *testSink) count(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.counters[key]
+}
+
+func newTestShadowComparator() (*shadowComparator, *testSink) {
+	sink := &testSink{counters: make(map[string]int)}
+	logger := noop.NewLogger()
+	return newShadowComparator(
+		func(context.Context) error { return nil },
+		logger,
+		newEngineMetrics(sink, logger),
+	), sink
+}
+
+func TestShadowComparator_Compare(t *testing.T) {
+	const (
+		statusKey = "beacon_kit.execution.engine.shadow_divergence" +
+			".method.new_payload.field.payload_status"
+		hashKey = "beacon_kit.execution.engine.shadow_divergence" +
+			".method.new_payload.field.latest_valid_hash"
+	)
+	hash := common.ExecutionHash{1}
+	otherHash := common.ExecutionHash{2}
+
+	tests := []struct {
+		name             string
+		primary, shadow  shadowResponse
+		statusDivergence int
+		hashDivergence   int
+	}{
+		{
+			name:    "agreeing responses",
+			primary: shadowResponse{latestValidHash: &hash},
+			shadow:  shadowResponse{latestValidHash: &hash},
+		},
+		{
+			name: "both syncing",
+			primary: shadowResponse{
+				err: engineerrors.ErrSyncingPayloadStatus,
+			},
+			shadow: shadowResponse{
+				err: engineerrors.ErrSyncingPayloadStatus,
+			},
+		},
+		{
+			name:    "shadow rejects the payload",
+			primary: shadowResponse{latestValidHash: &hash},
+			shadow: shadowResponse{
+				latestValidHash: &otherHash,
+				err:             engineerrors.ErrInvalidPayloadStatus,
+			},
+			statusDivergence: 1,
+			hashDivergence:   1,
+		},
+		{
+			name:    "shadow is syncing",
+			primary: shadowResponse{latestValidHash: &hash},
+			shadow: shadowResponse{
+				err: engineerrors.ErrSyncingPayloadStatus,
+			},
+			statusDivergence: 1,
+			hashDivergence:   1,
+		},
+		{
+			name:             "latest valid hashes differ",
+			primary:          shadowResponse{latestValidHash: &hash},
+			shadow:           shadowResponse{latestValidHash: &otherHash},
+			statusDivergence: 0,
+			hashDivergence:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc, sink := newTestShadowComparator()
+			sc.compare(&shadowCall{
+				method:  shadowMethodNewPayload,
+				primary: tt.primary,
+			}, tt.shadow)
+			require.Equal(t, tt.statusDivergence, sink.count(statusKey))
+			require.Equal(t, tt.hashDivergence, sink.count(hashKey))
+		})
+	}
+}
+
+func TestShadowComparator_RunReplaysInOrder(t *testing.T) {
+	sc, _ := newTestShadowComparator()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go sc.run(ctx)
+
+	var (
+		mu     sync.Mutex
+		called []int
+	)
+	for i := range 3 {
+		sc.enqueue(&shadowCall{
+			method: shadowMethodNewPayload,
+			call: func(context.Context) shadowResponse {
+				mu.Lock()
+				defer mu.Unlock()
+				called = append(called, i)
+				return shadowResponse{}
+			},
+		})
+	}
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(called) == 3
+	}, time.Second, time.Millisecond)
+	require.Equal(t, []int{0, 1, 2}, called)
+}
+
+func TestShadowComparator_DropsWhenFallingBehind(t *testing.T) {
+	sc, sink := newTestShadowComparator()
+
+	// Without a running comparator the queue is never drained.
+	for range shadowQueueSize + 2 {
+		sc.enqueue(&shadowCall{method: shadowMethodForkchoiceUpdated})
+	}
+	require.Equal(t, 2, sink.count(
+		"beacon_kit.execution.engine.shadow_call_dropped"+
+			".method.forkchoice_updated",
+	))
+}
@@ -23,27 +23,178 @@ package backend
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4881"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
 type Backend struct {
-	getNewStateDB func(context.Context, string) StateDB
+	cs           common.ChainSpec
+	sdb          StateStore[StateDB]
+	bs           BlockStore[*types.BeaconBlock]
+	blobs        BlobStore
+	deposits     DepositStore
+	sp           StateProcessor[StateDB]
+	builder      Builder
+	pool         OperationPool
+	blkFeed      EventFeed[*asynctypes.Event[*types.BeaconBlock]]
+	sidecarsFeed EventFeed[*asynctypes.Event[*datypes.BlobSidecars]]
 }
 
-// TODO: need to add state_id resolver; possible values are: "head" (canonical
-// head in node's view), "genesis", "finalized", "justified", <slot>, <hex
-// encoded stateRoot with 0x prefix>.
-func New(
-	getNewStateDB func(ctx context.Context, stateId string) StateDB,
-) *Backend {
+// Options holds the dependencies of the backend. Dependencies may be left
+// unset when none of the served handlers need them.
+type Options struct {
+	// ChainSpec is the chain spec of the node.
+	ChainSpec common.ChainSpec
+	// StateStore serves the current and historical beacon states.
+	StateStore StateStore[StateDB]
+	// BlockStore serves the blocks persisted by the node.
+	BlockStore BlockStore[*types.BeaconBlock]
+	// BlobStore serves the blob sidecars persisted by the node.
+	BlobStore BlobStore
+	// DepositStore serves the deposit contract tree of the node.
+	DepositStore DepositStore
+	// StateProcessor re-executes blocks to compute their rewards.
+	StateProcessor StateProcessor[StateDB]
+	// Builder forwards validator registrations and blinded blocks to the
+	// relay of the node.
+	Builder Builder
+	// OperationPool holds the operations submitted to the node until they
+	// are included in a block.
+	OperationPool OperationPool
+	// BlockFeed is the feed of the blocks processed by the node.
+	BlockFeed EventFeed[*asynctypes.Event[*types.BeaconBlock]]
+	// SidecarsFeed is the feed of the blob sidecars processed by the node.
+	SidecarsFeed EventFeed[*asynctypes.Event[*datypes.BlobSidecars]]
+}
+
+// New creates a new backend from the given options.
+func New(opts Options) *Backend {
 	return &Backend{
-		getNewStateDB: getNewStateDB,
+		cs:           opts.ChainSpec,
+		sdb:          opts.StateStore,
+		bs:           opts.BlockStore,
+		blobs:        opts.BlobStore,
+		deposits:     opts.DepositStore,
+		sp:           opts.StateProcessor,
+		builder:      opts.Builder,
+		pool:         opts.OperationPool,
+		blkFeed:      opts.BlockFeed,
+		sidecarsFeed: opts.SidecarsFeed,
 	}
 }
 
+// StateStore provides access to the current and historical beacon states.
+// Every call returns a fresh copy of the state, which callers are free to
+// mutate. States that are not available are reported with an error wrapping
+// ErrStateNotFound.
+type StateStore[StateDBT any] interface {
+	// HeadState returns the latest committed state.
+	HeadState(ctx context.Context) (StateDBT, error)
+	// StateAtSlot returns the state at the given slot. The state at slot
+	// zero is the genesis state.
+	StateAtSlot(ctx context.Context, slot math.Slot) (StateDBT, error)
+	// StateByRoot returns the state with the given state root.
+	StateByRoot(ctx context.Context, root common.Root) (StateDBT, error)
+}
+
+// BlockStore provides access to the blocks persisted by the node. Blocks that
+// are not available are reported with an error wrapping ErrBlockNotFound.
+type BlockStore[BeaconBlockT any] interface {
+	// GetBySlot returns the block at the given slot.
+	GetBySlot(slot math.Slot) (BeaconBlockT, error)
+	// GetByRoot returns the block with the given block root.
+	GetByRoot(root common.Root) (BeaconBlockT, error)
+}
+
+// BlobStore provides access to the blob sidecars persisted by the node within
+// the data availability window.
+type BlobStore interface {
+	// GetBlobSidecarsByIndices returns the sidecars stored for the given slot
+	// whose index is one of the given indices, or all of them if no index is
+	// given.
+	GetBlobSidecarsByIndices(
+		slot math.Slot,
+		indices []uint64,
+	) (*datypes.BlobSidecars, error)
+}
+
+// DepositStore provides access to the deposit contract tree maintained by
+// the node.
+type DepositStore interface {
+	// GetDepositSnapshot returns the snapshot of the finalized deposits, or
+	// nil if no deposits have been finalized yet.
+	GetDepositSnapshot() (*eip4881.DepositTreeSnapshot, error)
+}
+
+// Builder forwards validator registrations and signed blinded blocks to the
+// relay external block builders are reached through.
+type Builder interface {
+	// RegisterValidators registers the validators with the relay.
+	RegisterValidators(
+		ctx context.Context,
+		registrations []*relay.SignedValidatorRegistration,
+	) error
+	// PublishBlindedBlock submits the signed blinded block of the given fork
+	// version to the relay, which reveals its execution payload.
+	PublishBlindedBlock(
+		ctx context.Context,
+		forkVersion uint32,
+		signedBlindedBlock any,
+	) error
+}
+
+// OperationPool holds the operations waiting to be included in a block.
+type OperationPool interface {
+	// AddProposerSlashing adds the proposer slashing to the pool.
+	AddProposerSlashing(slashing *types.ProposerSlashing)
+	// ProposerSlashings returns the proposer slashings of the pool.
+	ProposerSlashings() []*types.ProposerSlashing
+	// AddVoluntaryExit adds the voluntary exit to the pool.
+	AddVoluntaryExit(exit *types.SignedVoluntaryExit)
+	// VoluntaryExits returns the voluntary exits of the pool.
+	VoluntaryExits() []*types.SignedVoluntaryExit
+}
+
+// EventFeed is a feed of events the backend can subscribe to, such as the
+// block and blob sidecar brokers of the node.
+type EventFeed[EventT any] interface {
+	// SubscribeWithOptions registers a new client with the given buffer size
+	// and backpressure policy, and returns its channel.
+	SubscribeWithOptions(
+		opts ...broker.SubscriptionOption,
+	) (chan EventT, error)
+	// Unsubscribe removes the client and closes its channel.
+	Unsubscribe(chan EventT)
+}
+
+// StateProcessor re-executes blocks and validates operations on top of
+// their pre-state.
+type StateProcessor[StateDBT any] interface {
+	// ComputeBlockRewards returns the rewards earned by the proposer of the
+	// given block. The given pre-state is mutated in the process.
+	ComputeBlockRewards(
+		st StateDBT,
+		blk *types.BeaconBlock,
+	) (*transition.BlockRewards, error)
+	// ValidateProposerSlashing returns an error if the proposer slashing can
+	// not be included in a block on top of the given state.
+	ValidateProposerSlashing(
+		st StateDBT,
+		slashing *types.ProposerSlashing,
+	) error
+	// ValidateVoluntaryExit returns an error if the voluntary exit can not
+	// be included in a block on top of the given state.
+	ValidateVoluntaryExit(st StateDBT, exit *types.SignedVoluntaryExit) error
+}
+
 type StateDB interface {
 	GetGenesisValidatorsRoot() (common.Root, error)
 	GetSlot() (math.Slot, error)
@@ -33,14 +33,21 @@ import (
 
 func (h Backend) GetGenesis(ctx context.Context) (common.Root, error) {
 	// needs genesis_time and gensis_fork_version
-	return h.getNewStateDB(ctx, "stateID").GetGenesisValidatorsRoot()
+	stateDB, err := h.stateFromID(ctx, StateIDGenesis)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return stateDB.GetGenesisValidatorsRoot()
 }
 
 func (h Backend) GetStateRoot(
 	ctx context.Context,
 	stateID string,
 ) (common.Bytes32, error) {
-	stateDB := h.getNewStateDB(ctx, stateID)
+	stateDB, err := h.stateFromID(ctx, stateID)
+	if err != nil {
+		return common.Bytes32{}, err
+	}
 	slot, err := stateDB.GetSlot()
 	if err != nil {
 		return common.Bytes32{}, err
@@ -60,7 +67,11 @@ func (h Backend) GetStateFork(
 	ctx context.Context,
 	stateID string,
 ) (*types.Fork, error) {
-	return h.getNewStateDB(ctx, stateID).GetFork()
+	stateDB, err := h.stateFromID(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
+	return stateDB.GetFork()
 }
 
 func (h Backend) GetStateValidators(
@@ -69,7 +80,10 @@ func (h Backend) GetStateValidators(
 	id []string,
 	_ []string,
 ) ([]*serverType.ValidatorData, error) {
-	stateDB := h.getNewStateDB(ctx, stateID)
+	stateDB, err := h.stateFromID(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
 	validators := make([]*serverType.ValidatorData, 0)
 	for _, indexOrKey := range id {
 		index, indexErr := getValidatorIndex(stateDB, indexOrKey)
@@ -115,7 +129,10 @@ func (h Backend) GetStateValidator(
 	stateID string,
 	validatorID string,
 ) (*serverType.ValidatorData, error) {
-	stateDB := h.getNewStateDB(ctx, stateID)
+	stateDB, err := h.stateFromID(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
 	index, indexErr := getValidatorIndex(stateDB, validatorID)
 	if indexErr != nil {
 		return nil, indexErr
@@ -141,7 +158,10 @@ func (h Backend) GetStateValidatorBalances(
 	stateID string,
 	id []string,
 ) ([]*serverType.ValidatorBalanceData, error) {
-	stateDB := h.getNewStateDB(ctx, stateID)
+	stateDB, err := h.stateFromID(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
 	balances := make([]*serverType.ValidatorBalanceData, 0)
 	for _, indexOrKey := range id {
 		index, indexErr := getValidatorIndex(stateDB, indexOrKey)
@@ -159,23 +179,3 @@ func (h Backend) GetStateValidatorBalances(
 	}
 	return balances, nil
 }
-
-func (h Backend) GetBlockRoot(
-	ctx context.Context,
-	_ string,
-) (common.Bytes32, error) {
-	stateDB := h.getNewStateDB(ctx, "stateID")
-	slot, err := stateDB.GetSlot()
-	if err != nil {
-		return common.Bytes32{}, err
-	}
-	block, err := stateDB.GetBlockRootAtIndex(slot.Unwrap())
-	if err != nil {
-		return common.Bytes32{}, err
-	}
-	root, err := block.HashTreeRoot()
-	if err != nil {
-		return common.Bytes32{}, err
-	}
-	return root, nil
-}
@@ -27,14 +27,16 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/backend"
 	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGetGenesisValidatorsRoot(t *testing.T) {
 	sdb := &mocks.StateDB{}
-	b := backend.New(func(context.Context, string) backend.StateDB {
-		return sdb
-	})
+	store := &mocks.StateStore[backend.StateDB]{}
+	b := backend.New(backend.Options{StateStore: store})
+	store.EXPECT().StateAtSlot(mock.Anything, math.Slot(0)).Return(sdb, nil)
 	sdb.EXPECT().GetGenesisValidatorsRoot().Return(common.Root{0x01}, nil)
 	root, err := b.GetGenesis(context.Background())
 	require.NoError(t, err)
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+	"strconv"
+
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// GetBlobSidecars returns the blob sidecars of the block with the given ID,
+// restricted to the given indices if any. Sidecars are only retained within
+// the data availability window, past which none are returned.
+func (h Backend) GetBlobSidecars(
+	ctx context.Context,
+	blockID string,
+	indices []string,
+) (*datypes.BlobSidecars, error) {
+	blobIndices := make([]uint64, 0, len(indices))
+	for _, index := range indices {
+		blobIndex, err := strconv.ParseUint(index, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidBlobIndex, "%s", index)
+		}
+		blobIndices = append(blobIndices, blobIndex)
+	}
+
+	slot, err := h.slotFromBlockID(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	return h.blobs.GetBlobSidecarsByIndices(slot, blobIndices)
+}
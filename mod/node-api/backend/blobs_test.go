@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBlobSidecars(t *testing.T) {
+	sidecars := &datypes.BlobSidecars{
+		Sidecars: []*datypes.BlobSidecar{{Index: 1}},
+	}
+	blk := newTestBlock(6, common.Root{0x01})
+	hashRoot, err := blk.HashTreeRoot()
+	require.NoError(t, err)
+	root := common.Root(hashRoot)
+
+	tests := []struct {
+		name    string
+		blockID string
+		indices []string
+		expect  func(
+			*mocks.StateStore[backend.StateDB],
+			*mocks.BlockStore[*types.BeaconBlock],
+			*mocks.BlobStore,
+		)
+	}{
+		{
+			name:    "head",
+			blockID: "head",
+			expect: func(
+				store *mocks.StateStore[backend.StateDB],
+				_ *mocks.BlockStore[*types.BeaconBlock],
+				blobs *mocks.BlobStore,
+			) {
+				sdb := &mocks.StateDB{}
+				sdb.EXPECT().GetSlot().Return(6, nil)
+				store.EXPECT().HeadState(mock.Anything).Return(sdb, nil)
+				blobs.EXPECT().
+					GetBlobSidecarsByIndices(math.Slot(6), []uint64{}).
+					Return(sidecars, nil)
+			},
+		},
+		{
+			name:    "slot with indices",
+			blockID: "6",
+			indices: []string{"1", "3"},
+			expect: func(
+				_ *mocks.StateStore[backend.StateDB],
+				_ *mocks.BlockStore[*types.BeaconBlock],
+				blobs *mocks.BlobStore,
+			) {
+				blobs.EXPECT().
+					GetBlobSidecarsByIndices(math.Slot(6), []uint64{1, 3}).
+					Return(sidecars, nil)
+			},
+		},
+		{
+			name:    "block root",
+			blockID: root.String(),
+			expect: func(
+				_ *mocks.StateStore[backend.StateDB],
+				bs *mocks.BlockStore[*types.BeaconBlock],
+				blobs *mocks.BlobStore,
+			) {
+				bs.EXPECT().GetByRoot(root).Return(blk, nil)
+				blobs.EXPECT().
+					GetBlobSidecarsByIndices(math.Slot(6), []uint64{}).
+					Return(sidecars, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mocks.StateStore[backend.StateDB]{}
+			bs := &mocks.BlockStore[*types.BeaconBlock]{}
+			blobs := &mocks.BlobStore{}
+			tt.expect(store, bs, blobs)
+
+			b := backend.New(backend.Options{
+				StateStore: store,
+				BlockStore: bs,
+				BlobStore:  blobs,
+			})
+			got, err := b.GetBlobSidecars(
+				context.Background(), tt.blockID, tt.indices,
+			)
+			require.NoError(t, err)
+			require.Equal(t, sidecars, got)
+			blobs.AssertExpectations(t)
+			bs.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetBlobSidecarsInvalidIndex(t *testing.T) {
+	blobs := &mocks.BlobStore{}
+	b := backend.New(backend.Options{BlobStore: blobs})
+
+	_, err := b.GetBlobSidecars(context.Background(), "6", []string{"x"})
+	require.ErrorIs(t, err, backend.ErrInvalidBlobIndex)
+	blobs.AssertNotCalled(
+		t, "GetBlobSidecarsByIndices", mock.Anything, mock.Anything,
+	)
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// BlockIDHead is the canonical head in the node's view.
+	BlockIDHead = "head"
+	// BlockIDGenesis is the genesis block.
+	BlockIDGenesis = "genesis"
+	// BlockIDFinalized is the latest finalized block.
+	BlockIDFinalized = "finalized"
+)
+
+// blockFromID resolves the given block ID to the block it refers to. The
+// block ID is one of "head", "genesis", "finalized", a decimal slot, or a 0x
+// prefixed hex encoded block root.
+func (h Backend) blockFromID(
+	ctx context.Context,
+	blockID string,
+) (*types.BeaconBlock, error) {
+	if strings.HasPrefix(blockID, "0x") {
+		root, err := blockRootFromID(blockID)
+		if err != nil {
+			return nil, err
+		}
+		return h.bs.GetByRoot(root)
+	}
+
+	slot, err := h.slotFromBlockID(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	return h.bs.GetBySlot(slot)
+}
+
+// slotFromBlockID resolves the given block ID to the slot of the block it
+// refers to. Only block roots require the block itself to be looked up.
+//
+// Blocks are final as soon as they are committed by CometBFT, so the
+// finalized block is always the head block.
+func (h Backend) slotFromBlockID(
+	ctx context.Context,
+	blockID string,
+) (math.Slot, error) {
+	switch {
+	case blockID == BlockIDHead, blockID == BlockIDFinalized:
+		st, err := h.sdb.HeadState(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return st.GetSlot()
+	case blockID == BlockIDGenesis:
+		return 0, nil
+	case strings.HasPrefix(blockID, "0x"):
+		root, err := blockRootFromID(blockID)
+		if err != nil {
+			return 0, err
+		}
+		blk, err := h.bs.GetByRoot(root)
+		if err != nil {
+			return 0, err
+		}
+		return blk.GetSlot(), nil
+	default:
+		slot, err := strconv.ParseUint(blockID, 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(ErrInvalidBlockID, "%s", blockID)
+		}
+		return math.Slot(slot), nil
+	}
+}
+
+// blockRootFromID parses the given hex encoded block root.
+func blockRootFromID(blockID string) (common.Root, error) {
+	var root common.Root
+	if err := root.UnmarshalText([]byte(blockID)); err != nil {
+		return common.Root{}, errors.Wrapf(ErrInvalidBlockID, "%s", blockID)
+	}
+	return root, nil
+}
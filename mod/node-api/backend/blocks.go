@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// GetBlock returns the block with the given ID.
+func (h Backend) GetBlock(
+	ctx context.Context,
+	blockID string,
+) (*types.BeaconBlock, error) {
+	return h.blockFromID(ctx, blockID)
+}
+
+// GetBlockRoot returns the root of the block with the given ID.
+func (h Backend) GetBlockRoot(
+	ctx context.Context,
+	blockID string,
+) (common.Root, error) {
+	blk, err := h.blockFromID(ctx, blockID)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return blk.HashTreeRoot()
+}
+
+// GetBlockHeader returns the header of the block with the given ID.
+func (h Backend) GetBlockHeader(
+	ctx context.Context,
+	blockID string,
+) (*serverType.BlockHeaderData, error) {
+	blk, err := h.blockFromID(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	return blockHeaderData(blk)
+}
+
+// GetBlockHeaders returns the headers of the blocks matching the given slot
+// and parent root, both of which are optional. The head block header is
+// returned when neither is set.
+func (h Backend) GetBlockHeaders(
+	ctx context.Context,
+	slot string,
+	parentRoot string,
+) ([]*serverType.BlockHeaderData, error) {
+	blockID := BlockIDHead
+	if slot != "" {
+		blockID = slot
+	}
+
+	var parent common.Root
+	if parentRoot != "" {
+		if err := parent.UnmarshalText([]byte(parentRoot)); err != nil {
+			return nil, errors.Wrapf(ErrInvalidBlockID, "%s", parentRoot)
+		}
+	}
+
+	// Every CometBFT height carries a block, so the child of a block is
+	// always found at the next slot.
+	if parentRoot != "" && slot == "" {
+		parentBlk, err := h.bs.GetByRoot(parent)
+		if errors.Is(err, ErrBlockNotFound) {
+			return []*serverType.BlockHeaderData{}, nil
+		} else if err != nil {
+			return nil, err
+		}
+		blockID = strconv.FormatUint(parentBlk.GetSlot().Unwrap()+1, 10)
+	}
+
+	blk, err := h.blockFromID(ctx, blockID)
+	if errors.Is(err, ErrBlockNotFound) {
+		return []*serverType.BlockHeaderData{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if parentRoot != "" && blk.GetParentBlockRoot() != parent {
+		return []*serverType.BlockHeaderData{}, nil
+	}
+
+	header, err := blockHeaderData(blk)
+	if err != nil {
+		return nil, err
+	}
+	return []*serverType.BlockHeaderData{header}, nil
+}
+
+// blockHeaderData builds the header response of the given block.
+func blockHeaderData(
+	blk *types.BeaconBlock,
+) (*serverType.BlockHeaderData, error) {
+	root, err := blk.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	bodyRoot, err := blk.GetBody().HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	return &serverType.BlockHeaderData{
+		Root:      root,
+		Canonical: true,
+		Header: &serverType.BlockHeaderMessage{
+			Message: &serverType.BeaconBlockHeaderData{
+				Slot:          blk.GetSlot().Unwrap(),
+				ProposerIndex: blk.GetProposerIndex().Unwrap(),
+				ParentRoot:    blk.GetParentBlockRoot(),
+				StateRoot:     blk.GetStateRoot(),
+				BodyRoot:      bodyRoot,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestBlock returns a hashable block at the given slot.
+func newTestBlock(slot math.Slot, parentRoot common.Root) *types.BeaconBlock {
+	return &types.BeaconBlock{RawBeaconBlock: &types.BeaconBlockDeneb{
+		BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+			Slot:            slot.Unwrap(),
+			ProposerIndex:   1,
+			ParentBlockRoot: parentRoot,
+		},
+		Body: &types.BeaconBlockBodyDeneb{
+			BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+				Eth1Data: &types.Eth1Data{},
+			},
+			ExecutionPayload: &types.ExecutableDataDeneb{
+				LogsBloom:    make([]byte, 256),
+				ExtraData:    []byte{},
+				Transactions: [][]byte{},
+				Withdrawals:  []*engineprimitives.Withdrawal{},
+			},
+			BlobKzgCommitments: []eip4844.KZGCommitment{},
+		},
+	}}
+}
+
+func TestBlockIDResolution(t *testing.T) {
+	blk := newTestBlock(9, common.Root{0x01})
+	hashRoot, err := blk.HashTreeRoot()
+	require.NoError(t, err)
+	root := common.Root(hashRoot)
+
+	headState := func(store *mocks.StateStore[backend.StateDB]) {
+		sdb := &mocks.StateDB{}
+		sdb.EXPECT().GetSlot().Return(9, nil)
+		store.EXPECT().HeadState(mock.Anything).Return(sdb, nil)
+	}
+	tests := []struct {
+		name    string
+		blockID string
+		expect  func(
+			*mocks.StateStore[backend.StateDB],
+			*mocks.BlockStore[*types.BeaconBlock],
+		)
+	}{
+		{
+			name:    "head",
+			blockID: "head",
+			expect: func(
+				store *mocks.StateStore[backend.StateDB],
+				bs *mocks.BlockStore[*types.BeaconBlock],
+			) {
+				headState(store)
+				bs.EXPECT().GetBySlot(math.Slot(9)).Return(blk, nil)
+			},
+		},
+		{
+			name:    "finalized",
+			blockID: "finalized",
+			expect: func(
+				store *mocks.StateStore[backend.StateDB],
+				bs *mocks.BlockStore[*types.BeaconBlock],
+			) {
+				headState(store)
+				bs.EXPECT().GetBySlot(math.Slot(9)).Return(blk, nil)
+			},
+		},
+		{
+			name:    "genesis",
+			blockID: "genesis",
+			expect: func(
+				_ *mocks.StateStore[backend.StateDB],
+				bs *mocks.BlockStore[*types.BeaconBlock],
+			) {
+				bs.EXPECT().GetBySlot(math.Slot(0)).Return(blk, nil)
+			},
+		},
+		{
+			name:    "slot",
+			blockID: "9",
+			expect: func(
+				_ *mocks.StateStore[backend.StateDB],
+				bs *mocks.BlockStore[*types.BeaconBlock],
+			) {
+				bs.EXPECT().GetBySlot(math.Slot(9)).Return(blk, nil)
+			},
+		},
+		{
+			name:    "block root",
+			blockID: root.String(),
+			expect: func(
+				_ *mocks.StateStore[backend.StateDB],
+				bs *mocks.BlockStore[*types.BeaconBlock],
+			) {
+				bs.EXPECT().GetByRoot(root).Return(blk, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mocks.StateStore[backend.StateDB]{}
+			bs := &mocks.BlockStore[*types.BeaconBlock]{}
+			tt.expect(store, bs)
+
+			b := backend.New(backend.Options{
+				StateStore: store,
+				BlockStore: bs,
+			})
+			got, err := b.GetBlockRoot(context.Background(), tt.blockID)
+			require.NoError(t, err)
+			require.Equal(t, root, got)
+			store.AssertExpectations(t)
+			bs.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBlockIDInvalid(t *testing.T) {
+	bs := &mocks.BlockStore[*types.BeaconBlock]{}
+	b := backend.New(backend.Options{BlockStore: bs})
+
+	for _, blockID := range []string{"justified", "-1", "0x01", "0xzz"} {
+		_, err := b.GetBlock(context.Background(), blockID)
+		require.ErrorIs(t, err, backend.ErrInvalidBlockID, blockID)
+	}
+	bs.AssertNotCalled(t, "GetBySlot", mock.Anything)
+}
+
+func TestBlockIDNotFound(t *testing.T) {
+	bs := &mocks.BlockStore[*types.BeaconBlock]{}
+	bs.EXPECT().
+		GetBySlot(math.Slot(1000)).
+		Return(nil, errors.Wrap(backend.ErrBlockNotFound, "slot: 1000"))
+	b := backend.New(backend.Options{BlockStore: bs})
+
+	_, err := b.GetBlockHeader(context.Background(), "1000")
+	require.ErrorIs(t, err, backend.ErrBlockNotFound)
+}
+
+func TestGetBlockHeadersByParentRoot(t *testing.T) {
+	parent := newTestBlock(4, common.Root{0x01})
+	hashRoot, err := parent.HashTreeRoot()
+	require.NoError(t, err)
+	parentRoot := common.Root(hashRoot)
+	child := newTestBlock(5, parentRoot)
+	childRoot, err := child.HashTreeRoot()
+	require.NoError(t, err)
+
+	bs := &mocks.BlockStore[*types.BeaconBlock]{}
+	bs.EXPECT().GetByRoot(parentRoot).Return(parent, nil)
+	bs.EXPECT().GetBySlot(math.Slot(5)).Return(child, nil)
+	b := backend.New(backend.Options{BlockStore: bs})
+
+	// The child of a block is found at the next slot.
+	headers, err := b.GetBlockHeaders(
+		context.Background(), "", parentRoot.String(),
+	)
+	require.NoError(t, err)
+	require.Len(t, headers, 1)
+	require.Equal(t, common.Root(childRoot), headers[0].Root)
+	require.Equal(t, uint64(5), headers[0].Header.Message.Slot)
+	require.Equal(t, parentRoot, headers[0].Header.Message.ParentRoot)
+
+	// A block at the requested slot that does not descend from the parent
+	// root does not match.
+	headers, err = b.GetBlockHeaders(
+		context.Background(), "5", common.Root{0x02}.String(),
+	)
+	require.NoError(t, err)
+	require.Empty(t, headers)
+}
+
+func TestGetBlockHeadersNotFound(t *testing.T) {
+	bs := &mocks.BlockStore[*types.BeaconBlock]{}
+	bs.EXPECT().
+		GetBySlot(math.Slot(7)).
+		Return(nil, errors.Wrap(backend.ErrBlockNotFound, "slot: 7"))
+	b := backend.New(backend.Options{BlockStore: bs})
+
+	headers, err := b.GetBlockHeaders(context.Background(), "7", "")
+	require.NoError(t, err)
+	require.Empty(t, headers)
+}
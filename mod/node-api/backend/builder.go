@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+)
+
+// RegisterValidators registers the validators with the relay, so that
+// external block builders build payloads with their fee recipient and gas
+// limit.
+func (h Backend) RegisterValidators(
+	ctx context.Context,
+	registrations []*relay.SignedValidatorRegistration,
+) error {
+	if h.builder == nil {
+		return ErrBuilderNotConfigured
+	}
+	return h.builder.RegisterValidators(ctx, registrations)
+}
+
+// PublishBlindedBlock submits the JSON encoded signed blinded block of the
+// given fork version to the relay, which reveals the execution payload the
+// block commits to.
+func (h Backend) PublishBlindedBlock(
+	ctx context.Context,
+	forkVersion uint32,
+	signedBlindedBlock json.RawMessage,
+) error {
+	if h.builder == nil {
+		return ErrBuilderNotConfigured
+	}
+	return h.builder.PublishBlindedBlock(
+		ctx, forkVersion, signedBlindedBlock,
+	)
+}
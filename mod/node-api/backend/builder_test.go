@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterValidators(t *testing.T) {
+	builder := mocks.NewBuilder(t)
+	b := backend.New(backend.Options{Builder: builder})
+
+	registrations := []*relay.SignedValidatorRegistration{{
+		Message: &relay.ValidatorRegistration{GasLimit: 30000000},
+	}}
+	builder.EXPECT().
+		RegisterValidators(mock.Anything, registrations).
+		Return(nil).
+		Once()
+	require.NoError(t, b.RegisterValidators(
+		context.Background(), registrations,
+	))
+}
+
+func TestPublishBlindedBlock(t *testing.T) {
+	builder := mocks.NewBuilder(t)
+	b := backend.New(backend.Options{Builder: builder})
+
+	signedBlindedBlock := json.RawMessage(`{"message":{}}`)
+	builder.EXPECT().
+		PublishBlindedBlock(mock.Anything, version.Deneb, signedBlindedBlock).
+		Return(nil).
+		Once()
+	require.NoError(t, b.PublishBlindedBlock(
+		context.Background(), version.Deneb, signedBlindedBlock,
+	))
+}
+
+func TestBuilderNotConfigured(t *testing.T) {
+	b := backend.New(backend.Options{})
+
+	err := b.RegisterValidators(context.Background(), nil)
+	require.ErrorIs(t, err, backend.ErrBuilderNotConfigured)
+	err = b.PublishBlindedBlock(
+		context.Background(), version.Deneb, json.RawMessage(`{}`),
+	)
+	require.ErrorIs(t, err, backend.ErrBuilderNotConfigured)
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4881"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDepositSnapshot(t *testing.T) {
+	deposits := mocks.NewDepositStore(t)
+	b := backend.New(backend.Options{DepositStore: deposits})
+
+	// No snapshot is served before any deposit is finalized.
+	deposits.EXPECT().GetDepositSnapshot().Return(nil, nil).Once()
+	_, err := b.GetDepositSnapshot(context.Background())
+	require.ErrorIs(t, err, backend.ErrDepositSnapshotNotFound)
+
+	snapshot := &eip4881.DepositTreeSnapshot{
+		Finalized:    []common.Root{{0x01}},
+		DepositCount: 1,
+	}
+	deposits.EXPECT().GetDepositSnapshot().Return(snapshot, nil).Once()
+	got, err := b.GetDepositSnapshot(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, snapshot, got)
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidStateID is returned when a state ID is neither one of the
+	// named states, a slot, nor a hex encoded state root.
+	ErrInvalidStateID = errors.New("invalid state ID")
+	// ErrStateNotFound is returned when no state is known for a valid
+	// state ID.
+	ErrStateNotFound = errors.New("state not found")
+	// ErrInvalidBlockID is returned when a block ID is neither one of the
+	// named blocks, a slot, nor a hex encoded block root.
+	ErrInvalidBlockID = errors.New("invalid block ID")
+	// ErrBlockNotFound is returned when no block is known for a valid
+	// block ID.
+	ErrBlockNotFound = errors.New("block not found")
+	// ErrInvalidBlobIndex is returned when a blob index is not a decimal
+	// number.
+	ErrInvalidBlobIndex = errors.New("invalid blob index")
+	// ErrDepositSnapshotNotFound is returned when no deposits have been
+	// finalized yet.
+	ErrDepositSnapshotNotFound = errors.New("deposit snapshot not found")
+	// ErrBuilderNotConfigured is returned when the node does not reach
+	// external block builders through a relay.
+	ErrBuilderNotConfigured = errors.New("builder not configured")
+	// ErrOperationPoolNotConfigured is returned when the node does not pool
+	// operations for block building.
+	ErrOperationPoolNotConfigured = errors.New(
+		"operation pool not configured",
+	)
+	// ErrInvalidProposerSlashing is returned when a submitted proposer
+	// slashing can not be included in a block on top of the head state.
+	ErrInvalidProposerSlashing = errors.New("invalid proposer slashing")
+	// ErrInvalidVoluntaryExit is returned when a submitted voluntary exit
+	// can not be included in a block on top of the head state.
+	ErrInvalidVoluntaryExit = errors.New("invalid voluntary exit")
+)
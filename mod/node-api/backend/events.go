@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	serverTypes "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// eventStreamBufferSize is the number of messages buffered for an events
+// stream before the oldest ones are dropped.
+const eventStreamBufferSize = 64
+
+// eventStreamSubscription returns the options the events streams subscribe
+// to the feeds with. A slow client must never hold back the node, so the
+// oldest messages are dropped once its buffer is full.
+func eventStreamSubscription() []broker.SubscriptionOption {
+	return []broker.SubscriptionOption{
+		broker.WithSubscriberBufferSize(eventStreamBufferSize),
+		broker.WithPolicy(broker.DropOldest),
+	}
+}
+
+// SubscribeEvents subscribes to the events of the given topics. The returned
+// channel is closed once the context is cancelled or the underlying feeds are
+// stopped.
+func (b *Backend) SubscribeEvents(
+	ctx context.Context,
+	topics []string,
+) (<-chan *serverTypes.Event, error) {
+	filter := make(map[string]bool, len(topics))
+	for _, topic := range topics {
+		filter[topic] = true
+	}
+
+	// Receiving from a nil channel blocks forever, so feeds that are not
+	// subscribed to are simply never selected.
+	var (
+		blkCh      chan *asynctypes.Event[*types.BeaconBlock]
+		sidecarsCh chan *asynctypes.Event[*datypes.BlobSidecars]
+		err        error
+	)
+	if filter[serverTypes.EventTopicHead] ||
+		filter[serverTypes.EventTopicBlock] ||
+		filter[serverTypes.EventTopicFinalizedCheckpoint] {
+		if blkCh, err = b.blkFeed.SubscribeWithOptions(
+			eventStreamSubscription()...,
+		); err != nil {
+			return nil, err
+		}
+	}
+	if filter[serverTypes.EventTopicBlobSidecar] {
+		if sidecarsCh, err = b.sidecarsFeed.SubscribeWithOptions(
+			eventStreamSubscription()...,
+		); err != nil {
+			if blkCh != nil {
+				b.blkFeed.Unsubscribe(blkCh)
+			}
+			return nil, err
+		}
+	}
+
+	out := make(chan *serverTypes.Event)
+	go func() {
+		defer close(out)
+		if blkCh != nil {
+			defer b.blkFeed.Unsubscribe(blkCh)
+		}
+		if sidecarsCh != nil {
+			defer b.sidecarsFeed.Unsubscribe(sidecarsCh)
+		}
+
+		for {
+			var evs []*serverTypes.Event
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-blkCh:
+				if !ok {
+					return
+				}
+				if !msg.Is(events.BeaconBlockFinalized) || msg.Error() != nil {
+					continue
+				}
+				evs = b.blockEvents(ctx, msg.Data(), filter)
+			case msg, ok := <-sidecarsCh:
+				if !ok {
+					return
+				}
+				if !msg.Is(events.BlobSidecarsProcessed) || msg.Error() != nil {
+					continue
+				}
+				evs = blobSidecarEvents(msg.Data())
+			}
+
+			for _, ev := range evs {
+				select {
+				case out <- ev:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+// blockEvents returns the events emitted for a finalized block. Since
+// CometBFT provides single slot finality, a block becomes the head and is
+// finalized at the same time.
+func (b *Backend) blockEvents(
+	ctx context.Context,
+	blk *types.BeaconBlock,
+	filter map[string]bool,
+) []*serverTypes.Event {
+	blkRoot, err := blk.HashTreeRoot()
+	if err != nil {
+		return nil
+	}
+
+	var evs []*serverTypes.Event
+	slot := blk.GetSlot()
+	if filter[serverTypes.EventTopicHead] {
+		if data, headErr := b.headEventData(
+			ctx, blk, blkRoot,
+		); headErr == nil {
+			evs = append(evs, &serverTypes.Event{
+				Topic: serverTypes.EventTopicHead,
+				Data:  data,
+			})
+		}
+	}
+	if filter[serverTypes.EventTopicBlock] {
+		evs = append(evs, &serverTypes.Event{
+			Topic: serverTypes.EventTopicBlock,
+			Data: &serverTypes.BlockEventData{
+				Slot:  slot.Unwrap(),
+				Block: blkRoot,
+			},
+		})
+	}
+	if filter[serverTypes.EventTopicFinalizedCheckpoint] {
+		evs = append(evs, &serverTypes.Event{
+			Topic: serverTypes.EventTopicFinalizedCheckpoint,
+			Data: &serverTypes.FinalizedCheckpointEventData{
+				Block: blkRoot,
+				State: blk.GetStateRoot(),
+				Epoch: b.cs.SlotToEpoch(slot).Unwrap(),
+			},
+		})
+	}
+	return evs
+}
+
+// headEventData returns the head event for the given block. The duty
+// dependent roots are read from the state committed with the block.
+func (b *Backend) headEventData(
+	ctx context.Context,
+	blk *types.BeaconBlock,
+	blkRoot common.Root,
+) (*serverTypes.HeadEventData, error) {
+	slot := blk.GetSlot()
+	st, err := b.sdb.StateAtSlot(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	epoch := b.cs.SlotToEpoch(slot)
+	currentRoot, err := b.dutyDependentRoot(st, epoch)
+	if err != nil {
+		return nil, err
+	}
+	previousRoot := currentRoot
+	if epoch > 0 {
+		if previousRoot, err = b.dutyDependentRoot(st, epoch-1); err != nil {
+			return nil, err
+		}
+	}
+
+	return &serverTypes.HeadEventData{
+		Slot:                      slot.Unwrap(),
+		Block:                     blkRoot,
+		State:                     blk.GetStateRoot(),
+		EpochTransition:           slot.Unwrap()%b.cs.SlotsPerEpoch() == 0,
+		PreviousDutyDependentRoot: previousRoot,
+		CurrentDutyDependentRoot:  currentRoot,
+	}, nil
+}
+
+// dutyDependentRoot returns the root of the last block before the start of
+// the given epoch, or the genesis block root for the genesis epoch.
+func (b *Backend) dutyDependentRoot(
+	st StateDB,
+	epoch math.Epoch,
+) (common.Root, error) {
+	slot := epoch.Unwrap() * b.cs.SlotsPerEpoch()
+	if slot > 0 {
+		slot--
+	}
+	return st.GetBlockRootAtIndex(slot % b.cs.SlotsPerHistoricalRoot())
+}
+
+// blobSidecarEvents returns the events emitted for processed blob sidecars.
+func blobSidecarEvents(
+	sidecars *datypes.BlobSidecars,
+) []*serverTypes.Event {
+	if sidecars == nil {
+		return nil
+	}
+
+	evs := make([]*serverTypes.Event, 0, len(sidecars.Sidecars))
+	for _, sidecar := range sidecars.Sidecars {
+		blkRoot, err := sidecar.BeaconBlockHeader.HashTreeRoot()
+		if err != nil {
+			continue
+		}
+		evs = append(evs, &serverTypes.Event{
+			Topic: serverTypes.EventTopicBlobSidecar,
+			Data: &serverTypes.BlobSidecarEventData{
+				BlockRoot:     blkRoot,
+				Index:         sidecar.Index,
+				Slot:          sidecar.BeaconBlockHeader.GetSlot().Unwrap(),
+				KzgCommitment: sidecar.KzgCommitment,
+				VersionedHash: sidecar.KzgCommitment.ToVersionedHash(),
+			},
+		})
+	}
+	return evs
+}
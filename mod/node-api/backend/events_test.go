@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	serverTypes "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	blockEvent    = asynctypes.Event[*types.BeaconBlock]
+	sidecarsEvent = asynctypes.Event[*datypes.BlobSidecars]
+)
+
+// newEventsBackend returns a backend subscribed to started brokers.
+func newEventsBackend(
+	t *testing.T,
+	store backend.StateStore[backend.StateDB],
+) (
+	*backend.Backend,
+	*broker.Broker[*blockEvent],
+	*broker.Broker[*sidecarsEvent],
+) {
+	t.Helper()
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			SlotsPerEpoch:          4,
+			SlotsPerHistoricalRoot: 8,
+		},
+	)
+	blkFeed := broker.New[*blockEvent]("blk-broker")
+	sidecarsFeed := broker.New[*sidecarsEvent]("blob-broker")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, blkFeed.Start(ctx))
+	require.NoError(t, sidecarsFeed.Start(ctx))
+
+	return backend.New(backend.Options{
+		ChainSpec:    cs,
+		StateStore:   store,
+		BlockFeed:    blkFeed,
+		SidecarsFeed: sidecarsFeed,
+	}), blkFeed, sidecarsFeed
+}
+
+// receive returns the next event of the stream.
+func receive(
+	t *testing.T,
+	ch <-chan *serverTypes.Event,
+) *serverTypes.Event {
+	t.Helper()
+	select {
+	case ev, ok := <-ch:
+		require.True(t, ok, "events stream closed")
+		return ev
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for event")
+		return nil
+	}
+}
+
+func TestSubscribeEventsBlock(t *testing.T) {
+	store := &mocks.StateStore[backend.StateDB]{}
+	b, blkFeed, _ := newEventsBackend(t, store)
+
+	blk := &types.BeaconBlock{RawBeaconBlock: &types.BeaconBlockDeneb{
+		BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+			Slot:            4,
+			ProposerIndex:   1,
+			ParentBlockRoot: common.Root{0x01},
+			StateRoot:       common.Root{0x02},
+		},
+		Body: &types.BeaconBlockBodyDeneb{
+			BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+				Eth1Data: &types.Eth1Data{},
+			},
+			ExecutionPayload: &types.ExecutableDataDeneb{
+				LogsBloom:    make([]byte, 256),
+				ExtraData:    []byte{},
+				Transactions: [][]byte{},
+				Withdrawals:  []*engineprimitives.Withdrawal{},
+			},
+			BlobKzgCommitments: []eip4844.KZGCommitment{},
+		},
+	}}
+	blkRoot, err := blk.HashTreeRoot()
+	require.NoError(t, err)
+
+	// The duty dependent roots are the block roots at the last slot of the
+	// previous epochs.
+	st := &mocks.StateDB{}
+	store.EXPECT().StateAtSlot(context.Background(), math.Slot(4)).
+		Return(st, nil)
+	st.EXPECT().GetBlockRootAtIndex(uint64(3)).Return(common.Root{0x03}, nil)
+	st.EXPECT().GetBlockRootAtIndex(uint64(0)).Return(common.Root{0x04}, nil)
+
+	ch, err := b.SubscribeEvents(context.Background(), []string{
+		serverTypes.EventTopicHead,
+		serverTypes.EventTopicBlock,
+		serverTypes.EventTopicFinalizedCheckpoint,
+	})
+	require.NoError(t, err)
+
+	// Events other than finalized blocks are not streamed.
+	require.NoError(t, blkFeed.Publish(context.Background(),
+		asynctypes.NewEvent(
+			context.Background(), events.BeaconBlockReceived, blk,
+		),
+	))
+	require.NoError(t, blkFeed.Publish(context.Background(),
+		asynctypes.NewEvent(
+			context.Background(), events.BeaconBlockFinalized, blk,
+		),
+	))
+
+	require.Equal(t, &serverTypes.Event{
+		Topic: serverTypes.EventTopicHead,
+		Data: &serverTypes.HeadEventData{
+			Slot:                      4,
+			Block:                     blkRoot,
+			State:                     common.Root{0x02},
+			EpochTransition:           true,
+			PreviousDutyDependentRoot: common.Root{0x04},
+			CurrentDutyDependentRoot:  common.Root{0x03},
+		},
+	}, receive(t, ch))
+	require.Equal(t, &serverTypes.Event{
+		Topic: serverTypes.EventTopicBlock,
+		Data: &serverTypes.BlockEventData{
+			Slot:  4,
+			Block: blkRoot,
+		},
+	}, receive(t, ch))
+	require.Equal(t, &serverTypes.Event{
+		Topic: serverTypes.EventTopicFinalizedCheckpoint,
+		Data: &serverTypes.FinalizedCheckpointEventData{
+			Block: blkRoot,
+			State: common.Root{0x02},
+			Epoch: 1,
+		},
+	}, receive(t, ch))
+}
+
+func TestSubscribeEventsBlobSidecar(t *testing.T) {
+	b, _, sidecarsFeed := newEventsBackend(
+		t, &mocks.StateStore[backend.StateDB]{},
+	)
+
+	header := (&types.BeaconBlockHeader{}).New(
+		3, 1, common.Root{0x01}, common.Root{0x02}, common.Root{0x03},
+	)
+	headerRoot, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	commitment := eip4844.KZGCommitment{0x04}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := b.SubscribeEvents(ctx, []string{
+		serverTypes.EventTopicBlobSidecar,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, sidecarsFeed.Publish(context.Background(),
+		asynctypes.NewEvent(
+			context.Background(),
+			events.BlobSidecarsProcessed,
+			&datypes.BlobSidecars{Sidecars: []*datypes.BlobSidecar{{
+				Index:             1,
+				KzgCommitment:     commitment,
+				BeaconBlockHeader: header,
+			}}},
+		),
+	))
+
+	require.Equal(t, &serverTypes.Event{
+		Topic: serverTypes.EventTopicBlobSidecar,
+		Data: &serverTypes.BlobSidecarEventData{
+			BlockRoot:     headerRoot,
+			Index:         1,
+			Slot:          3,
+			KzgCommitment: commitment,
+			VersionedHash: commitment.ToVersionedHash(),
+		},
+	}, receive(t, ch))
+
+	// Cancelling the subscription closes the stream.
+	cancel()
+	select {
+	case _, ok := <-ch:
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		require.FailNow(t, "events stream not closed")
+	}
+}
@@ -21,24 +21,130 @@
 package backend
 
 import (
-	"context"
-
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4881"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/stretchr/testify/mock"
 )
 
 func NewMockBackend() *Backend {
 	sdb := &mocks.StateDB{}
-	b := New(func(context.Context, string) StateDB {
-		return sdb
+	store := &mocks.StateStore[StateDB]{}
+	bs := &mocks.BlockStore[*types.BeaconBlock]{}
+	blobs := &mocks.BlobStore{}
+	deposits := &mocks.DepositStore{}
+	sp := &mocks.StateProcessor[StateDB]{}
+	builder := &mocks.Builder{}
+	pool := &mocks.OperationPool{}
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			SlotsPerEpoch:          32,
+			SlotsPerHistoricalRoot: 8,
+		},
+	)
+	b := New(Options{
+		ChainSpec:      cs,
+		StateStore:     store,
+		BlockStore:     bs,
+		BlobStore:      blobs,
+		DepositStore:   deposits,
+		StateProcessor: sp,
+		Builder:        builder,
+		OperationPool:  pool,
+		BlockFeed: broker.New[*asynctypes.Event[*types.BeaconBlock]](
+			"blk-broker",
+		),
+		SidecarsFeed: broker.New[*asynctypes.Event[*datypes.BlobSidecars]](
+			"blob-broker",
+		),
 	})
 	setReturnValues(sdb)
+	store.EXPECT().HeadState(mock.Anything).Return(sdb, nil)
+	store.EXPECT().StateAtSlot(mock.Anything, mock.Anything).Return(sdb, nil)
+	store.EXPECT().StateByRoot(mock.Anything, mock.Anything).Return(sdb, nil)
+	bs.EXPECT().GetBySlot(mock.Anything).Return(newMockBlock(), nil)
+	bs.EXPECT().GetByRoot(mock.Anything).Return(newMockBlock(), nil)
+	blobs.EXPECT().
+		GetBlobSidecarsByIndices(mock.Anything, mock.Anything).
+		Return(&datypes.BlobSidecars{
+			Sidecars: []*datypes.BlobSidecar{{
+				BeaconBlockHeader: newMockBlock().GetHeader(),
+				InclusionProof:    make([][32]byte, 8),
+			}},
+		}, nil)
+	deposits.EXPECT().
+		GetDepositSnapshot().
+		Return(&eip4881.DepositTreeSnapshot{
+			Finalized:    []common.Root{{0x01}},
+			DepositCount: 1,
+		}, nil)
+	sp.EXPECT().
+		ComputeBlockRewards(mock.Anything, mock.Anything).
+		Return(&transition.BlockRewards{
+			ProposerIndex:     1,
+			Total:             1,
+			ProposerSlashings: 1,
+		}, nil)
+	builder.EXPECT().
+		RegisterValidators(mock.Anything, mock.Anything).
+		Return(nil)
+	builder.EXPECT().
+		PublishBlindedBlock(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+	sp.EXPECT().
+		ValidateProposerSlashing(mock.Anything, mock.Anything).
+		Return(nil)
+	sp.EXPECT().
+		ValidateVoluntaryExit(mock.Anything, mock.Anything).
+		Return(nil)
+	pool.EXPECT().AddProposerSlashing(mock.Anything).Return()
+	pool.EXPECT().
+		ProposerSlashings().
+		Return([]*types.ProposerSlashing{})
+	pool.EXPECT().AddVoluntaryExit(mock.Anything).Return()
+	pool.EXPECT().
+		VoluntaryExits().
+		Return([]*types.SignedVoluntaryExit{{
+			Message: &types.VoluntaryExit{Epoch: 1, ValidatorIndex: 1},
+		}})
 	return b
 }
 
+func newMockBlock() *types.BeaconBlock {
+	return &types.BeaconBlock{RawBeaconBlock: &types.BeaconBlockDeneb{
+		BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+			Slot:            1,
+			ProposerIndex:   1,
+			ParentBlockRoot: common.Root{0x01},
+		},
+		Body: &types.BeaconBlockBodyDeneb{
+			BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+				Eth1Data: &types.Eth1Data{},
+			},
+			ExecutionPayload: &types.ExecutableDataDeneb{
+				LogsBloom:    make([]byte, 256),
+				ExtraData:    []byte{},
+				Transactions: [][]byte{},
+				Withdrawals:  []*engineprimitives.Withdrawal{},
+			},
+			BlobKzgCommitments: []eip4844.KZGCommitment{},
+		},
+	}}
+}
+
 func setReturnValues(sdb *mocks.StateDB) {
 	sdb.EXPECT().GetGenesisValidatorsRoot().Return(common.Root{0x01}, nil)
 	sdb.EXPECT().GetSlot().Return(1, nil)
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// GetProposerSlashings returns the proposer slashings waiting in the
+// operation pool to be included in a block.
+func (h Backend) GetProposerSlashings(
+	context.Context,
+) ([]*types.ProposerSlashing, error) {
+	if h.pool == nil {
+		return nil, ErrOperationPoolNotConfigured
+	}
+	return h.pool.ProposerSlashings(), nil
+}
+
+// SubmitProposerSlashing adds the proposer slashing to the operation pool,
+// from which it is included in the next block proposed by the node. The
+// slashing is rejected unless it is valid on top of the head state.
+func (h Backend) SubmitProposerSlashing(
+	ctx context.Context,
+	slashing *types.ProposerSlashing,
+) error {
+	if h.pool == nil {
+		return ErrOperationPoolNotConfigured
+	}
+	if slashing.IsNil() {
+		return errors.Wrap(ErrInvalidProposerSlashing, "missing header")
+	}
+	st, err := h.sdb.HeadState(ctx)
+	if err != nil {
+		return err
+	}
+	if err = h.sp.ValidateProposerSlashing(st, slashing); err != nil {
+		return errors.Join(ErrInvalidProposerSlashing, err)
+	}
+	h.pool.AddProposerSlashing(slashing)
+	return nil
+}
+
+// GetVoluntaryExits returns the voluntary exits waiting in the operation
+// pool to be included in a block.
+func (h Backend) GetVoluntaryExits(
+	context.Context,
+) ([]*types.SignedVoluntaryExit, error) {
+	if h.pool == nil {
+		return nil, ErrOperationPoolNotConfigured
+	}
+	return h.pool.VoluntaryExits(), nil
+}
+
+// SubmitVoluntaryExit adds the voluntary exit to the operation pool, from
+// which it is included in the next block proposed by the node. The exit is
+// rejected unless it is valid on top of the head state.
+func (h Backend) SubmitVoluntaryExit(
+	ctx context.Context,
+	exit *types.SignedVoluntaryExit,
+) error {
+	if h.pool == nil {
+		return ErrOperationPoolNotConfigured
+	}
+	if exit == nil || exit.Message == nil {
+		return errors.Wrap(ErrInvalidVoluntaryExit, "missing message")
+	}
+	st, err := h.sdb.HeadState(ctx)
+	if err != nil {
+		return err
+	}
+	if err = h.sp.ValidateVoluntaryExit(st, exit); err != nil {
+		return errors.Join(ErrInvalidVoluntaryExit, err)
+	}
+	h.pool.AddVoluntaryExit(exit)
+	return nil
+}
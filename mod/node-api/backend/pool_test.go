@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newPoolBackend returns a backend validating voluntary exits against the
+// returned head state.
+func newPoolBackend(t *testing.T) (
+	*backend.Backend,
+	*mocks.OperationPool,
+	*mocks.StateProcessor[backend.StateDB],
+	*mocks.StateDB,
+) {
+	t.Helper()
+	pool := mocks.NewOperationPool(t)
+	sp := mocks.NewStateProcessor[backend.StateDB](t)
+	store := &mocks.StateStore[backend.StateDB]{}
+	head := &mocks.StateDB{}
+	store.EXPECT().HeadState(mock.Anything).Return(head, nil)
+	return backend.New(backend.Options{
+		StateStore:     store,
+		StateProcessor: sp,
+		OperationPool:  pool,
+	}), pool, sp, head
+}
+
+func TestSubmitVoluntaryExit(t *testing.T) {
+	b, pool, sp, head := newPoolBackend(t)
+
+	exit := &types.SignedVoluntaryExit{
+		Message: &types.VoluntaryExit{Epoch: 1, ValidatorIndex: 2},
+	}
+	sp.EXPECT().ValidateVoluntaryExit(head, exit).Return(nil).Once()
+	pool.EXPECT().AddVoluntaryExit(exit).Return().Once()
+	require.NoError(t, b.SubmitVoluntaryExit(context.Background(), exit))
+
+	pool.EXPECT().
+		VoluntaryExits().
+		Return([]*types.SignedVoluntaryExit{exit}).
+		Once()
+	exits, err := b.GetVoluntaryExits(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []*types.SignedVoluntaryExit{exit}, exits)
+}
+
+func TestSubmitVoluntaryExit_Invalid(t *testing.T) {
+	b, _, sp, head := newPoolBackend(t)
+
+	// Exits that can not be included on top of the head state never reach
+	// the pool.
+	exit := &types.SignedVoluntaryExit{
+		Message: &types.VoluntaryExit{Epoch: 1, ValidatorIndex: 2},
+	}
+	errTooYoung := errors.New("validator too young to exit")
+	sp.EXPECT().ValidateVoluntaryExit(head, exit).Return(errTooYoung).Once()
+	err := b.SubmitVoluntaryExit(context.Background(), exit)
+	require.ErrorIs(t, err, backend.ErrInvalidVoluntaryExit)
+	require.ErrorIs(t, err, errTooYoung)
+
+	err = b.SubmitVoluntaryExit(
+		context.Background(), &types.SignedVoluntaryExit{},
+	)
+	require.ErrorIs(t, err, backend.ErrInvalidVoluntaryExit)
+}
+
+func TestSubmitProposerSlashing(t *testing.T) {
+	b, pool, sp, head := newPoolBackend(t)
+
+	slashing := types.NewProposerSlashing(
+		&types.SignedBeaconBlockHeader{Header: &types.BeaconBlockHeader{}},
+		&types.SignedBeaconBlockHeader{Header: &types.BeaconBlockHeader{}},
+	)
+	sp.EXPECT().ValidateProposerSlashing(head, slashing).Return(nil).Once()
+	pool.EXPECT().AddProposerSlashing(slashing).Return().Once()
+	require.NoError(t, b.SubmitProposerSlashing(
+		context.Background(), slashing,
+	))
+
+	errSignature := errors.New("invalid signature")
+	sp.EXPECT().
+		ValidateProposerSlashing(head, slashing).
+		Return(errSignature).
+		Once()
+	err := b.SubmitProposerSlashing(context.Background(), slashing)
+	require.ErrorIs(t, err, backend.ErrInvalidProposerSlashing)
+
+	err = b.SubmitProposerSlashing(
+		context.Background(), &types.ProposerSlashing{},
+	)
+	require.ErrorIs(t, err, backend.ErrInvalidProposerSlashing)
+
+	pool.EXPECT().
+		ProposerSlashings().
+		Return([]*types.ProposerSlashing{slashing}).
+		Once()
+	slashings, err := b.GetProposerSlashings(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []*types.ProposerSlashing{slashing}, slashings)
+}
+
+func TestOperationPoolNotConfigured(t *testing.T) {
+	b := backend.New(backend.Options{})
+
+	_, err := b.GetVoluntaryExits(context.Background())
+	require.ErrorIs(t, err, backend.ErrOperationPoolNotConfigured)
+	_, err = b.GetProposerSlashings(context.Background())
+	require.ErrorIs(t, err, backend.ErrOperationPoolNotConfigured)
+	err = b.SubmitVoluntaryExit(
+		context.Background(), &types.SignedVoluntaryExit{},
+	)
+	require.ErrorIs(t, err, backend.ErrOperationPoolNotConfigured)
+}
@@ -23,19 +23,45 @@ package backend
 import (
 	"context"
 
-	"github.com/berachain/beacon-kit/mod/node-api/server/types"
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
+// GetBlockRewards returns the rewards earned by the proposer of the block
+// with the given ID, computed by re-executing the block on top of its
+// pre-state.
 func (h Backend) GetBlockRewards(
-	_ context.Context,
-	_ string,
-) (*types.BlockRewardsData, error) {
-	return &types.BlockRewardsData{
-		ProposerIndex:     1,
-		Total:             1,
-		Attestations:      1,
-		SyncAggregate:     1,
-		ProposerSlashings: 1,
-		AttesterSlashings: 1,
+	ctx context.Context,
+	blockID string,
+) (*serverType.BlockRewardsData, error) {
+	blk, err := h.blockFromID(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The genesis block is not the result of a state transition, so its
+	// proposer earns no rewards.
+	rewards := &transition.BlockRewards{
+		ProposerIndex: blk.GetProposerIndex(),
+	}
+	if blk.GetSlot() > 0 {
+		// The pre-state of the block is the state at the preceding slot.
+		var preState StateDB
+		preState, err = h.sdb.StateAtSlot(ctx, blk.GetSlot()-1)
+		if err != nil {
+			return nil, err
+		}
+		if rewards, err = h.sp.ComputeBlockRewards(preState, blk); err != nil {
+			return nil, err
+		}
+	}
+
+	return &serverType.BlockRewardsData{
+		ProposerIndex:     rewards.ProposerIndex.Unwrap(),
+		Total:             rewards.Total.Unwrap(),
+		Attestations:      rewards.Attestations.Unwrap(),
+		SyncAggregate:     rewards.SyncAggregate.Unwrap(),
+		ProposerSlashings: rewards.ProposerSlashings.Unwrap(),
+		AttesterSlashings: rewards.AttesterSlashings.Unwrap(),
 	}, nil
 }
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	serverTypes "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newRewardsBackend returns a backend serving a block at the given slot.
+func newRewardsBackend(
+	t *testing.T,
+	slot math.Slot,
+	sp backend.StateProcessor[backend.StateDB],
+) (*backend.Backend, *mocks.StateStore[backend.StateDB]) {
+	t.Helper()
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		slot, 3, common.Root{0x01}, version.Deneb,
+	)
+	require.NoError(t, err)
+
+	store := &mocks.StateStore[backend.StateDB]{}
+	bs := &mocks.BlockStore[*types.BeaconBlock]{}
+	bs.EXPECT().GetBySlot(slot).Return(blk, nil)
+	return backend.New(backend.Options{
+		StateStore:     store,
+		BlockStore:     bs,
+		StateProcessor: sp,
+	}), store
+}
+
+func TestGetBlockRewards(t *testing.T) {
+	sp := &mocks.StateProcessor[backend.StateDB]{}
+	b, store := newRewardsBackend(t, 5, sp)
+
+	// The block is re-executed on top of the state at the preceding slot.
+	preState := &mocks.StateDB{}
+	store.EXPECT().
+		StateAtSlot(mock.Anything, math.Slot(4)).
+		Return(preState, nil).
+		Once()
+	sp.EXPECT().
+		ComputeBlockRewards(preState, mock.Anything).
+		Return(&transition.BlockRewards{
+			ProposerIndex:     3,
+			Total:             62_500_000,
+			ProposerSlashings: 62_500_000,
+		}, nil).
+		Once()
+
+	rewards, err := b.GetBlockRewards(context.Background(), "5")
+	require.NoError(t, err)
+	require.Equal(t, &serverTypes.BlockRewardsData{
+		ProposerIndex:     3,
+		Total:             62_500_000,
+		ProposerSlashings: 62_500_000,
+	}, rewards)
+	store.AssertExpectations(t)
+	sp.AssertExpectations(t)
+}
+
+func TestGetBlockRewardsGenesis(t *testing.T) {
+	sp := &mocks.StateProcessor[backend.StateDB]{}
+	b, store := newRewardsBackend(t, 0, sp)
+
+	rewards, err := b.GetBlockRewards(context.Background(), "genesis")
+	require.NoError(t, err)
+	require.Equal(t, &serverTypes.BlockRewardsData{ProposerIndex: 3}, rewards)
+	store.AssertNotCalled(t, "StateAtSlot", mock.Anything, mock.Anything)
+	sp.AssertNotCalled(t, "ComputeBlockRewards", mock.Anything, mock.Anything)
+}
+
+func TestGetBlockRewardsError(t *testing.T) {
+	errReplay := errors.New("replay failed")
+	sp := &mocks.StateProcessor[backend.StateDB]{}
+	b, store := newRewardsBackend(t, 5, sp)
+	store.EXPECT().
+		StateAtSlot(mock.Anything, mock.Anything).
+		Return(&mocks.StateDB{}, nil)
+	sp.EXPECT().
+		ComputeBlockRewards(mock.Anything, mock.Anything).
+		Return(nil, errReplay)
+
+	_, err := b.GetBlockRewards(context.Background(), "5")
+	require.ErrorIs(t, err, errReplay)
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// StateIDHead is the canonical head in the node's view.
+	StateIDHead = "head"
+	// StateIDGenesis is the genesis state.
+	StateIDGenesis = "genesis"
+	// StateIDFinalized is the latest finalized state.
+	StateIDFinalized = "finalized"
+	// StateIDJustified is the latest justified state.
+	StateIDJustified = "justified"
+)
+
+// stateFromID resolves the given state ID to the state it refers to. The
+// state ID is one of "head", "genesis", "finalized", "justified", a decimal
+// slot, or a 0x prefixed hex encoded state root.
+//
+// Blocks are final as soon as they are committed by CometBFT, so the
+// finalized and justified states are always the head state.
+func (h Backend) stateFromID(
+	ctx context.Context,
+	stateID string,
+) (StateDB, error) {
+	var (
+		st  StateDB
+		err error
+	)
+
+	switch {
+	case stateID == StateIDHead,
+		stateID == StateIDFinalized,
+		stateID == StateIDJustified:
+		st, err = h.sdb.HeadState(ctx)
+	case stateID == StateIDGenesis:
+		st, err = h.sdb.StateAtSlot(ctx, 0)
+	case strings.HasPrefix(stateID, "0x"):
+		var root common.Root
+		if err = root.UnmarshalText([]byte(stateID)); err != nil {
+			return nil, errors.Wrapf(ErrInvalidStateID, "%s", stateID)
+		}
+		st, err = h.sdb.StateByRoot(ctx, root)
+	default:
+		var slot uint64
+		if slot, err = strconv.ParseUint(stateID, 10, 64); err != nil {
+			return nil, errors.Wrapf(ErrInvalidStateID, "%s", stateID)
+		}
+		st, err = h.sdb.StateAtSlot(ctx, math.Slot(slot))
+	}
+	return st, err
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateIDResolution(t *testing.T) {
+	root := common.Root{0xab}
+	tests := []struct {
+		name    string
+		stateID string
+		expect  func(*mocks.StateStore[backend.StateDB], backend.StateDB)
+	}{
+		{
+			name:    "head",
+			stateID: "head",
+			expect: func(
+				store *mocks.StateStore[backend.StateDB], sdb backend.StateDB,
+			) {
+				store.EXPECT().HeadState(mock.Anything).Return(sdb, nil)
+			},
+		},
+		{
+			name:    "finalized",
+			stateID: "finalized",
+			expect: func(
+				store *mocks.StateStore[backend.StateDB], sdb backend.StateDB,
+			) {
+				store.EXPECT().HeadState(mock.Anything).Return(sdb, nil)
+			},
+		},
+		{
+			name:    "justified",
+			stateID: "justified",
+			expect: func(
+				store *mocks.StateStore[backend.StateDB], sdb backend.StateDB,
+			) {
+				store.EXPECT().HeadState(mock.Anything).Return(sdb, nil)
+			},
+		},
+		{
+			name:    "genesis",
+			stateID: "genesis",
+			expect: func(
+				store *mocks.StateStore[backend.StateDB], sdb backend.StateDB,
+			) {
+				store.EXPECT().
+					StateAtSlot(mock.Anything, math.Slot(0)).
+					Return(sdb, nil)
+			},
+		},
+		{
+			name:    "slot",
+			stateID: "12",
+			expect: func(
+				store *mocks.StateStore[backend.StateDB], sdb backend.StateDB,
+			) {
+				store.EXPECT().
+					StateAtSlot(mock.Anything, math.Slot(12)).
+					Return(sdb, nil)
+			},
+		},
+		{
+			name:    "state root",
+			stateID: root.String(),
+			expect: func(
+				store *mocks.StateStore[backend.StateDB], sdb backend.StateDB,
+			) {
+				store.EXPECT().StateByRoot(mock.Anything, root).Return(sdb, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fork := &types.Fork{Epoch: 7}
+			sdb := &mocks.StateDB{}
+			sdb.EXPECT().GetFork().Return(fork, nil)
+			store := &mocks.StateStore[backend.StateDB]{}
+			tt.expect(store, sdb)
+
+			b := backend.New(backend.Options{StateStore: store})
+			got, err := b.GetStateFork(context.Background(), tt.stateID)
+			require.NoError(t, err)
+			require.Equal(t, fork, got)
+			store.AssertExpectations(t)
+		})
+	}
+}
+
+func TestStateIDInvalid(t *testing.T) {
+	store := &mocks.StateStore[backend.StateDB]{}
+	b := backend.New(backend.Options{StateStore: store})
+
+	for _, stateID := range []string{"latest", "-1", "0x01", "0xzz"} {
+		_, err := b.GetStateFork(context.Background(), stateID)
+		require.ErrorIs(t, err, backend.ErrInvalidStateID, stateID)
+	}
+	store.AssertNotCalled(t, "HeadState", mock.Anything)
+}
+
+func TestStateIDNotFound(t *testing.T) {
+	store := &mocks.StateStore[backend.StateDB]{}
+	store.EXPECT().
+		StateAtSlot(mock.Anything, math.Slot(1000)).
+		Return(
+			(*mocks.StateDB)(nil),
+			errors.Wrap(backend.ErrStateNotFound, "slot: 1000"),
+		)
+	b := backend.New(backend.Options{StateStore: store})
+
+	_, err := b.GetStateFork(context.Background(), "1000")
+	require.ErrorIs(t, err, backend.ErrStateNotFound)
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	types "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	echo "github.com/labstack/echo/v4"
+)
+
+func (rh RouteHandlers) GetBlobSidecars(c echo.Context) error {
+	params, err := BindAndValidate[types.BlobSidecarRequest](c)
+	if err != nil {
+		return err
+	}
+	if params == nil {
+		return echo.ErrInternalServerError
+	}
+	sidecars, err := rh.Backend.GetBlobSidecars(
+		context.TODO(),
+		params.BlockID,
+		params.Indices,
+	)
+	if err != nil {
+		return err
+	}
+
+	if acceptsSSZ(c) {
+		// The sidecars are served as an SSZ list, that is the concatenation
+		// of the fixed size encodings of each sidecar.
+		var bz []byte
+		for _, sc := range sidecars.Sidecars {
+			if bz, err = sc.MarshalSSZTo(bz); err != nil {
+				return err
+			}
+		}
+		return c.Blob(http.StatusOK, echo.MIMEOctetStream, bz)
+	}
+
+	data := make([]*types.BlobSidecarData, 0, sidecars.Len())
+	for _, sc := range sidecars.Sidecars {
+		data = append(data, blobSidecarData(sc))
+	}
+	return c.JSON(http.StatusOK, WrapData(data))
+}
+
+// blobSidecarData builds the JSON representation of the given sidecar.
+func blobSidecarData(sc *datypes.BlobSidecar) *types.BlobSidecarData {
+	proof := make([]common.Root, 0, len(sc.InclusionProof))
+	for _, node := range sc.InclusionProof {
+		proof = append(proof, node)
+	}
+	header := sc.BeaconBlockHeader
+	return &types.BlobSidecarData{
+		Index:         sc.Index,
+		Blob:          sc.Blob,
+		KzgCommitment: sc.KzgCommitment,
+		KzgProof:      sc.KzgProof,
+		SignedBlockHeader: &types.BlockHeaderMessage{
+			Message: &types.BeaconBlockHeaderData{
+				Slot:          header.GetSlot().Unwrap(),
+				ProposerIndex: header.GetProposerIndex().Unwrap(),
+				ParentRoot:    header.GetParentBlockRoot(),
+				StateRoot:     header.GetStateRoot(),
+				BodyRoot:      header.BodyRoot,
+			},
+		},
+		InclusionProof: proof,
+	}
+}
@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	consensustypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	types "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	echo "github.com/labstack/echo/v4"
+)
+
+// ConsensusVersionHeader is the header carrying the fork version of the
+// returned block.
+const ConsensusVersionHeader = "Eth-Consensus-Version"
+
+func (rh RouteHandlers) GetBlock(c echo.Context) error {
+	params, err := BindAndValidate[types.BlockIDRequest](c)
+	if err != nil {
+		return err
+	}
+	if params == nil {
+		return echo.ErrInternalServerError
+	}
+	blk, err := rh.Backend.GetBlock(context.TODO(), params.BlockID)
+	if err != nil {
+		return err
+	}
+
+	forkName := forkVersionName(blk.Version())
+	c.Response().Header().Set(ConsensusVersionHeader, forkName)
+	if acceptsSSZ(c) {
+		var bz []byte
+		if bz, err = blk.MarshalSSZ(); err != nil {
+			return err
+		}
+		return c.Blob(http.StatusOK, echo.MIMEOctetStream, bz)
+	}
+	return c.JSON(http.StatusOK, types.BlockResponse{
+		Version:             forkName,
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           true,
+		Data:                blockData(blk),
+	})
+}
+
+func (rh RouteHandlers) GetBlockRoot(c echo.Context) error {
+	params, err := BindAndValidate[types.BlockIDRequest](c)
+	if err != nil {
+		return err
+	}
+	if params == nil {
+		return echo.ErrInternalServerError
+	}
+	root, err := rh.Backend.GetBlockRoot(context.TODO(), params.BlockID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, types.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           true,
+		Data:                types.RootData{Root: root},
+	})
+}
+
+func (rh RouteHandlers) GetBlockHeader(c echo.Context) error {
+	params, err := BindAndValidate[types.BlockIDRequest](c)
+	if err != nil {
+		return err
+	}
+	if params == nil {
+		return echo.ErrInternalServerError
+	}
+	header, err := rh.Backend.GetBlockHeader(context.TODO(), params.BlockID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, types.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           true,
+		Data:                header,
+	})
+}
+
+func (rh RouteHandlers) GetBlockHeaders(c echo.Context) error {
+	params, err := BindAndValidate[types.BeaconHeadersRequest](c)
+	if err != nil {
+		return err
+	}
+	if params == nil {
+		return echo.ErrInternalServerError
+	}
+	headers, err := rh.Backend.GetBlockHeaders(
+		context.TODO(),
+		params.Slot,
+		params.ParentRoot,
+	)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, types.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           true,
+		Data:                headers,
+	})
+}
+
+// PublishBlindedBlock submits the signed blinded block in the request body
+// to the relay of the node, which reveals its execution payload. The fork
+// of the block is given by the Eth-Consensus-Version header.
+func (rh RouteHandlers) PublishBlindedBlock(c echo.Context) error {
+	forkVersion, ok := forkVersionFromName(
+		c.Request().Header.Get(ConsensusVersionHeader),
+	)
+	if !ok {
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			"invalid "+ConsensusVersionHeader+" header",
+		)
+	}
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil || !json.Valid(body) {
+		return echo.ErrBadRequest
+	}
+	if err = rh.Backend.PublishBlindedBlock(
+		context.TODO(), forkVersion, body,
+	); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// acceptsSSZ reports whether the client asked for an SSZ encoded response.
+func acceptsSSZ(c echo.Context) bool {
+	return strings.Contains(
+		c.Request().Header.Get(echo.HeaderAccept),
+		echo.MIMEOctetStream,
+	)
+}
+
+// forkVersionName returns the lowercase name of the given fork version, as
+// used in the version field of the responses.
+func forkVersionName(forkVersion uint32) string {
+	switch forkVersion {
+	case version.Deneb:
+		return "deneb"
+	case version.Electra:
+		return "electra"
+	default:
+		return ""
+	}
+}
+
+// forkVersionFromName returns the fork version with the given lowercase
+// name, and false if the name is not one of a known fork.
+func forkVersionFromName(name string) (uint32, bool) {
+	switch name {
+	case "deneb":
+		return version.Deneb, true
+	case "electra":
+		return version.Electra, true
+	default:
+		return 0, false
+	}
+}
+
+// blockData builds the JSON representation of the given block.
+func blockData(blk *consensustypes.BeaconBlock) types.BlockData {
+	return types.BlockData{
+		Message: &types.BeaconBlockData{
+			Slot:          blk.GetSlot().Unwrap(),
+			ProposerIndex: blk.GetProposerIndex().Unwrap(),
+			ParentRoot:    blk.GetParentBlockRoot(),
+			StateRoot:     blk.GetStateRoot(),
+			Body:          blk.GetBody().RawBeaconBlockBody,
+		},
+	}
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	types "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	echo "github.com/labstack/echo/v4"
+)
+
+// GetEvents streams the events of the requested topics as server-sent
+// events until the client disconnects.
+func (rh RouteHandlers) GetEvents(c echo.Context) error {
+	params := &types.EventsRequest{}
+	if err := c.Bind(params); err != nil {
+		return echo.ErrBadRequest
+	}
+	// Topics may be given either as repeated or as comma separated values.
+	var topics []string
+	for _, topic := range params.Topics {
+		topics = append(topics, strings.Split(topic, ",")...)
+	}
+	params.Topics = topics
+	if err := c.Validate(params); err != nil {
+		return err
+	}
+
+	events, err := rh.Backend.SubscribeEvents(
+		c.Request().Context(),
+		params.Topics,
+	)
+	if err != nil {
+		return err
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	for event := range events {
+		data, marshalErr := json.Marshal(event.Data)
+		if marshalErr != nil {
+			c.Logger().Error(marshalErr)
+			continue
+		}
+		if _, err = fmt.Fprintf(
+			res, "event: %s\ndata: %s\n\n", event.Topic, data,
+		); err != nil {
+			// The client has gone away, the subscription is released once
+			// the request context is cancelled.
+			return nil
+		}
+		res.Flush()
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
 	"github.com/berachain/beacon-kit/mod/node-api/server/types"
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
@@ -54,9 +55,40 @@ func CustomHTTPErrorHandler(err error, c echo.Context) {
 	code := http.StatusInternalServerError
 	var message any = http.StatusText(code)
 	httpError := &echo.HTTPError{}
-	if errors.As(err, &httpError) {
+	switch {
+	case errors.As(err, &httpError):
 		code = httpError.Code
 		message = httpError.Message
+	case errors.Is(err, backend.ErrInvalidStateID):
+		code = http.StatusBadRequest
+		message = err.Error()
+	case errors.Is(err, backend.ErrStateNotFound):
+		code = http.StatusNotFound
+		message = err.Error()
+	case errors.Is(err, backend.ErrInvalidBlockID):
+		code = http.StatusBadRequest
+		message = err.Error()
+	case errors.Is(err, backend.ErrBlockNotFound):
+		code = http.StatusNotFound
+		message = err.Error()
+	case errors.Is(err, backend.ErrInvalidBlobIndex):
+		code = http.StatusBadRequest
+		message = err.Error()
+	case errors.Is(err, backend.ErrDepositSnapshotNotFound):
+		code = http.StatusNotFound
+		message = err.Error()
+	case errors.Is(err, backend.ErrBuilderNotConfigured):
+		code = http.StatusServiceUnavailable
+		message = err.Error()
+	case errors.Is(err, backend.ErrOperationPoolNotConfigured):
+		code = http.StatusServiceUnavailable
+		message = err.Error()
+	case errors.Is(err, backend.ErrInvalidProposerSlashing):
+		code = http.StatusBadRequest
+		message = err.Error()
+	case errors.Is(err, backend.ErrInvalidVoluntaryExit):
+		code = http.StatusBadRequest
+		message = err.Error()
 	}
 	c.Logger().Error(err)
 	response := &types.ErrorResponse{
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	echo "github.com/labstack/echo/v4"
+)
+
+// GetProposerSlashings returns the proposer slashings waiting in the
+// operation pool of the node.
+func (rh RouteHandlers) GetProposerSlashings(c echo.Context) error {
+	slashings, err := rh.Backend.GetProposerSlashings(context.TODO())
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, WrapData(slashings))
+}
+
+// SubmitProposerSlashing adds the proposer slashing in the request body to
+// the operation pool of the node, to be included in a block it proposes.
+func (rh RouteHandlers) SubmitProposerSlashing(c echo.Context) error {
+	slashing := new(types.ProposerSlashing)
+	if err := c.Bind(slashing); err != nil {
+		return echo.ErrBadRequest
+	}
+	if err := rh.Backend.SubmitProposerSlashing(
+		context.TODO(), slashing,
+	); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// GetVoluntaryExits returns the voluntary exits waiting in the operation pool
+// of the node.
+func (rh RouteHandlers) GetVoluntaryExits(c echo.Context) error {
+	exits, err := rh.Backend.GetVoluntaryExits(context.TODO())
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, WrapData(exits))
+}
+
+// SubmitVoluntaryExit adds the signed voluntary exit in the request body to
+// the operation pool of the node, to be included in a block it proposes.
+func (rh RouteHandlers) SubmitVoluntaryExit(c echo.Context) error {
+	exit := new(types.SignedVoluntaryExit)
+	if err := c.Bind(exit); err != nil {
+		return echo.ErrBadRequest
+	}
+	if err := rh.Backend.SubmitVoluntaryExit(
+		context.TODO(), exit,
+	); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusOK)
+}
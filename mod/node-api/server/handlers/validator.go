@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	echo "github.com/labstack/echo/v4"
+)
+
+// RegisterValidators registers the validators in the request body with the
+// relay of the node, so that external block builders know the fee
+// recipient and gas limit each of them expects.
+func (rh RouteHandlers) RegisterValidators(c echo.Context) error {
+	var registrations []*relay.SignedValidatorRegistration
+	if err := c.Bind(&registrations); err != nil {
+		return echo.ErrBadRequest
+	}
+	if err := rh.Backend.RegisterValidators(
+		context.TODO(), registrations,
+	); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusOK)
+}
@@ -32,7 +32,20 @@ type Handlers interface {
 	PostStateValidators(c echo.Context) error
 	GetStateValidatorBalances(c echo.Context) error
 	PostStateValidatorBalances(c echo.Context) error
+	GetBlock(c echo.Context) error
+	GetBlockRoot(c echo.Context) error
+	GetBlockHeader(c echo.Context) error
+	GetBlockHeaders(c echo.Context) error
+	GetBlobSidecars(c echo.Context) error
+	GetDepositSnapshot(c echo.Context) error
 	GetBlockRewards(c echo.Context) error
+	GetEvents(c echo.Context) error
+	PublishBlindedBlock(c echo.Context) error
+	RegisterValidators(c echo.Context) error
+	GetProposerSlashings(c echo.Context) error
+	SubmitProposerSlashing(c echo.Context) error
+	GetVoluntaryExits(c echo.Context) error
+	SubmitVoluntaryExit(c echo.Context) error
 }
 
 func UseMiddlewares(e *echo.Echo, middlewares ...echo.MiddlewareFunc) {
@@ -78,29 +91,29 @@ func assignBeaconRoutes(e *echo.Echo, h Handlers) {
 	e.GET("/eth/v1/beacon/states/:state_id/randao",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/headers",
-		h.NotImplemented)
+		h.GetBlockHeaders)
 	e.GET("/eth/v1/beacon/headers/:block_id",
-		h.NotImplemented)
+		h.GetBlockHeader)
 	e.POST("/eth/v1/beacon/blocks/blinded_blocks",
-		h.NotImplemented)
+		h.PublishBlindedBlock)
 	e.POST("/eth/v2/beacon/blocks/blinded_blocks",
-		h.NotImplemented)
+		h.PublishBlindedBlock)
 	e.POST("/eth/v1/beacon/blocks",
 		h.NotImplemented)
 	e.POST("/eth/v2/beacon/blocks",
 		h.NotImplemented)
 	e.GET("/eth/v2/beacon/blocks/:block_id",
-		h.NotImplemented)
+		h.GetBlock)
 	e.GET("/eth/v1/beacon/blocks/:block_id/root",
-		h.NotImplemented)
+		h.GetBlockRoot)
 	e.GET("/eth/v1/beacon/blocks/:block_id/attestations",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/blob_sidecars/:block_id",
-		h.NotImplemented)
+		h.GetBlobSidecars)
 	e.POST("/eth/v1/beacon/rewards/sync_committee/:block_id",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/deposit_snapshot",
-		h.NotImplemented)
+		h.GetDepositSnapshot)
 	e.POST("/eth/v1/beacon/rewards/attestation/:epoch",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/blinded_blocks/:block_id",
@@ -122,15 +135,15 @@ func assignBeaconRoutes(e *echo.Echo, h Handlers) {
 	e.POST("/eth/v1/beacon/pool/attester_slashings",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/pool/proposer_slashings",
-		h.NotImplemented)
+		h.GetProposerSlashings)
 	e.POST("/eth/v1/beacon/pool/proposer_slashings",
-		h.NotImplemented)
+		h.SubmitProposerSlashing)
 	e.POST("/eth/v1/beacon/pool/sync_committees",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/pool/voluntary_exits",
-		h.NotImplemented)
+		h.GetVoluntaryExits)
 	e.POST("/eth/v1/beacon/pool/voluntary_exits",
-		h.NotImplemented)
+		h.SubmitVoluntaryExit)
 	e.GET("/eth/v1/beacon/pool/bls_to_execution_changes",
 		h.NotImplemented)
 	e.POST("/eth/v1/beacon/pool/bls_to_execution_changes",
@@ -162,7 +175,7 @@ func assignDebugRoutes(e *echo.Echo, h Handlers) {
 
 func assignEventsRoutes(e *echo.Echo, h Handlers) {
 	e.GET("/eth/v1/events",
-		h.NotImplemented)
+		h.GetEvents)
 }
 
 func aasignNodeRoutes(e *echo.Echo, h Handlers) {
@@ -212,7 +225,7 @@ func assignValidatorRoutes(e *echo.Echo, h Handlers) {
 	e.POST("/eth/v1/validator/prepare_beacon_proposer",
 		h.NotImplemented)
 	e.POST("/eth/v1/validator/register_validator",
-		h.NotImplemented)
+		h.RegisterValidators)
 	e.POST("/eth/v1/validator/liveness/:epoch",
 		h.NotImplemented)
 }
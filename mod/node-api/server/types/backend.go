@@ -22,8 +22,13 @@ package types
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4881"
 )
 
 type BackendHandlers interface {
@@ -48,8 +53,60 @@ type BackendHandlers interface {
 		stateID string,
 		id []string,
 	) ([]*ValidatorBalanceData, error)
+	GetBlock(
+		ctx context.Context,
+		blockID string,
+	) (*types.BeaconBlock, error)
+	GetBlockRoot(
+		ctx context.Context,
+		blockID string,
+	) (common.Root, error)
+	GetBlockHeader(
+		ctx context.Context,
+		blockID string,
+	) (*BlockHeaderData, error)
+	GetBlockHeaders(
+		ctx context.Context,
+		slot string,
+		parentRoot string,
+	) ([]*BlockHeaderData, error)
+	GetBlobSidecars(
+		ctx context.Context,
+		blockID string,
+		indices []string,
+	) (*datypes.BlobSidecars, error)
+	GetDepositSnapshot(
+		ctx context.Context,
+	) (*eip4881.DepositTreeSnapshot, error)
 	GetBlockRewards(
 		ctx context.Context,
 		blockID string,
 	) (*BlockRewardsData, error)
+	PublishBlindedBlock(
+		ctx context.Context,
+		forkVersion uint32,
+		signedBlindedBlock json.RawMessage,
+	) error
+	RegisterValidators(
+		ctx context.Context,
+		registrations []*relay.SignedValidatorRegistration,
+	) error
+	GetProposerSlashings(
+		ctx context.Context,
+	) ([]*types.ProposerSlashing, error)
+	SubmitProposerSlashing(
+		ctx context.Context,
+		slashing *types.ProposerSlashing,
+	) error
+	GetVoluntaryExits(
+		ctx context.Context,
+	) ([]*types.SignedVoluntaryExit, error)
+	SubmitVoluntaryExit(
+		ctx context.Context,
+		exit *types.SignedVoluntaryExit,
+	) error
+	SubscribeEvents(
+		ctx context.Context,
+		topics []string,
+	) (<-chan *Event, error)
 }
@@ -23,6 +23,7 @@ package types
 import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 )
 
 type ErrorResponse struct {
@@ -77,3 +78,90 @@ type BlockRewardsData struct {
 	ProposerSlashings uint64 `json:"proposer_slashings,string"`
 	AttesterSlashings uint64 `json:"attester_slashings,string"`
 }
+
+type BlockResponse struct {
+	Version             string `json:"version"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+	Finalized           bool   `json:"finalized"`
+	Data                any    `json:"data"`
+}
+
+// BlockData wraps a beacon block. Blocks are authenticated by the CometBFT
+// commit rather than by a proposer signature, so none is included.
+type BlockData struct {
+	Message *BeaconBlockData `json:"message"`
+}
+
+type BeaconBlockData struct {
+	Slot          uint64      `json:"slot,string"`
+	ProposerIndex uint64      `json:"proposer_index,string"`
+	ParentRoot    common.Root `json:"parent_root"`
+	StateRoot     common.Root `json:"state_root"`
+	Body          any         `json:"body"`
+}
+
+type BlockHeaderData struct {
+	Root      common.Root         `json:"root"`
+	Canonical bool                `json:"canonical"`
+	Header    *BlockHeaderMessage `json:"header"`
+}
+
+// BlockHeaderMessage wraps a block header. As for blocks, no signature is
+// included.
+type BlockHeaderMessage struct {
+	Message *BeaconBlockHeaderData `json:"message"`
+}
+
+type BeaconBlockHeaderData struct {
+	Slot          uint64      `json:"slot,string"`
+	ProposerIndex uint64      `json:"proposer_index,string"`
+	ParentRoot    common.Root `json:"parent_root"`
+	StateRoot     common.Root `json:"state_root"`
+	BodyRoot      common.Root `json:"body_root"`
+}
+
+type BlobSidecarData struct {
+	Index             uint64                `json:"index,string"`
+	Blob              eip4844.Blob          `json:"blob"`
+	KzgCommitment     eip4844.KZGCommitment `json:"kzg_commitment"`
+	KzgProof          eip4844.KZGProof      `json:"kzg_proof"`
+	SignedBlockHeader *BlockHeaderMessage   `json:"signed_block_header"`
+	InclusionProof    []common.Root         `json:"kzg_commitment_inclusion_proof"`
+}
+
+// Event is a single event emitted on the events stream.
+type Event struct {
+	Topic string
+	Data  any
+}
+
+type HeadEventData struct {
+	Slot                      uint64      `json:"slot,string"`
+	Block                     common.Root `json:"block"`
+	State                     common.Root `json:"state"`
+	EpochTransition           bool        `json:"epoch_transition"`
+	PreviousDutyDependentRoot common.Root `json:"previous_duty_dependent_root"`
+	CurrentDutyDependentRoot  common.Root `json:"current_duty_dependent_root"`
+	ExecutionOptimistic       bool        `json:"execution_optimistic"`
+}
+
+type BlockEventData struct {
+	Slot                uint64      `json:"slot,string"`
+	Block               common.Root `json:"block"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+type BlobSidecarEventData struct {
+	BlockRoot     common.Root           `json:"block_root"`
+	Index         uint64                `json:"index,string"`
+	Slot          uint64                `json:"slot,string"`
+	KzgCommitment eip4844.KZGCommitment `json:"kzg_commitment"`
+	VersionedHash common.Bytes32        `json:"versioned_hash"`
+}
+
+type FinalizedCheckpointEventData struct {
+	Block               common.Root `json:"block"`
+	State               common.Root `json:"state"`
+	Epoch               uint64      `json:"epoch,string"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
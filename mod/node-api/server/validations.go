@@ -24,6 +24,7 @@ import (
 	"regexp"
 	"strconv"
 
+	"github.com/berachain/beacon-kit/mod/node-api/server/types"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -36,7 +37,9 @@ func ConstructValidator() *validator.Validate {
 		"epoch":            ValidateUint64,
 		"slot":             ValidateUint64,
 		"committee_index":  ValidateUint64,
+		"uint64":           ValidateUint64,
 		"hex":              ValidateHex,
+		"event_topic":      ValidateEventTopic,
 	}
 	validate := validator.New()
 	for tag, fn := range validators {
@@ -119,6 +122,18 @@ func ValidateValidatorStatus(fl validator.FieldLevel) bool {
 	return validateAllowedStrings(fl, allowedStatuses)
 }
 
+// ValidateEventTopic checks if the provided field is an events stream topic
+// supported by the node.
+func ValidateEventTopic(fl validator.FieldLevel) bool {
+	allowedTopics := map[string]bool{
+		types.EventTopicHead:                true,
+		types.EventTopicBlock:               true,
+		types.EventTopicBlobSidecar:         true,
+		types.EventTopicFinalizedCheckpoint: true,
+	}
+	return validateAllowedStrings(fl, allowedTopics)
+}
+
 func validateAllowedStrings(
 	fl validator.FieldLevel,
 	allowedValues map[string]bool,
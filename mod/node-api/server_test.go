@@ -27,8 +27,14 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/labstack/echo/v4"
 	middleware "github.com/labstack/echo/v4/middleware"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type testcase struct {
@@ -59,6 +65,65 @@ func TestEndpoints(t *testing.T) {
 	}
 }
 
+func TestGetBlockSSZ(t *testing.T) {
+	e := NewServer(middleware.DefaultCORSConfig,
+		middleware.DefaultLoggerConfig)
+
+	req := httptest.NewRequest("GET", "/eth/v2/beacon/blocks/head", nil)
+	req.Header.Set(echo.HeaderAccept, echo.MIMEOctetStream)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, echo.MIMEOctetStream,
+		rec.Header().Get(echo.HeaderContentType))
+	require.Equal(t, "deneb", rec.Header().Get("Eth-Consensus-Version"))
+	blk, err := (&types.BeaconBlock{}).NewFromSSZ(
+		rec.Body.Bytes(), version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(1), blk.GetSlot())
+}
+
+func TestGetBlobSidecarsSSZ(t *testing.T) {
+	e := NewServer(middleware.DefaultCORSConfig,
+		middleware.DefaultLoggerConfig)
+
+	req := httptest.NewRequest(
+		"GET", "/eth/v1/beacon/blob_sidecars/head?indices=0", nil,
+	)
+	req.Header.Set(echo.HeaderAccept, echo.MIMEOctetStream)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	sc := new(datypes.BlobSidecar)
+	require.NoError(t, sc.UnmarshalSSZ(rec.Body.Bytes()))
+	require.Equal(t, math.Slot(1), sc.BeaconBlockHeader.GetSlot())
+}
+
+func TestPublishBlindedBlock(t *testing.T) {
+	e := NewServer(middleware.DefaultCORSConfig,
+		middleware.DefaultLoggerConfig)
+
+	body := `{"message":{"slot":"1"},"signature":"0x00"}`
+	req := buildRequest("POST", "/eth/v2/beacon/blocks/blinded_blocks", &body)
+	req.Header.Set("Eth-Consensus-Version", "deneb")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// The body must be a JSON encoded block.
+	invalidBody := "not json"
+	req = buildRequest(
+		"POST", "/eth/v2/beacon/blocks/blinded_blocks", &invalidBody,
+	)
+	req.Header.Set("Eth-Consensus-Version", "deneb")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
 func buildRequest(method, endpoint string, body *string) *http.Request {
 	req := httptest.NewRequest(method, endpoint, nil)
 	if method != "GET" && body != nil {
@@ -84,6 +149,12 @@ func getTestcases() []testcase {
 			expectedStatus: http.StatusOK,
 			expectedBody:   "{\"execution_optimistic\":false,\"finalized\":false,\"data\":{\"data\":{\"root\":\"0x0100000000000000000000000000000000000000000000000000000000000000\"}}}\n",
 		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/beacon/states/0x01/root",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "{\"code\":400,\"message\":\"0x01: invalid state ID\"}\n",
+		},
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/states/:state_id/fork",
@@ -143,22 +214,24 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/headers",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"execution_optimistic\":false,\"finalized\":true,\"data\":[{\"root\":\"0xeb263bd8466fabfb15382c6078d62bd9009d23109f6ec26bdd7a0b78bb6bbf03\",\"canonical\":true,\"header\":{\"message\":{\"slot\":\"1\",\"proposer_index\":\"1\",\"parent_root\":\"0x0100000000000000000000000000000000000000000000000000000000000000\",\"state_root\":\"0x0000000000000000000000000000000000000000000000000000000000000000\",\"body_root\":\"0x390e507b42d26f4782c13d9958c32323a063dfc8d2938207a6743d09a2381cc8\"}}}]}\n",
 		},
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/headers/:block_id",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"execution_optimistic\":false,\"finalized\":true,\"data\":{\"root\":\"0xeb263bd8466fabfb15382c6078d62bd9009d23109f6ec26bdd7a0b78bb6bbf03\",\"canonical\":true,\"header\":{\"message\":{\"slot\":\"1\",\"proposer_index\":\"1\",\"parent_root\":\"0x0100000000000000000000000000000000000000000000000000000000000000\",\"state_root\":\"0x0000000000000000000000000000000000000000000000000000000000000000\",\"body_root\":\"0x390e507b42d26f4782c13d9958c32323a063dfc8d2938207a6743d09a2381cc8\"}}}}\n",
 		},
 		{
 			method:         "POST",
 			endpoint:       "/eth/v1/beacon/blocks/blinded_blocks",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			method:         "POST",
 			endpoint:       "/eth/v2/beacon/blocks/blinded_blocks",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			method:         "POST",
@@ -173,12 +246,13 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v2/beacon/blocks/:block_id",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
 		},
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/blocks/:block_id/root",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"execution_optimistic\":false,\"finalized\":true,\"data\":{\"root\":\"0xeb263bd8466fabfb15382c6078d62bd9009d23109f6ec26bdd7a0b78bb6bbf03\"}}\n",
 		},
 		{
 			method:         "GET",
@@ -188,7 +262,13 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/blob_sidecars/:block_id",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/beacon/blob_sidecars/:block_id?indices=x",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "{\"code\":400,\"message\":\"code=400, message=Invalid Indices[0]: x\"}\n",
 		},
 		{
 			method:         "POST",
@@ -198,13 +278,14 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/deposit_snapshot",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"data\":{\"finalized\":[\"0x0100000000000000000000000000000000000000000000000000000000000000\"],\"deposit_root\":\"0x0000000000000000000000000000000000000000000000000000000000000000\",\"deposit_count\":\"1\",\"execution_block_hash\":\"0x0000000000000000000000000000000000000000000000000000000000000000\",\"execution_block_height\":\"0\"}}\n",
 		},
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/rewards/blocks/:block_id",
 			expectedStatus: http.StatusOK,
-			expectedBody:   "{\"execution_optimistic\":false,\"finalized\":false,\"data\":{\"proposer_index\":\"1\",\"total\":\"1\",\"attestations\":\"1\",\"sync_aggregate\":\"1\",\"proposer_slashings\":\"1\",\"attester_slashings\":\"1\"}}\n",
+			expectedBody:   "{\"execution_optimistic\":false,\"finalized\":false,\"data\":{\"proposer_index\":\"1\",\"total\":\"1\",\"attestations\":\"0\",\"sync_aggregate\":\"0\",\"proposer_slashings\":\"1\",\"attester_slashings\":\"0\"}}\n",
 		},
 		{
 			method:         "POST",
@@ -259,12 +340,14 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/pool/proposer_slashings",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
 		},
 		{
-			method:         "POST",
-			endpoint:       "/eth/v1/beacon/pool/proposer_slashings",
-			expectedStatus: http.StatusNotImplemented,
+			method:   "POST",
+			endpoint: "/eth/v1/beacon/pool/proposer_slashings",
+			body: `{"signed_header_1":{"message":{}},` +
+				`"signed_header_2":{"message":{}}}`,
+			expectedStatus: http.StatusOK,
 		},
 		{
 			method:         "POST",
@@ -274,12 +357,14 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/pool/voluntary_exits",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
 		},
 		{
-			method:         "POST",
-			endpoint:       "/eth/v1/beacon/pool/voluntary_exits",
-			expectedStatus: http.StatusNotImplemented,
+			method:   "POST",
+			endpoint: "/eth/v1/beacon/pool/voluntary_exits",
+			body: `{"message":{"epoch":"0x1","validatorIndex":"0x1"},` +
+				`"signature":"0x` + strings.Repeat("00", 96) + `"}`,
+			expectedStatus: http.StatusOK,
 		},
 		{
 			method:         "GET",
@@ -329,7 +414,8 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/events?topics=head&topics=proposer_slashing",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "{\"code\":400,\"message\":\"Invalid Topics[1]: proposer_slashing\"}\n",
 		},
 		{
 			method:         "GET",
@@ -439,7 +525,8 @@ func getTestcases() []testcase {
 		{
 			method:         "POST",
 			endpoint:       "/eth/v1/validator/register_validator",
-			expectedStatus: http.StatusNotImplemented,
+			body:           "[]",
+			expectedStatus: http.StatusOK,
 		},
 		{
 			method:         "POST",
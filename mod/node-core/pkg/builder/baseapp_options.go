@@ -21,6 +21,7 @@
 package builder
 
 import (
+	pruningtypes "cosmossdk.io/store/pruning/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -40,6 +41,27 @@ func WithCometParamStore(
 	}
 }
 
+// historicalStatesPruningInterval is the interval, in blocks, at which states
+// that are no longer retained are pruned.
+const historicalStatesPruningInterval = 10
+
+// WithHistoricalStatesRetention bounds the number of committed heights, and
+// hence historical beacon states, kept by the multistore. A value of zero
+// leaves the pruning options of the application untouched.
+func WithHistoricalStatesRetention(
+	historicalStates uint64,
+) func(bApp *baseapp.BaseApp) {
+	if historicalStates == 0 {
+		return func(*baseapp.BaseApp) {}
+	}
+	return baseapp.SetPruning(
+		pruningtypes.NewCustomPruningOptions(
+			// The multistore always keeps at least two recent heights.
+			max(historicalStates, 2), historicalStatesPruningInterval,
+		),
+	)
+}
+
 // WithPrepareProposal sets the prepare proposal handler to the baseapp.
 func WithPrepareProposal(
 	handler sdk.PrepareProposalHandler,
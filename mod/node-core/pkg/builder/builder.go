@@ -27,6 +27,7 @@ import (
 	"cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/app"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
@@ -83,6 +84,8 @@ func (nb *NodeBuilder[NodeT]) Build(
 		appBuilder      *runtime.AppBuilder
 		abciMiddleware  *components.ABCIMiddleware
 		serviceRegistry *service.Registry
+		cfg             *config.Config
+		historicalStore *components.HistoricalStateStore
 	)
 
 	// build all node components using depinject
@@ -104,6 +107,8 @@ func (nb *NodeBuilder[NodeT]) Build(
 		&chainSpec,
 		&abciMiddleware,
 		&serviceRegistry,
+		&cfg,
+		&historicalStore,
 	); err != nil {
 		panic(err)
 	}
@@ -114,18 +119,19 @@ func (nb *NodeBuilder[NodeT]) Build(
 	)
 
 	// set the application to a new BeaconApp with necessary ABCI handlers
-	nb.node.RegisterApp(
-		app.NewBeaconKitApp(
-			db, traceStore, true, appBuilder,
-			append(
-				server.DefaultBaseappOptions(appOpts),
-				WithCometParamStore(chainSpec),
-				WithPrepareProposal(consensusEngine.PrepareProposal),
-				WithProcessProposal(consensusEngine.ProcessProposal),
-				WithPreBlocker(consensusEngine.PreBlock),
-			)...,
-		),
+	beaconApp := app.NewBeaconKitApp(
+		db, traceStore, true, appBuilder,
+		append(
+			server.DefaultBaseappOptions(appOpts),
+			WithHistoricalStatesRetention(cfg.Storage.HistoricalStates),
+			WithCometParamStore(chainSpec),
+			WithPrepareProposal(consensusEngine.PrepareProposal),
+			WithProcessProposal(consensusEngine.ProcessProposal),
+			WithPreBlocker(consensusEngine.PreBlock),
+		)...,
 	)
+	historicalStore.SetQueryContextFn(beaconApp.CreateQueryContext)
+	nb.node.RegisterApp(beaconApp)
 	nb.node.SetServiceRegistry(serviceRegistry)
 
 	// TODO: put this in some post node creation hook/listener.
@@ -21,19 +21,22 @@
 package components
 
 import (
-	"errors"
 	"os"
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/async/pkg/bus"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/afero"
 	"github.com/spf13/cast"
 )
 
@@ -43,6 +46,7 @@ type AvailabilityStoreInput struct {
 	depinject.In
 	AppOpts   servertypes.AppOptions
 	ChainSpec common.ChainSpec
+	Config    *config.Config
 	Logger    log.Logger
 }
 
@@ -52,6 +56,16 @@ func ProvideAvailibilityStore[
 ](
 	in AvailabilityStoreInput,
 ) (*dastore.Store[BeaconBlockBodyT], error) {
+	var opts []dastore.Option
+	if in.Config.Storage.BlobArchive {
+		opts = append(opts, dastore.WithArchive())
+	}
+	if dir := in.Config.Storage.BlobColdStorageDir; dir != "" {
+		opts = append(opts, dastore.WithColdStorage(
+			dastore.NewColdStorage(afero.NewOsFs(), dir),
+		))
+	}
+
 	return dastore.New[BeaconBlockBodyT](
 		filedb.NewRangeDB(
 			filedb.NewDB(
@@ -67,6 +81,7 @@ func ProvideAvailibilityStore[
 		),
 		in.Logger.With("service", "beacon-kit.da.store"),
 		in.ChainSpec,
+		opts...,
 	), nil
 }
 
@@ -75,8 +90,9 @@ func ProvideAvailibilityStore[
 type AvailabilityPrunerInput struct {
 	depinject.In
 	AvailabilityStore *AvailabilityStore
-	BlockBroker       *BlockBroker
 	ChainSpec         common.ChainSpec
+	Config            *config.Config
+	EventBus          *EventBus
 	Logger            log.Logger
 }
 
@@ -84,32 +100,37 @@ type AvailabilityPrunerInput struct {
 // framework.
 func ProvideAvailabilityPruner(
 	in AvailabilityPrunerInput,
-) (pruner.Pruner[*filedb.RangeDB], error) {
-	rangeDB, ok := in.AvailabilityStore.IndexDB.(*filedb.RangeDB)
-	if !ok {
-		in.Logger.Error("availability store does not have a range db")
-		return nil, errors.New("availability store does not have a range db")
-	}
-
-	subCh, err := in.BlockBroker.Subscribe()
+) (pruner.Pruner[*AvailabilityStore], error) {
+	subCh, err := bus.SubscribeDurable[*BeaconBlock](
+		in.EventBus, manager.AvailabilityPrunerName, events.BeaconBlockFinalized,
+	)
 	if err != nil {
 		in.Logger.Error("failed to subscribe to block feed", "err", err)
 		return nil, err
 	}
 
-	// build the availability pruner if IndexDB is available.
+	// Sidecars are kept within the data availability window, or for the
+	// retention period in archive mode.
+	pruneRangeFn := dastore.BuildPruneRangeFn[
+		*BeaconBlock,
+		*BlockEvent,
+	](in.ChainSpec)
+	if in.Config.Storage.BlobArchive {
+		pruneRangeFn = dastore.BuildArchivePruneRangeFn[
+			*BeaconBlock,
+			*BlockEvent,
+		](in.ChainSpec, in.Config.Storage.BlobRetentionEpochs)
+	}
+
 	return pruner.NewPruner[
 		*BeaconBlock,
 		*BlockEvent,
-		*filedb.RangeDB,
+		*AvailabilityStore,
 	](
 		in.Logger.With("service", manager.AvailabilityPrunerName),
-		rangeDB,
+		in.AvailabilityStore,
 		manager.AvailabilityPrunerName,
 		subCh,
-		dastore.BuildPruneRangeFn[
-			*BeaconBlock,
-			*BlockEvent,
-		](in.ChainSpec),
+		pruneRangeFn,
 	), nil
 }
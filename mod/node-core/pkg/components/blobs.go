@@ -23,8 +23,7 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
-	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
-	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/async/pkg/bus"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
@@ -33,6 +32,7 @@ import (
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 	"github.com/spf13/cast"
@@ -92,7 +92,7 @@ type DAServiceIn struct {
 	depinject.In
 
 	AvailabilityStore *dastore.Store[*BeaconBlockBody]
-	SidecarsBroker    *SidecarsBroker
+	EventBus          *EventBus
 	BlobProcessor     *dablob.Processor[
 		*dastore.Store[*BeaconBlockBody],
 		*BeaconBlockBody,
@@ -102,23 +102,28 @@ type DAServiceIn struct {
 
 // ProvideDAService is a function that provides the BlobService to the
 // depinject framework.
-func ProvideDAService(in DAServiceIn) *da.Service[
-	*dastore.Store[*BeaconBlockBody],
-	*BeaconBlockBody,
-	*BlobSidecars,
-	*broker.Broker[*asynctypes.Event[*BlobSidecars]],
-	*ExecutionPayload,
-] {
+func ProvideDAService(in DAServiceIn) (*DAService, error) {
+	// The DA service handles the sidecars it is requested to process, and
+	// publishes the outcome.
+	sidecarsFeed, err := bus.NewFeed[*BlobSidecars](
+		in.EventBus,
+		events.BlobSidecarsProcessRequest,
+		events.BlobSidecarsReceived,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return da.NewService[
 		*dastore.Store[*BeaconBlockBody],
 		*BeaconBlockBody,
 		*BlobSidecars,
-		*broker.Broker[*asynctypes.Event[*BlobSidecars]],
+		*SidecarsFeed,
 		*ExecutionPayload,
 	](
 		in.AvailabilityStore,
 		in.BlobProcessor,
-		in.SidecarsBroker,
+		sidecarsFeed,
 		in.Logger.With("service", "da"),
-	)
+	), nil
 }
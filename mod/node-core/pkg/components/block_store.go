@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"os"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/async/pkg/bus"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// BlockStoreInput is the input for the ProvideBlockStore function for the
+// depinject framework.
+type BlockStoreInput struct {
+	depinject.In
+	AppOpts   servertypes.AppOptions
+	ChainSpec common.ChainSpec
+	Logger    log.Logger
+}
+
+// ProvideBlockStore provides the block store.
+func ProvideBlockStore(
+	in BlockStoreInput,
+) *BlockStore {
+	return block.NewStore[*BeaconBlock](
+		filedb.NewDB(
+			filedb.WithRootDirectory(
+				cast.ToString(
+					in.AppOpts.Get(flags.FlagHome),
+				)+"/data/blocks",
+			),
+			filedb.WithFileExtension("ssz"),
+			filedb.WithDirectoryPermissions(os.ModePerm),
+			filedb.WithLogger(in.Logger),
+		),
+		in.ChainSpec,
+	)
+}
+
+// BlockStoreServiceInput is the input for the ProvideBlockStoreService
+// function for the depinject framework.
+type BlockStoreServiceInput struct {
+	depinject.In
+	BlockStore *BlockStore
+	EventBus   *EventBus
+	Logger     log.Logger
+}
+
+// ProvideBlockStoreService provides the service persisting finalized blocks
+// in the block store.
+func ProvideBlockStoreService(
+	in BlockStoreServiceInput,
+) (*BlockStoreService, error) {
+	subCh, err := bus.SubscribeDurable[*BeaconBlock](
+		in.EventBus, "block-store", events.BeaconBlockFinalized,
+	)
+	if err != nil {
+		in.Logger.Error("failed to subscribe to block feed", "err", err)
+		return nil, err
+	}
+
+	return block.NewService[*BeaconBlock, *BlockEvent](
+		in.Logger.With("service", "block-store"),
+		in.BlockStore,
+		subCh,
+	), nil
+}
+
+// BlockPrunerInput is the input for the ProvideBlockPruner function for the
+// depinject framework.
+type BlockPrunerInput struct {
+	depinject.In
+	BlockStore *BlockStore
+	Config     *config.Config
+	EventBus   *EventBus
+	Logger     log.Logger
+}
+
+// ProvideBlockPruner provides a block pruner for the depinject framework.
+func ProvideBlockPruner(
+	in BlockPrunerInput,
+) (pruner.Pruner[*BlockStore], error) {
+	subCh, err := bus.SubscribeDurable[*BeaconBlock](
+		in.EventBus, manager.BlockPrunerName, events.BeaconBlockFinalized,
+	)
+	if err != nil {
+		in.Logger.Error("failed to subscribe to block feed", "err", err)
+		return nil, err
+	}
+
+	return pruner.NewPruner[
+		*BeaconBlock,
+		*BlockEvent,
+		*BlockStore,
+	](
+		in.Logger.With("service", manager.BlockPrunerName),
+		in.BlockStore,
+		manager.BlockPrunerName,
+		subCh,
+		block.BuildPruneRangeFn[
+			*BeaconBlock,
+			*BlockEvent,
+		](in.Config.Storage.HistoricalBlocks),
+	), nil
+}
@@ -21,47 +21,157 @@
 package components
 
 import (
+	"encoding/binary"
+	"errors"
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/async/pkg/bus"
+	"github.com/berachain/beacon-kit/mod/async/pkg/journal"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/service"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
 )
 
-// ProvideBlobFeed provides a blob feed for the depinject framework.
-func ProvideBlobFeed() *SidecarsBroker {
-	return broker.New[*SidecarEvent](
-		"blob-broker",
+// versionLength is the length of the fork version prefixing the journaled
+// blocks.
+const versionLength = 4
+
+// EventBusInput is the input for the event bus provider.
+type EventBusInput struct {
+	depinject.In
+	AppOpts       servertypes.AppOptions
+	Config        *config.Config
+	TelemetrySink *metrics.TelemetrySink
+}
+
+// ProvideEventBus provides the event bus of the node, with a topic for each
+// payload type exchanged between the middleware and the services. When the
+// event journal is enabled, the finalized blocks are journaled so that they
+// are replayed to the services that did not handle them before a restart.
+func ProvideEventBus(in EventBusInput) (*EventBus, error) {
+	b := bus.New(broker.WithTelemetrySink(in.TelemetrySink))
+	if err := errors.Join(
+		bus.Register[*Genesis](
+			b, "genesis-broker",
+			events.GenesisDataProcessRequest,
+		),
+		registerBlockTopic(b, in),
+		bus.Register[*BlobSidecars](
+			b, "blob-broker",
+			events.BlobSidecarsBuilt,
+			events.BlobSidecarsReceived,
+			events.BlobSidecarsProcessRequest,
+			events.BlobSidecarsProcessed,
+		),
+		bus.Register[math.Slot](
+			b, "slot-broker",
+			events.NewSlot,
+			events.MissedSlot,
+		),
+		bus.Register[*service.StatusEvent](
+			b, "status-broker",
+			events.ServiceStatusUpdated,
+		),
+		bus.Register[transition.ValidatorUpdates](
+			b, "validator-updates-broker",
+			events.ValidatorSetUpdated,
+		),
+	); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// registerBlockTopic registers the topic of the beacon blocks, journaling
+// the finalized blocks if the event journal is enabled.
+func registerBlockTopic(b *EventBus, in EventBusInput) error {
+	eventIDs := []asynctypes.EventID{
+		events.BeaconBlockBuilt,
+		events.BeaconBlockReceived,
+		events.BeaconBlockVerified,
+		events.BeaconBlockRejected,
+		events.BeaconBlockFinalizedRequest,
+		events.BeaconBlockFinalized,
+	}
+	if !in.Config.Storage.EventJournal {
+		return bus.Register[*BeaconBlock](b, "blk-broker", eventIDs...)
+	}
+
+	j, err := journal.New(filepath.Join(
+		cast.ToString(in.AppOpts.Get(flags.FlagHome)), "data", "events",
+	))
+	if err != nil {
+		return err
+	}
+	return bus.RegisterDurable[*BeaconBlock](
+		b, "blk-broker", j, blockCodec{},
+		[]asynctypes.EventID{events.BeaconBlockFinalized},
+		eventIDs...,
 	)
 }
 
-// ProvideBlockFeed provides a block feed for the depinject framework.
-func ProvideBlockFeed() *BlockBroker {
-	return broker.New[*BlockEvent](
-		"blk-broker",
+// blockCodec encodes the journaled beacon blocks as their fork version
+// followed by their SSZ encoding.
+type blockCodec struct{}
+
+// Marshal encodes the beacon block.
+func (blockCodec) Marshal(blk *BeaconBlock) ([]byte, error) {
+	bz, err := blk.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, versionLength, versionLength+len(bz))
+	binary.BigEndian.PutUint32(out, blk.Version())
+	return append(out, bz...), nil
+}
+
+// Unmarshal decodes the beacon block.
+func (blockCodec) Unmarshal(bz []byte) (*BeaconBlock, error) {
+	if len(bz) < versionLength {
+		return nil, journal.ErrCorruptedEntry
+	}
+	return (&BeaconBlock{}).NewFromSSZ(
+		bz[versionLength:], binary.BigEndian.Uint32(bz),
 	)
 }
 
+// FeedInput is the input for the feeds of the event bus.
+type FeedInput struct {
+	depinject.In
+	EventBus *EventBus
+}
+
 // ProvideGenesisBroker provides a genesis feed for the depinject framework.
-func ProvideGenesisBroker() *GenesisBroker {
-	return broker.New[*GenesisEvent](
-		"genesis-broker",
-	)
+func ProvideGenesisBroker(in FeedInput) (*GenesisBroker, error) {
+	return bus.Topic[*Genesis](in.EventBus, events.GenesisDataProcessRequest)
 }
 
 // ProvideSlotBroker provides a slot feed for the depinject framework.
-func ProvideSlotBroker() *SlotBroker {
-	return broker.New[*SlotEvent](
-		"slot-broker",
-	)
+func ProvideSlotBroker(in FeedInput) (*SlotBroker, error) {
+	return bus.Topic[math.Slot](in.EventBus, events.NewSlot)
 }
 
 // ProvideStatusBroker provides a status feed.
-func ProvideStatusBroker() *broker.Broker[*StatusEvent] {
-	return broker.New[*StatusEvent](
-		"status-broker",
+func ProvideStatusBroker(in FeedInput) (*StatusBroker, error) {
+	return bus.Topic[*service.StatusEvent](
+		in.EventBus, events.ServiceStatusUpdated,
 	)
 }
 
 // ProvideValidatorUpdateBroker provides a validator updates feed.
-func ProvideValidatorUpdateBroker() *ValidatorUpdateBroker {
-	return broker.New[*ValidatorUpdateEvent](
-		"validator-updates-broker",
+func ProvideValidatorUpdateBroker(
+	in FeedInput,
+) (*ValidatorUpdateBroker, error) {
+	return bus.Topic[transition.ValidatorUpdates](
+		in.EventBus, events.ValidatorSetUpdated,
 	)
 }
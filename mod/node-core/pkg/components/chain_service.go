@@ -23,28 +23,30 @@ package components
 import (
 	"cosmossdk.io/core/log"
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/async/pkg/bus"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
 	"github.com/berachain/beacon-kit/mod/config"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 )
 
 // ChainServiceInput is the input for the chain service provider.
 type ChainServiceInput struct {
 	depinject.In
-	BlockBroker           *BlockBroker
 	ChainSpec             common.ChainSpec
 	Cfg                   *config.Config
 	DepositService        *DepositService
 	EngineClient          *EngineClient
+	EventBus              *EventBus
 	ExecutionEngine       *ExecutionEngine
 	GenesisBrocker        *GenesisBroker
 	LocalBuilder          *LocalBuilder
 	Logger                log.Logger
 	Signer                crypto.BLSSigner
-	StateProcessor        StateProcessor
+	StateProcessor        *StateProcessor
 	StorageBackend        StorageBackend
 	TelemetrySink         *metrics.TelemetrySink
 	ValidatorUpdateBroker *ValidatorUpdateBroker
@@ -53,7 +55,18 @@ type ChainServiceInput struct {
 // ProvideChainService is a depinject provider for the blockchain service.
 func ProvideChainService(
 	in ChainServiceInput,
-) *ChainService {
+) (*ChainService, error) {
+	// The chain service handles the blocks it is requested to process, and
+	// publishes the outcome.
+	blkFeed, err := bus.NewFeed[*BeaconBlock](
+		in.EventBus,
+		events.BeaconBlockReceived,
+		events.BeaconBlockFinalizedRequest,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return blockchain.NewService[
 		*AvailabilityStore,
 		*BeaconBlock,
@@ -62,6 +75,7 @@ func ProvideChainService(
 		BeaconState,
 		*BlobSidecars,
 		*Deposit,
+		*DepositStore,
 		*ExecutionPayload,
 		*ExecutionPayloadHeader,
 		*Genesis,
@@ -76,9 +90,9 @@ func ProvideChainService(
 		in.StateProcessor,
 		in.TelemetrySink,
 		in.GenesisBrocker,
-		in.BlockBroker,
+		blkFeed,
 		in.ValidatorUpdateBroker,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
 		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
-	)
+	), nil
 }
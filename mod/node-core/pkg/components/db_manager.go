@@ -23,7 +23,6 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
-	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
@@ -31,7 +30,8 @@ import (
 // DBManagerInput is the input for the dep inject framework.
 type DBManagerInput struct {
 	depinject.In
-	AvailabilityPruner pruner.Pruner[*filedb.RangeDB]
+	AvailabilityPruner pruner.Pruner[*AvailabilityStore]
+	BlockPruner        pruner.Pruner[*BlockStore]
 	DepositPruner      pruner.Pruner[*DepositStore]
 	Logger             log.Logger
 }
@@ -47,5 +47,6 @@ func ProvideDBManager(
 		in.Logger.With("service", "db-manager"),
 		in.DepositPruner,
 		in.AvailabilityPruner,
+		in.BlockPruner,
 	)
 }
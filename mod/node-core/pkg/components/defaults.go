@@ -36,8 +36,9 @@ func DefaultComponentsWithStandardTypes() []any {
 		ProvideAvailabilityPruner,
 		ProvideAvailibilityStore[*BeaconBlockBody],
 		ProvideBlsSigner,
-		ProvideBlobFeed,
-		ProvideBlockFeed,
+		ProvideBlockPruner,
+		ProvideBlockStore,
+		ProvideBlockStoreService,
 		ProvideBlobProcessor[*BeaconBlockBody],
 		ProvideBlobProofVerifier,
 		ProvideChainService,
@@ -56,6 +57,7 @@ func DefaultComponentsWithStandardTypes() []any {
 			*ExecutionPayload,
 			*engineprimitives.PayloadAttributes[*Withdrawal],
 		],
+		ProvideEventBus,
 		ProvideExecutionEngine[
 			*ExecutionPayload,
 			*engineprimitives.PayloadAttributes[*Withdrawal],
@@ -63,8 +65,10 @@ func DefaultComponentsWithStandardTypes() []any {
 			*Withdrawal,
 		],
 		ProvideGenesisBroker,
+		ProvideHistoricalStateStore,
 		ProvideJWTSecret,
 		ProvideLocalBuilder,
+		ProvideOperationPool,
 		ProvideServiceRegistry,
 		ProvideStateProcessor,
 		ProvideSlotBroker,
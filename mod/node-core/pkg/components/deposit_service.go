@@ -25,10 +25,12 @@ import (
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/async/pkg/bus"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -38,18 +40,21 @@ type DepositServiceIn struct {
 	BeaconDepositContract *deposit.WrappedBeaconDepositContract[
 		*Deposit, types.WithdrawalCredentials,
 	]
-	BlockBroker   *BlockBroker
-	ChainSpec     common.ChainSpec
-	DepositStore  *DepositStore
-	EngineClient  *EngineClient
-	Logger        log.Logger
-	TelemetrySink *metrics.TelemetrySink
+	ChainSpec            common.ChainSpec
+	DepositStore         *DepositStore
+	EngineClient         *EngineClient
+	EventBus             *EventBus
+	HistoricalStateStore *HistoricalStateStore
+	Logger               log.Logger
+	TelemetrySink        *metrics.TelemetrySink
 }
 
 // ProvideDepositService provides the deposit service to the depinject
 // framework.
 func ProvideDepositService(in DepositServiceIn) (*DepositService, error) {
-	blkSub, err := in.BlockBroker.Subscribe()
+	blkSub, err := bus.SubscribeDurable[*BeaconBlock](
+		in.EventBus, "deposit-handler", events.BeaconBlockFinalized,
+	)
 	if err != nil {
 		in.Logger.Error("failed to subscribe to block feed", "err", err)
 		return nil, errors.New("failed to subscribe to block feed")
@@ -62,11 +67,16 @@ func ProvideDepositService(in DepositServiceIn) (*DepositService, error) {
 		*BlockEvent,
 		*DepositStore,
 		*ExecutionPayload,
+		types.WithdrawalCredentials,
+		*Deposit,
+		BeaconState,
+		*types.Eth1Data,
 	](
 		in.Logger.With("service", "deposit"),
 		math.U64(in.ChainSpec.Eth1FollowDistance()),
 		in.TelemetrySink,
 		in.DepositStore,
+		in.HistoricalStateStore,
 		in.BeaconDepositContract,
 		blkSub,
 	), nil
@@ -24,10 +24,12 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/async/pkg/bus"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
@@ -48,7 +50,7 @@ func ProvideDepositStore[
 	DepositT interface {
 		constraints.SSZMarshallable
 		GetIndex() uint64
-		HashTreeRoot() ([32]byte, error)
+		GetDataRoot() (common.Root, error)
 	},
 ](
 	in DepositStoreInput,
@@ -64,15 +66,15 @@ func ProvideDepositStore[
 		&depositstore.KVStoreProvider{
 			KVStoreWithBatch: kvp,
 		},
-	), nil
+	)
 }
 
 // DepositPrunerInput is the input for the deposit pruner.
 type DepositPrunerInput struct {
 	depinject.In
-	BlockBroker  *BlockBroker
 	ChainSpec    common.ChainSpec
 	DepositStore *DepositStore
+	EventBus     *EventBus
 	Logger       log.Logger
 }
 
@@ -80,7 +82,9 @@ type DepositPrunerInput struct {
 func ProvideDepositPruner(
 	in DepositPrunerInput,
 ) (pruner.Pruner[*DepositStore], error) {
-	subCh, err := in.BlockBroker.Subscribe()
+	subCh, err := bus.SubscribeDurable[*BeaconBlock](
+		in.EventBus, manager.DepositPrunerName, events.BeaconBlockFinalized,
+	)
 	if err != nil {
 		in.Logger.Error("failed to subscribe to block feed", "err", err)
 		return nil, err
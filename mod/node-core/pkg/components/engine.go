@@ -83,7 +83,10 @@ type ExecutionEngineInputs[
 	WithdrawalT any,
 ] struct {
 	depinject.In
+	ChainSpec     common.ChainSpec
+	Config        *config.Config
 	EngineClient  *client.EngineClient[ExecutionPayloadT, PayloadAttributesT]
+	JWTSecret     *jwt.Secret `optional:"true"`
 	Logger        log.Logger
 	StatusBroker  *StatusBroker
 	TelemetrySink *metrics.TelemetrySink
@@ -108,6 +111,20 @@ func ProvideExecutionEngine[
 	ExecutionPayloadT, PayloadAttributesT,
 	PayloadIDT, WithdrawalT,
 ] {
+	// Only dial a shadow execution client if one is configured.
+	var shadowClient *client.EngineClient[
+		ExecutionPayloadT, PayloadAttributesT,
+	]
+	if cfg := in.Config.GetEngine().ShadowConfig(); cfg != nil {
+		shadowClient = client.New[ExecutionPayloadT, PayloadAttributesT](
+			cfg,
+			in.Logger.With("service", "engine.shadow-client"),
+			in.JWTSecret,
+			in.TelemetrySink,
+			new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
+		)
+	}
+
 	return engine.New[
 		ExecutionPayloadT,
 		PayloadAttributesT,
@@ -118,5 +135,6 @@ func ProvideExecutionEngine[
 		in.Logger.With("service", "execution-engine"),
 		in.StatusBroker,
 		in.TelemetrySink,
+		shadowClient,
 	)
 }
@@ -22,24 +22,23 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/async/pkg/bus"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/middleware"
 )
 
 // ABCIMiddlewareInput is the input for the validator middleware provider.
 type ABCIMiddlewareInput struct {
 	depinject.In
-	BeaconBlockFeed       *BlockBroker
-	ChainService          *ChainService
-	ChainSpec             common.ChainSpec
-	GenesisBroker         *GenesisBroker
-	Logger                log.Logger[any]
-	SidecarsFeed          *SidecarsBroker
-	SlotBroker            *SlotBroker
-	TelemetrySink         *metrics.TelemetrySink
-	ValidatorUpdateBroker *ValidatorUpdateBroker
+	ChainService  *ChainService
+	ChainSpec     common.ChainSpec
+	EventBus      *EventBus
+	Logger        log.Logger[any]
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideABCIMiddleware is a depinject provider for the validator
@@ -47,7 +46,9 @@ type ABCIMiddlewareInput struct {
 func ProvideABCIMiddleware(
 	in ABCIMiddlewareInput,
 ) (*ABCIMiddleware, error) {
-	validatorUpdatesSub, err := in.ValidatorUpdateBroker.Subscribe()
+	validatorUpdatesSub, err := bus.Subscribe[transition.ValidatorUpdates](
+		in.EventBus, events.ValidatorSetUpdated,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +61,7 @@ func ProvideABCIMiddleware(
 		in.ChainService,
 		in.Logger,
 		in.TelemetrySink,
-		in.GenesisBroker,
-		in.BeaconBlockFeed,
-		in.SidecarsFeed,
-		in.SlotBroker,
+		in.EventBus,
 		validatorUpdatesSub,
 	), nil
 }
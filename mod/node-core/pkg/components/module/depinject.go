@@ -43,7 +43,8 @@ func init() {
 // ModuleInput is the input for the dep inject framework.
 type ModuleInput struct {
 	depinject.In
-	ABCIMiddleware *components.ABCIMiddleware
+	ABCIMiddleware       *components.ABCIMiddleware
+	HistoricalStateStore *components.HistoricalStateStore
 }
 
 // ModuleOutput is the output for the dep inject framework.
@@ -57,6 +58,7 @@ func ProvideModule(in ModuleInput) (ModuleOutput, error) {
 	return ModuleOutput{
 		Module: NewAppModule(
 			in.ABCIMiddleware,
+			in.HistoricalStateStore,
 		),
 	}, nil
 }
@@ -64,5 +66,6 @@ func ProvideModule(in ModuleInput) (ModuleOutput, error) {
 func SupplyModuleDependencies() []any {
 	return []any{
 		&components.ABCIMiddleware{},
+		&components.HistoricalStateStore{},
 	}
 }
@@ -49,15 +49,18 @@ var (
 // AppModule implements an application module for the beacon module.
 // It is a wrapper around the ABCIMiddleware.
 type AppModule struct {
-	ABCIMiddleware *components.ABCIMiddleware
+	ABCIMiddleware       *components.ABCIMiddleware
+	HistoricalStateStore *components.HistoricalStateStore
 }
 
 // NewAppModule creates a new AppModule object.
 func NewAppModule(
 	abciMiddleware *components.ABCIMiddleware,
+	historicalStateStore *components.HistoricalStateStore,
 ) AppModule {
 	return AppModule{
-		ABCIMiddleware: abciMiddleware,
+		ABCIMiddleware:       abciMiddleware,
+		HistoricalStateStore: historicalStateStore,
 	}
 }
 
@@ -112,14 +115,18 @@ func (am AppModule) ExportGenesis(
 }
 
 // InitGenesis initializes the beacon module's state from a provided genesis
-// state.
+// state, and keeps the resulting beacon state as the genesis state.
 func (am AppModule) InitGenesis(
 	ctx context.Context,
 	bz json.RawMessage,
 ) ([]appmodule.ValidatorUpdate, error) {
-	return cometbft.NewConsensusEngine[appmodule.ValidatorUpdate](
+	updates, err := cometbft.NewConsensusEngine[appmodule.ValidatorUpdate](
 		am.ABCIMiddleware,
 	).InitGenesis(ctx, bz)
+	if err != nil {
+		return nil, err
+	}
+	return updates, am.HistoricalStateStore.StoreGenesisState(ctx)
 }
 
 // EndBlock returns the validator set updates from the beacon state.
@@ -24,28 +24,66 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
 )
 
 // LocalBuilderInput is an input for the dep inject framework.
 type LocalBuilderInput struct {
 	depinject.In
+	AppOpts           servertypes.AppOptions
 	AttributesFactory *AttributesFactory
 	Cfg               *config.Config
 	ChainSpec         common.ChainSpec
 	ExecutionEngine   *ExecutionEngine
 	Logger            log.Logger
+	Signer            crypto.BLSSigner
+	TelemetrySink     *metrics.TelemetrySink
 }
 
 // ProvideLocalBuilder provides a local payload builder for the
 // depinject framework.
 func ProvideLocalBuilder(
 	in LocalBuilderInput,
-) *LocalBuilder {
+) (*LocalBuilder, error) {
+	// Only source payloads from external block builders if a relay is
+	// configured.
+	var r payloadbuilder.Relay[*ExecutionPayload, *ExecutionPayloadHeader]
+	if in.Cfg.PayloadBuilder.RelayURL != "" {
+		// Builders sign their bids over the builder domain, which is
+		// bound to the genesis fork and not to the chain.
+		builderDomain, err := types.NewForkData(
+			version.FromUint32[common.Version](
+				in.ChainSpec.ActiveForkVersionForEpoch(0),
+			), common.Root{},
+		).ComputeDomain(in.ChainSpec.DomainTypeApplicationMask())
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := relay.New[*ExecutionPayload, *ExecutionPayloadHeader](
+			in.Cfg.PayloadBuilder.RelayURL,
+			in.Cfg.PayloadBuilder.RelayTimeout,
+			builderDomain,
+			in.ChainSpec.MaxBlobCommitmentsPerBlock(),
+			in.Signer.VerifySignature,
+		)
+		if err != nil {
+			return nil, err
+		}
+		r = client
+	}
+
 	return payloadbuilder.New[
 		BeaconState, *ExecutionPayload, *ExecutionPayloadHeader,
 	](
@@ -58,5 +96,10 @@ func ProvideLocalBuilder(
 			[32]byte, math.Slot,
 		](),
 		in.AttributesFactory,
-	)
+		r,
+		// The payload must be retrieved within the CometBFT propose
+		// timeout for the proposal not to be missed.
+		cast.ToDuration(in.AppOpts.Get("consensus.timeout_propose")),
+		in.TelemetrySink,
+	), nil
 }
@@ -23,8 +23,11 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/async/pkg/bus"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	primservice "github.com/berachain/beacon-kit/mod/primitives/pkg/service"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
 	sdkversion "github.com/cosmos/cosmos-sdk/version"
 )
@@ -32,44 +35,75 @@ import (
 // ServiceRegistryInput is the input for the service registry provider.
 type ServiceRegistryInput struct {
 	depinject.In
-	ABCIService           *ABCIMiddleware
-	BlockBroker           *BlockBroker
-	ChainService          *ChainService
-	DBManager             *DBManager
-	DAService             *DAService
-	DepositService        *DepositService
-	EngineClient          *EngineClient
-	GenesisBroker         *GenesisBroker
-	Logger                log.Logger
-	SidecarsBroker        *SidecarsBroker
-	SlotBroker            *SlotBroker
-	TelemetrySink         *metrics.TelemetrySink
-	ValidatorService      *ValidatorService
-	ValidatorUpdateBroker *ValidatorUpdateBroker
+	ABCIService       *ABCIMiddleware
+	BlockStoreService *BlockStoreService
+	ChainService      *ChainService
+	DBManager         *DBManager
+	DAService         *DAService
+	DepositService    *DepositService
+	EngineClient      *EngineClient
+	EventBus          *EventBus
+	ExecutionEngine   *ExecutionEngine
+	Logger            log.Logger
+	StatusBroker      *StatusBroker
+	TelemetrySink     *metrics.TelemetrySink
+	ValidatorService  *ValidatorService
 }
 
 // ProvideServiceRegistry is the depinject provider for the service registry.
+// Every service is started after, and stopped before, the services it
+// depends on. The services running background loops are restarted once a
+// loop dies.
 func ProvideServiceRegistry(
 	in ServiceRegistryInput,
-) *service.Registry {
+) (*service.Registry, error) {
+	statusFeed, err := bus.Subscribe[*primservice.StatusEvent](
+		in.EventBus, events.ServiceStatusUpdated,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return service.NewRegistry(
 		service.WithLogger(in.Logger),
-		service.WithService(in.ValidatorService),
-		service.WithService(in.ChainService),
-		service.WithService(in.DAService),
-		service.WithService(in.DepositService),
-		service.WithService(in.ABCIService),
+		service.WithStatusFeed(statusFeed),
+		service.WithStatusPublisher(in.StatusBroker),
+		service.WithTelemetrySink(in.TelemetrySink),
+		service.WithService(in.EventBus),
+		service.WithService(in.EngineClient),
+		service.WithService(in.ExecutionEngine, in.EngineClient),
+		service.WithService(in.DBManager, in.EventBus),
+		service.WithService(
+			in.ValidatorService,
+			in.EventBus, in.EngineClient, in.DBManager,
+		),
+		service.WithService(
+			in.ChainService,
+			in.EventBus, in.EngineClient, in.DBManager,
+		),
+		service.WithService(in.DAService, in.EventBus),
+		service.WithService(
+			in.DepositService,
+			in.EventBus, in.EngineClient, in.DBManager,
+		),
+		service.WithService(
+			in.ABCIService,
+			in.EventBus, in.ChainService, in.DAService, in.ValidatorService,
+		),
 		service.WithService(version.NewReportingService(
 			in.Logger.With("service", "reporting"),
 			in.TelemetrySink,
 			sdkversion.Version,
 		)),
-		service.WithService(in.DBManager),
-		service.WithService(in.GenesisBroker),
-		service.WithService(in.BlockBroker),
-		service.WithService(in.SlotBroker),
-		service.WithService(in.SidecarsBroker),
-		service.WithService(in.ValidatorUpdateBroker),
-		service.WithService(in.EngineClient),
-	)
+		service.WithService(in.BlockStoreService, in.EventBus),
+		service.WithRestartPolicy(
+			in.EngineClient, service.DefaultRestartPolicy(),
+		),
+		service.WithRestartPolicy(
+			in.DBManager, service.DefaultRestartPolicy(),
+		),
+		service.WithRestartPolicy(
+			in.DepositService, service.DefaultRestartPolicy(),
+		),
+	), nil
 }
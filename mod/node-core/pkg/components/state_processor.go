@@ -42,7 +42,7 @@ type StateProcessorInput struct {
 // framework.
 func ProvideStateProcessor(
 	in StateProcessorInput,
-) StateProcessor {
+) *StateProcessor {
 	return core.NewStateProcessor[
 		*BeaconBlock,
 		*BeaconBlockBody,
@@ -56,7 +56,9 @@ func ProvideStateProcessor(
 		*ExecutionPayloadHeader,
 		*types.Fork,
 		*types.ForkData,
+		*types.ProposerSlashing,
 		*types.Validator,
+		*types.SignedVoluntaryExit,
 		*Withdrawal,
 		types.WithdrawalCredentials,
 	](
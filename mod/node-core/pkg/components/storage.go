@@ -22,12 +22,18 @@ package components
 
 import (
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
+	storev2 "cosmossdk.io/store/v2/db"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
 )
 
 // StorageBackendInput is the input for the ProvideStorageBackend function.
@@ -58,6 +64,36 @@ func ProvideStorageBackend(
 	)
 }
 
+// HistoricalStateStoreInput is the input for the ProvideHistoricalStateStore
+// function.
+type HistoricalStateStoreInput struct {
+	depinject.In
+	AppOpts   servertypes.AppOptions
+	ChainSpec common.ChainSpec
+	KVStore   *KVStore
+}
+
+// ProvideHistoricalStateStore is the depinject provider that returns a store
+// serving beacon states committed at past heights.
+func ProvideHistoricalStateStore(
+	in HistoricalStateStoreInput,
+) (*HistoricalStateStore, error) {
+	name := "genesis_state"
+	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+	kvp, err := storev2.NewDB(storev2.DBTypePebbleDB, name, dir, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.NewHistoricalStateStore[
+		BeaconState, *BeaconStateMarshallable,
+	](
+		in.ChainSpec,
+		in.KVStore,
+		newKVStore(&depositstore.KVStoreProvider{KVStoreWithBatch: kvp}),
+	), nil
+}
+
 // KVStoreInput is the input for the ProvideKVStore function.
 type KVStoreInput struct {
 	depinject.In
@@ -68,6 +104,11 @@ type KVStoreInput struct {
 func ProvideKVStore(
 	in KVStoreInput,
 ) *KVStore {
+	return newKVStore(in.Environment.KVStoreService)
+}
+
+// newKVStore returns a beacon KV store over the given store service.
+func newKVStore(kss store.KVStoreService) *KVStore {
 	payloadCodec := &encoding.
 		SSZInterfaceCodec[*ExecutionPayloadHeader]{}
 	return beacondb.New[
@@ -76,5 +117,5 @@ func ProvideKVStore(
 		*ExecutionPayloadHeader,
 		*types.Fork,
 		*types.Validator,
-	](in.Environment.KVStoreService, payloadCodec)
+	](kss, payloadCodec)
 }
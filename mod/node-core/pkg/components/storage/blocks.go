@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package storage
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+)
+
+// APIBlockStore serves the blocks of a block store to the node API. Blocks
+// missing from the block store are reported with an error wrapping
+// backend.ErrBlockNotFound, which the API answers with a 404.
+type APIBlockStore[BeaconBlockT block.BeaconBlock[BeaconBlockT]] struct {
+	bs *block.Store[BeaconBlockT]
+}
+
+// NewAPIBlockStore creates a new API block store on top of the given block
+// store.
+func NewAPIBlockStore[BeaconBlockT block.BeaconBlock[BeaconBlockT]](
+	bs *block.Store[BeaconBlockT],
+) *APIBlockStore[BeaconBlockT] {
+	return &APIBlockStore[BeaconBlockT]{bs: bs}
+}
+
+// GetBySlot returns the block at the given slot.
+func (s *APIBlockStore[BeaconBlockT]) GetBySlot(
+	slot math.Slot,
+) (BeaconBlockT, error) {
+	blk, err := s.bs.GetBySlot(slot)
+	if errors.Is(err, block.ErrBlockNotFound) {
+		return blk, errors.Wrapf(backend.ErrBlockNotFound, "slot: %d", slot)
+	}
+	return blk, err
+}
+
+// GetByRoot returns the block with the given block root.
+func (s *APIBlockStore[BeaconBlockT]) GetByRoot(
+	root common.Root,
+) (BeaconBlockT, error) {
+	blk, err := s.bs.GetByRoot(root)
+	if errors.Is(err, block.ErrBlockNotFound) {
+		return blk, errors.Wrapf(backend.ErrBlockNotFound, "root: %s", root)
+	}
+	return blk, err
+}
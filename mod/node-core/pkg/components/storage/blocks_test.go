@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package storage_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestBlockBackend returns a node API backend serving the blocks of the
+// returned block store.
+func newTestBlockBackend(
+	t *testing.T,
+) (*backend.Backend, *block.Store[*types.BeaconBlock]) {
+	t.Helper()
+	bs := block.NewStore[*types.BeaconBlock](
+		filedb.NewDB(
+			filedb.WithRootDirectory(t.TempDir()),
+			filedb.WithFileExtension("ssz"),
+			filedb.WithDirectoryPermissions(0700),
+			filedb.WithLogger(log.NewNopLogger()),
+		),
+		spec.TestnetChainSpec(),
+	)
+	return backend.New(backend.Options{
+		BlockStore: storage.NewAPIBlockStore(bs),
+	}), bs
+}
+
+// setBlock persists a block at the given slot and returns its root.
+func setBlock(
+	t *testing.T,
+	bs *block.Store[*types.BeaconBlock],
+	slot math.Slot,
+	parentRoot common.Root,
+) common.Root {
+	t.Helper()
+	blk := &types.BeaconBlock{RawBeaconBlock: &types.BeaconBlockDeneb{
+		BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+			Slot:            slot.Unwrap(),
+			ParentBlockRoot: parentRoot,
+		},
+		Body: &types.BeaconBlockBodyDeneb{
+			BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+				Eth1Data: &types.Eth1Data{},
+			},
+			ExecutionPayload: &types.ExecutableDataDeneb{
+				LogsBloom:    make([]byte, 256),
+				ExtraData:    []byte{},
+				Transactions: [][]byte{},
+				Withdrawals:  []*engineprimitives.Withdrawal{},
+			},
+			BlobKzgCommitments: []eip4844.KZGCommitment{},
+		},
+	}}
+	require.NoError(t, bs.Set(blk))
+	root, err := blk.HashTreeRoot()
+	require.NoError(t, err)
+	return root
+}
+
+func TestAPIBlockStore_BlockNotFound(t *testing.T) {
+	ctx := context.Background()
+	b, bs := newTestBlockBackend(t)
+	root := setBlock(t, bs, 1, common.Root{})
+
+	_, err := b.GetBlock(ctx, "2")
+	require.ErrorIs(t, err, backend.ErrBlockNotFound)
+
+	_, err = b.GetBlock(ctx, common.Root{0x01}.String())
+	require.ErrorIs(t, err, backend.ErrBlockNotFound)
+
+	blk, err := b.GetBlock(ctx, root.String())
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(1), blk.GetSlot())
+}
+
+func TestAPIBlockStore_HeadersByParentRoot(t *testing.T) {
+	ctx := context.Background()
+	b, bs := newTestBlockBackend(t)
+	parent := setBlock(t, bs, 1, common.Root{})
+
+	// The child of the latest block is not known yet.
+	headers, err := b.GetBlockHeaders(ctx, "", parent.String())
+	require.NoError(t, err)
+	require.Empty(t, headers)
+
+	headers, err = b.GetBlockHeaders(ctx, "", common.Root{0x01}.String())
+	require.NoError(t, err)
+	require.Empty(t, headers)
+
+	child := setBlock(t, bs, 2, parent)
+	headers, err = b.GetBlockHeaders(ctx, "", parent.String())
+	require.NoError(t, err)
+	require.Len(t, headers, 1)
+	require.Equal(t, child, headers[0].Root)
+}
@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// ErrQueryContextNotSet is returned when historical states are requested
+	// before the application has been built.
+	ErrQueryContextNotSet = errors.New("query context function not set")
+
+	// ErrStateNotRetained is returned when the state at the requested slot
+	// is not, or no longer, available in the multistore.
+	ErrStateNotRetained = errors.Wrap(
+		backend.ErrStateNotFound, "state not retained",
+	)
+
+	// ErrStateRootNotFound is returned when no retained state matches the
+	// requested state root.
+	ErrStateRootNotFound = errors.Wrap(
+		backend.ErrStateNotFound, "state root not found",
+	)
+)
+
+// QueryContextFn opens a read-only context over the multistore as it was
+// committed at the given height. A height of zero refers to the latest
+// committed height.
+type QueryContextFn func(height int64, prove bool) (sdk.Context, error)
+
+// HistoricalStateStore serves beacon states committed at past heights. Since
+// a slot is finalized at the CometBFT height of the same number, the state
+// for a slot is read from the multistore at that height. The genesis state,
+// which is committed together with the first block, is kept in a store of
+// its own.
+type HistoricalStateStore[
+	BeaconStateT core.BeaconState[
+		*types.BeaconBlockHeader, *types.Eth1Data,
+		*types.ExecutionPayloadHeader, *types.Fork,
+		*types.Validator, *engineprimitives.Withdrawal,
+	],
+	BeaconStateMarshallableT state.BeaconStateMarshallable[
+		BeaconStateMarshallableT, *types.BeaconBlockHeader, *types.Eth1Data,
+		*types.ExecutionPayloadHeader, *types.Fork, *types.Validator,
+	],
+] struct {
+	cs         common.ChainSpec
+	bs         *KVStore
+	genesis    *KVStore
+	queryCtxFn QueryContextFn
+}
+
+// NewHistoricalStateStore creates a new historical state store. The query
+// context function must be set with SetQueryContextFn once the application
+// has been built.
+func NewHistoricalStateStore[
+	BeaconStateT core.BeaconState[
+		*types.BeaconBlockHeader, *types.Eth1Data,
+		*types.ExecutionPayloadHeader, *types.Fork,
+		*types.Validator, *engineprimitives.Withdrawal,
+	],
+	BeaconStateMarshallableT state.BeaconStateMarshallable[
+		BeaconStateMarshallableT, *types.BeaconBlockHeader, *types.Eth1Data,
+		*types.ExecutionPayloadHeader, *types.Fork, *types.Validator,
+	],
+](
+	cs common.ChainSpec,
+	bs *KVStore,
+	genesis *KVStore,
+) *HistoricalStateStore[BeaconStateT, BeaconStateMarshallableT] {
+	return &HistoricalStateStore[BeaconStateT, BeaconStateMarshallableT]{
+		cs:      cs,
+		bs:      bs,
+		genesis: genesis,
+	}
+}
+
+// SetQueryContextFn sets the function used to open the multistore at a
+// committed height.
+func (k *HistoricalStateStore[
+	BeaconStateT, BeaconStateMarshallableT,
+]) SetQueryContextFn(fn QueryContextFn) {
+	k.queryCtxFn = fn
+}
+
+// StoreGenesisState keeps the beacon state held by the given context as the
+// genesis state. It must be called once the genesis has been processed.
+func (k *HistoricalStateStore[
+	BeaconStateT, BeaconStateMarshallableT,
+]) StoreGenesisState(ctx context.Context) error {
+	return k.bs.WithContext(ctx).CopyInto(k.genesis.WithContext(ctx))
+}
+
+// HeadState returns the state at the latest committed height.
+func (k *HistoricalStateStore[
+	BeaconStateT, BeaconStateMarshallableT,
+]) HeadState(
+	_ context.Context,
+) (BeaconStateT, error) {
+	return k.stateAtHeight(0)
+}
+
+// StateAtSlot returns the state committed at the given slot.
+func (k *HistoricalStateStore[
+	BeaconStateT, BeaconStateMarshallableT,
+]) StateAtSlot(
+	ctx context.Context,
+	slot math.Slot,
+) (BeaconStateT, error) {
+	var (
+		st  BeaconStateT
+		err error
+	)
+
+	// The genesis state is committed together with the first block, so
+	// there is no height at which it can be read back on its own. It is
+	// read from the store it was kept in at genesis instead.
+	if slot == 0 {
+		st = state.NewBeaconStateFromDB[
+			BeaconStateT, BeaconStateMarshallableT,
+		](
+			k.genesis.WithContext(ctx), k.cs,
+		)
+	} else {
+		st, err = k.stateAtHeight(int64(slot))
+		if err != nil {
+			return st, err
+		}
+	}
+
+	// Guard against reading a height that was committed by a different
+	// application, e.g. after a state sync, and against a genesis state
+	// that was never kept.
+	stateSlot, err := st.GetSlot()
+	if err != nil {
+		return st, errors.Join(ErrStateNotRetained, err)
+	}
+	if stateSlot != slot {
+		return st, errors.Wrapf(
+			ErrStateNotRetained, "expected slot: %d, got: %d", slot, stateSlot,
+		)
+	}
+	return st, nil
+}
+
+// StateByRoot returns the retained state with the given state root. Only the
+// states whose roots are still tracked by the head state are considered.
+func (k *HistoricalStateStore[
+	BeaconStateT, BeaconStateMarshallableT,
+]) StateByRoot(
+	ctx context.Context,
+	root common.Root,
+) (BeaconStateT, error) {
+	head, err := k.HeadState(ctx)
+	if err != nil {
+		return head, err
+	}
+
+	headRoot, err := head.HashTreeRoot()
+	if err != nil {
+		return head, err
+	}
+	if headRoot == root {
+		return head, nil
+	}
+
+	headSlot, err := head.GetSlot()
+	if err != nil {
+		return head, err
+	}
+
+	// The root of the state committed at slot s is recorded at index
+	// s % SLOTS_PER_HISTORICAL_ROOT when the following slot is processed.
+	slotsPerHistoricalRoot := k.cs.SlotsPerHistoricalRoot()
+	for i := uint64(1); i <= slotsPerHistoricalRoot; i++ {
+		if i > headSlot.Unwrap() {
+			break
+		}
+		slot := headSlot - math.Slot(i)
+		stateRoot, err := head.StateRootAtIndex(
+			slot.Unwrap() % slotsPerHistoricalRoot,
+		)
+		if err != nil {
+			return head, err
+		}
+		if stateRoot == root {
+			return k.StateAtSlot(ctx, slot)
+		}
+	}
+
+	var st BeaconStateT
+	return st, errors.Wrapf(ErrStateRootNotFound, "root: %s", root)
+}
+
+// stateAtHeight opens the beacon state over the multistore at the given
+// committed height.
+func (k *HistoricalStateStore[
+	BeaconStateT, BeaconStateMarshallableT,
+]) stateAtHeight(height int64) (BeaconStateT, error) {
+	var st BeaconStateT
+	if k.queryCtxFn == nil {
+		return st, ErrQueryContextNotSet
+	}
+
+	queryCtx, err := k.queryCtxFn(height, false)
+	if err != nil {
+		return st, errors.Join(ErrStateNotRetained, err)
+	}
+
+	return state.NewBeaconStateFromDB[
+		BeaconStateT, BeaconStateMarshallableT,
+	](
+		k.bs.WithContext(queryCtx), k.cs,
+	), nil
+}
@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package storage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	statedb "github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testBeaconState = core.BeaconState[
+		*types.BeaconBlockHeader, *types.Eth1Data,
+		*types.ExecutionPayloadHeader, *types.Fork,
+		*types.Validator, *engineprimitives.Withdrawal,
+	]
+	testBeaconStateMarshallable = state.BeaconStateMarshallable[
+		*types.BeaconBlockHeader, *types.Eth1Data,
+		*types.ExecutionPayloadHeader, *types.Fork, *types.Validator,
+	]
+	testStore = storage.HistoricalStateStore[
+		testBeaconState, *testBeaconStateMarshallable,
+	]
+)
+
+var errHeightPruned = errors.New("height pruned")
+
+// apiStateStore serves the states of a historical state store to the node
+// API backend.
+type apiStateStore struct{ hs *testStore }
+
+func (s apiStateStore) HeadState(
+	ctx context.Context,
+) (backend.StateDB, error) {
+	return toAPIState(s.hs.HeadState(ctx))
+}
+
+func (s apiStateStore) StateAtSlot(
+	ctx context.Context,
+	slot math.Slot,
+) (backend.StateDB, error) {
+	return toAPIState(s.hs.StateAtSlot(ctx, slot))
+}
+
+func (s apiStateStore) StateByRoot(
+	ctx context.Context,
+	root common.Root,
+) (backend.StateDB, error) {
+	return toAPIState(s.hs.StateByRoot(ctx, root))
+}
+
+func toAPIState(st testBeaconState, err error) (backend.StateDB, error) {
+	if err != nil {
+		return nil, err
+	}
+	//nolint:errcheck // the beacon state implements the API state.
+	return any(st).(backend.StateDB), nil
+}
+
+// newTestKVStore returns a beacon store over an in-memory database.
+func newTestKVStore() *storage.KVStore {
+	return beacondb.New[
+		*types.BeaconBlockHeader, *types.Eth1Data,
+		*types.ExecutionPayloadHeader, *types.Fork, *types.Validator,
+	](
+		&deposit.KVStoreProvider{KVStoreWithBatch: storev2.NewMemDB()},
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+	)
+}
+
+// newTestStore returns a historical state store whose multistore retains
+// only the latest committed height, which holds the state of the returned
+// beacon store.
+func newTestStore(t *testing.T) (*testStore, *storage.KVStore) {
+	t.Helper()
+	bs := newTestKVStore()
+	hs := storage.NewHistoricalStateStore[
+		testBeaconState, *testBeaconStateMarshallable,
+	](spec.TestnetChainSpec(), bs, newTestKVStore())
+	hs.SetQueryContextFn(func(height int64, _ bool) (sdk.Context, error) {
+		if height != 0 {
+			return sdk.Context{}, errHeightPruned
+		}
+		return sdk.Context{}, nil
+	})
+	return hs, bs
+}
+
+// setState writes the given slot and genesis validators root to the store.
+func setState(
+	t *testing.T,
+	bs *storage.KVStore,
+	slot math.Slot,
+	root common.Root,
+) {
+	t.Helper()
+	st := statedb.NewBeaconStateFromDB[
+		testBeaconState, *testBeaconStateMarshallable,
+	](bs.WithContext(context.Background()), spec.TestnetChainSpec())
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetGenesisValidatorsRoot(root))
+}
+
+func TestHistoricalStateStore_GenesisNotKept(t *testing.T) {
+	hs, _ := newTestStore(t)
+
+	_, err := hs.StateAtSlot(context.Background(), 0)
+	require.ErrorIs(t, err, storage.ErrStateNotRetained)
+	require.ErrorIs(t, err, backend.ErrStateNotFound)
+}
+
+func TestHistoricalStateStore_ServesKeptGenesis(t *testing.T) {
+	ctx := context.Background()
+	hs, bs := newTestStore(t)
+
+	genesisRoot := common.Root{0x01}
+	setState(t, bs, 0, genesisRoot)
+	require.NoError(t, hs.StoreGenesisState(ctx))
+
+	// The genesis state must not follow the state of later blocks.
+	setState(t, bs, 1, common.Root{0x02})
+
+	st, err := hs.StateAtSlot(ctx, 0)
+	require.NoError(t, err)
+	slot, err := st.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(0), slot)
+	root, err := st.GetGenesisValidatorsRoot()
+	require.NoError(t, err)
+	require.Equal(t, genesisRoot, root)
+}
+
+func TestHistoricalStateStore_StateNotRetained(t *testing.T) {
+	hs, bs := newTestStore(t)
+	setState(t, bs, 2, common.Root{})
+
+	_, err := hs.StateAtSlot(context.Background(), 1)
+	require.ErrorIs(t, err, errHeightPruned)
+	require.ErrorIs(t, err, backend.ErrStateNotFound)
+}
+
+func TestHistoricalStateStore_APIGenesis(t *testing.T) {
+	ctx := context.Background()
+	hs, bs := newTestStore(t)
+	b := backend.New(backend.Options{StateStore: apiStateStore{hs}})
+
+	_, err := b.GetGenesis(ctx)
+	require.ErrorIs(t, err, backend.ErrStateNotFound)
+
+	genesisRoot := common.Root{0x01}
+	setState(t, bs, 0, genesisRoot)
+	require.NoError(t, hs.StoreGenesisState(ctx))
+
+	root, err := b.GetGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genesisRoot, root)
+}
+
+func TestHistoricalStateStore_APIFirstBlockRewards(t *testing.T) {
+	ctx := context.Background()
+	hs, bs := newTestStore(t)
+	setState(t, bs, 0, common.Root{})
+	require.NoError(t, hs.StoreGenesisState(ctx))
+
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		1, 3, common.Root{0x01}, version.Deneb,
+	)
+	require.NoError(t, err)
+	blocks := &mocks.BlockStore[*types.BeaconBlock]{}
+	blocks.EXPECT().GetBySlot(math.Slot(1)).Return(blk, nil)
+
+	// The first block is re-executed on top of the genesis state.
+	sp := &mocks.StateProcessor[backend.StateDB]{}
+	sp.EXPECT().
+		ComputeBlockRewards(
+			mock.MatchedBy(func(st backend.StateDB) bool {
+				slot, sErr := st.GetSlot()
+				return sErr == nil && slot == 0
+			}),
+			blk,
+		).
+		Return(&transition.BlockRewards{ProposerIndex: 3}, nil).
+		Once()
+
+	b := backend.New(backend.Options{
+		StateStore:     apiStateStore{hs},
+		BlockStore:     blocks,
+		StateProcessor: sp,
+	})
+	rewards, err := b.GetBlockRewards(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), rewards.ProposerIndex)
+	sp.AssertExpectations(t)
+}
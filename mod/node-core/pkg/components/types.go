@@ -22,9 +22,11 @@ package components
 
 import (
 	broker "github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/async/pkg/bus"
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/beacon"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/beacon/pool"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/genesis"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state"
@@ -38,6 +40,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	execution "github.com/berachain/beacon-kit/mod/execution/pkg/engine"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -46,6 +49,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/middleware"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 )
@@ -108,6 +112,7 @@ type (
 		BeaconState,
 		*BlobSidecars,
 		*Deposit,
+		*DepositStore,
 		*ExecutionPayload,
 		*ExecutionPayloadHeader,
 		*Genesis,
@@ -120,7 +125,7 @@ type (
 		*dastore.Store[*BeaconBlockBody],
 		*BeaconBlockBody,
 		*BlobSidecars,
-		*broker.Broker[*SidecarEvent],
+		*SidecarsFeed,
 		*ExecutionPayload,
 	]
 
@@ -138,7 +143,9 @@ type (
 		*BeaconBlock,
 		*BeaconBlockBody,
 		*BlockEvent,
+		BeaconState,
 		*Deposit,
+		*types.Eth1Data,
 		*ExecutionPayload,
 		types.WithdrawalCredentials,
 	]
@@ -163,6 +170,11 @@ type (
 	// Genesis is a type alias for the genesis.
 	Genesis = genesis.Genesis[*Deposit, *ExecutionPayloadHeader]
 
+	// HistoricalStateStore is a type alias for the historical state store.
+	HistoricalStateStore = storage.HistoricalStateStore[
+		BeaconState, *BeaconStateMarshallable,
+	]
+
 	// KVStore is a type alias for the KV store.
 	KVStore = beacondb.KVStore[
 		*BeaconBlockHeader, *types.Eth1Data, *ExecutionPayloadHeader,
@@ -181,14 +193,30 @@ type (
 		engineprimitives.PayloadID,
 	]
 
-	// StateProcessor is the type alias for the state processor interface.
-	StateProcessor = blockchain.StateProcessor[
+	// OperationPool is a type alias for the operation pool.
+	OperationPool = pool.OperationPool[
+		*types.SignedVoluntaryExit, *types.ProposerSlashing,
+	]
+
+	// StateProcessor is the type alias for the state processor.
+	StateProcessor = core.StateProcessor[
 		*BeaconBlock,
+		*BeaconBlockBody,
+		*BeaconBlockHeader,
 		BeaconState,
 		*BlobSidecars,
 		*transition.Context,
 		*Deposit,
+		*types.Eth1Data,
+		*ExecutionPayload,
 		*ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*types.ProposerSlashing,
+		*types.Validator,
+		*types.SignedVoluntaryExit,
+		*Withdrawal,
+		types.WithdrawalCredentials,
 	]
 
 	// StorageBackend is the type alias for the storage backend interface.
@@ -213,6 +241,8 @@ type (
 		*ExecutionPayload,
 		*ExecutionPayloadHeader,
 		*types.ForkData,
+		*types.ProposerSlashing,
+		*types.SignedVoluntaryExit,
 	]
 
 	// Withdrawal is a type alias for the engineprimitives withdrawal.
@@ -248,14 +278,23 @@ type (
 /* -------------------------------------------------------------------------- */
 
 type (
+	// EventBus is a type alias for the event bus.
+	EventBus = bus.Bus
+
 	// GenesisBroker is a type alias for the genesis feed.
 	GenesisBroker = broker.Broker[*GenesisEvent]
 
-	// SidecarsBroker is a type alias for the blob feed.
-	SidecarsBroker = broker.Broker[*SidecarEvent]
+	// SidecarsFeed is a type alias for the blob feed.
+	SidecarsFeed = bus.Feed[*BlobSidecars]
+
+	// BlockStore is a type alias for the block store.
+	BlockStore = block.Store[*BeaconBlock]
+
+	// BlockStoreService is a type alias for the block store service.
+	BlockStoreService = block.Service[*BeaconBlock, *BlockEvent]
 
-	// BlockBroker is a type alias for the block feed.
-	BlockBroker = broker.Broker[*BlockEvent]
+	// BlockFeed is a type alias for the block feed.
+	BlockFeed = bus.Feed[*BeaconBlock]
 
 	// SlotBroker is a type alias for the slot feed.
 	SlotBroker = broker.Broker[*SlotEvent]
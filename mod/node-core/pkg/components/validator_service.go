@@ -21,10 +21,11 @@
 package components
 
 import (
+	"os"
+
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
-	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
-	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/async/pkg/bus"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -32,36 +33,54 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
 )
 
 // ValidatorServiceInput is the input for the validator service provider.
 type ValidatorServiceInput struct {
 	depinject.In
-	BeaconBlockFeed *BlockBroker
-	BlobProcessor   *BlobProcessor
-	Cfg             *config.Config
-	ChainSpec       common.ChainSpec
-	LocalBuilder    *LocalBuilder
-	Logger          log.Logger
-	StateProcessor  StateProcessor
-	StorageBackend  StorageBackend
-	Signer          crypto.BLSSigner
-	SidecarsFeed    *SidecarsBroker
-	SlotBroker      *broker.Broker[*asynctypes.Event[math.Slot]]
-	TelemetrySink   *metrics.TelemetrySink
+	AppOpts        servertypes.AppOptions
+	BlobProcessor  *BlobProcessor
+	Cfg            *config.Config
+	ChainSpec      common.ChainSpec
+	EventBus       *EventBus
+	LocalBuilder   *LocalBuilder
+	Logger         log.Logger
+	OperationPool  *OperationPool
+	StateProcessor *StateProcessor
+	StorageBackend StorageBackend
+	Signer         crypto.BLSSigner
+	TelemetrySink  *metrics.TelemetrySink
 }
 
 // ProvideValidatorService is a depinject provider for the validator service.
 func ProvideValidatorService(
 	in ValidatorServiceInput,
 ) (*ValidatorService, error) {
-	slotSubscription, err := in.SlotBroker.Subscribe()
+	slotSubscription, err := bus.Subscribe[math.Slot](
+		in.EventBus, events.NewSlot,
+	)
 	if err != nil {
 		in.Logger.Error("failed to subscribe to slot feed", "err", err)
 		return nil, err
 	}
 
+	// The validator service only publishes the blocks and sidecars it
+	// builds.
+	blkFeed, err := bus.NewFeed[*BeaconBlock](in.EventBus)
+	if err != nil {
+		return nil, err
+	}
+	sidecarsFeed, err := bus.NewFeed[*BlobSidecars](in.EventBus)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build the builder service.
 	return validator.NewService[
 		*BeaconBlock,
@@ -74,6 +93,8 @@ func ProvideValidatorService(
 		*ExecutionPayload,
 		*ExecutionPayloadHeader,
 		*types.ForkData,
+		*types.ProposerSlashing,
+		*types.SignedVoluntaryExit,
 	](
 		&in.Cfg.Validator,
 		in.Logger.With("service", "validator"),
@@ -81,18 +102,31 @@ func ProvideValidatorService(
 		in.StorageBackend,
 		in.StateProcessor,
 		in.Signer,
+		filedb.NewDB(
+			filedb.WithRootDirectory(
+				cast.ToString(
+					in.AppOpts.Get(flags.FlagHome),
+				)+"/data/slashing-protection",
+			),
+			filedb.WithFileExtension("bin"),
+			filedb.WithDirectoryPermissions(os.ModePerm),
+			filedb.WithLogger(in.Logger),
+		),
 		dablob.NewSidecarFactory[*BeaconBlock, *BeaconBlockBody](
 			in.ChainSpec,
 			types.KZGPositionDeneb,
 			in.TelemetrySink,
 		),
+		in.OperationPool,
 		in.LocalBuilder,
-		[]validator.PayloadBuilder[BeaconState, *ExecutionPayload]{
+		[]validator.PayloadBuilder[
+			BeaconState, *ExecutionPayload, *ExecutionPayloadHeader,
+		]{
 			in.LocalBuilder,
 		},
 		in.TelemetrySink,
-		in.BeaconBlockFeed,
-		in.SidecarsFeed,
+		blkFeed,
+		sidecarsFeed,
 		slotSubscription,
 	), nil
 }
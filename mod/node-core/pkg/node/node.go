@@ -23,6 +23,7 @@ package node
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/app"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
@@ -50,6 +51,15 @@ func (n *node) Start(ctx context.Context) error {
 	return n.registry.StartAll(ctx)
 }
 
+// Close stops the services of the node, in the reverse order they were
+// started in, before closing the application.
+func (n *node) Close() error {
+	return errors.Join(
+		n.registry.StopAll(context.Background()),
+		n.BeaconApp.Close(),
+	)
+}
+
 // SetApplication sets the application.
 func (n *node) RegisterApp(a servertypes.Application) {
 	//nolint:errcheck // BeaconApp is our servertypes.Application
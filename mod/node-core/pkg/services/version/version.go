@@ -63,6 +63,17 @@ func (*ReportingService) Name() string {
 	return "reporting"
 }
 
+// Stop does nothing, the reporting exits once the context of the service is
+// cancelled.
+func (*ReportingService) Stop(context.Context) error {
+	return nil
+}
+
+// Status returns the status of the service.
+func (*ReportingService) Status() error {
+	return nil
+}
+
 // Start begins the periodic logging of the chain version.
 func (v *ReportingService) Start(ctx context.Context) error {
 	ticker := time.NewTicker(v.reportingInterval)
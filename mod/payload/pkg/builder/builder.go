@@ -21,6 +21,8 @@
 package builder
 
 import (
+	"time"
+
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
@@ -43,6 +45,7 @@ type PayloadBuilder[
 	ExecutionPayloadHeaderT interface {
 		GetBlockHash() common.ExecutionHash
 		GetParentHash() common.ExecutionHash
+		HashTreeRoot() ([32]byte, error)
 	},
 	PayloadAttributesT interface {
 		engineprimitives.PayloadAttributer
@@ -75,6 +78,14 @@ type PayloadBuilder[
 	attributesFactory *attributes.Factory[
 		BeaconStateT, PayloadAttributesT, *engineprimitives.Withdrawal,
 	]
+	// relay is the builder-API relay serving payloads built by external
+	// block builders, nil if payloads are only built locally.
+	relay Relay[ExecutionPayloadT, ExecutionPayloadHeaderT]
+	// proposeTimeout is the CometBFT timeout_propose, it bounds how long
+	// RequestPayloadSync may wait for a payload. Zero if unbounded.
+	proposeTimeout time.Duration
+	// metrics is the metrics for the payload builder.
+	metrics *payloadMetrics
 }
 
 // New creates a new service.
@@ -89,6 +100,7 @@ func New[
 	ExecutionPayloadHeaderT interface {
 		GetBlockHash() common.ExecutionHash
 		GetParentHash() common.ExecutionHash
+		HashTreeRoot() ([32]byte, error)
 	},
 	PayloadAttributesT interface {
 		engineprimitives.PayloadAttributer
@@ -113,6 +125,9 @@ func New[
 	af *attributes.Factory[
 		BeaconStateT, PayloadAttributesT, *engineprimitives.Withdrawal,
 	],
+	relay Relay[ExecutionPayloadT, ExecutionPayloadHeaderT],
+	proposeTimeout time.Duration,
+	telemetrySink TelemetrySink,
 ) *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT,
@@ -127,6 +142,9 @@ func New[
 		ee:                ee,
 		pc:                pc,
 		attributesFactory: af,
+		relay:             relay,
+		proposeTimeout:    proposeTimeout,
+		metrics:           newPayloadMetrics(telemetrySink),
 	}
 }
 
@@ -30,6 +30,12 @@ const (
 	// defaultPayloadTimeout is the default value for local build
 	// payload timeout.
 	defaultPayloadTimeout = 1200 * time.Millisecond
+	// defaultPayloadPollInterval is the default interval at which the
+	// execution client is polled for whether the payload is ready.
+	defaultPayloadPollInterval = 100 * time.Millisecond
+	// defaultRelayTimeout is the default value for the relay request
+	// timeout.
+	defaultRelayTimeout = 950 * time.Millisecond
 )
 
 // Config is the configuration for the payload builder.
@@ -46,6 +52,19 @@ type Config struct {
 	// timeout on your execution client. It also must be less than
 	// timeout_proposal in the CometBFT configuration.
 	PayloadTimeout time.Duration `mapstructure:"payload-timeout"`
+	// PayloadPollInterval is the interval at which the execution client is
+	// polled for whether the payload being built is ready, if it supports
+	// it. The payload is returned as soon as it is ready, instead of
+	// waiting for the full PayloadTimeout. Setting it to zero disables
+	// polling.
+	PayloadPollInterval time.Duration `mapstructure:"payload-poll-interval"`
+	// RelayURL is the url of the builder-API relay used to source payloads
+	// from external block builders. Payloads are only built locally if it
+	// is left empty.
+	RelayURL string `mapstructure:"relay-url"`
+	// RelayTimeout is the timeout for requests to the relay, after which
+	// the local payload is used.
+	RelayTimeout time.Duration `mapstructure:"relay-timeout"`
 }
 
 // DefaultConfig returns the default fork configuration.
@@ -54,5 +73,8 @@ func DefaultConfig() Config {
 		Enabled:               true,
 		SuggestedFeeRecipient: common.ZeroAddress,
 		PayloadTimeout:        defaultPayloadTimeout,
+		PayloadPollInterval:   defaultPayloadPollInterval,
+		RelayURL:              "",
+		RelayTimeout:          defaultRelayTimeout,
 	}
 }
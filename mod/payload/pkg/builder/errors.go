@@ -50,4 +50,13 @@ var (
 	// ErrNilPayload is returned when a nil payload envelope is
 	// received.
 	ErrNilPayload = errors.New("received nil payload envelope")
+
+	// ErrRelayDisabled is returned when no relay is configured.
+	ErrRelayDisabled = errors.New("relay is disabled")
+
+	// ErrUnblindedPayloadMismatch is returned when the payload revealed by
+	// the relay does not match the header of the bid.
+	ErrUnblindedPayloadMismatch = errors.New(
+		"unblinded payload does not match bid header",
+	)
 )
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"strconv"
+	"time"
+)
+
+// payloadMetrics is a struct that contains metrics for the payload builder.
+type payloadMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newPayloadMetrics creates a new payloadMetrics.
+func newPayloadMetrics(sink TelemetrySink) *payloadMetrics {
+	return &payloadMetrics{
+		sink: sink,
+	}
+}
+
+// measureTimeToPayload measures the time it took for the local payload to
+// be retrieved from the execution client, labelled by whether it was
+// retrieved before the deadline.
+func (pm *payloadMetrics) measureTimeToPayload(start time.Time, early bool) {
+	pm.sink.MeasureSince(
+		"beacon_kit.payload.builder.time_to_payload",
+		start,
+		"early", strconv.FormatBool(early),
+	)
+}
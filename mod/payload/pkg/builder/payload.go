@@ -22,7 +22,6 @@ package builder
 
 import (
 	"context"
-	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -128,25 +127,11 @@ func (pb *PayloadBuilder[
 	// Wait for the payload to be delivered to the execution client.
 	pb.logger.Info(
 		"Waiting for local payload to be delivered to execution client",
-		"for_slot", slot.Base10(), "timeout", pb.cfg.PayloadTimeout.String(),
-	)
-	select {
-	case <-time.After(pb.cfg.PayloadTimeout):
-		// We want to trigger delivery of the payload to the execution client
-		// before the timestamp expires.
-		break
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	}
-
-	// Get the payload from the execution client.
-	return pb.ee.GetPayload(
-		ctx,
-		&engineprimitives.GetPayloadRequest[PayloadIDT]{
-			PayloadID:   *payloadID,
-			ForkVersion: pb.chainSpec.ActiveForkVersionForSlot(slot),
-		},
+		"for_slot", slot.Base10(),
+		"deadline", pb.payloadDeadline().String(),
+		"poll_interval", pb.cfg.PayloadPollInterval.String(),
 	)
+	return pb.waitForPayload(ctx, slot, *payloadID)
 }
 
 // RetrievePayload attempts to pull a previously built payload
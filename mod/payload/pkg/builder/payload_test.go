@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+var errPayloadNotReady = errors.New("payload not ready")
+
+// testEngine serves payloads of the given value, or the given error.
+type testEngine struct {
+	mu    sync.Mutex
+	calls int
+	value int64
+	err   error
+}
+
+func (e *testEngine) GetPayload(
+	context.Context, *engineprimitives.GetPayloadRequest[engineprimitives.PayloadID],
+) (engineprimitives.BuiltExecutionPayloadEnv[*testPayload], error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.calls++
+	if e.err != nil {
+		return nil, e.err
+	}
+	return &testEnvelope{
+		value: math.MustNewU256LFromBigInt(big.NewInt(e.value)),
+	}, nil
+}
+
+func (e *testEngine) NotifyForkchoiceUpdate(
+	context.Context,
+	*engineprimitives.ForkchoiceUpdateRequest[*testAttributes],
+) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+	return nil, nil, nil
+}
+
+func (e *testEngine) numCalls() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.calls
+}
+
+// readyTestEngine is a test engine reporting whether its payload is ready
+// with the ready function, for each readiness check.
+type readyTestEngine struct {
+	*testEngine
+	checks int
+	ready  func(check int) (bool, error)
+}
+
+func (e *readyTestEngine) IsPayloadReady(
+	context.Context, engineprimitives.PayloadID,
+) (bool, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.checks++
+	return e.ready(e.checks)
+}
+
+type testSink struct{}
+
+func (testSink) SetGauge(string, int64, ...string) {}
+
+func (testSink) MeasureSince(string, time.Time, ...string) {}
+
+// newTestChainSpec returns a chain spec whose first epochs are Deneb epochs.
+func newTestChainSpec() common.ChainSpec {
+	return chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			SlotsPerEpoch:    32,
+			ElectraForkEpoch: 100,
+		},
+	)
+}
+
+func newSyncTestPayloadBuilder(
+	cfg *builder.Config,
+	ee builder.ExecutionEngine[
+		*testPayload, *testAttributes, engineprimitives.PayloadID,
+	],
+	proposeTimeout time.Duration,
+) *builder.PayloadBuilder[
+	builder.BeaconState[*testHeader], *testPayload, *testHeader,
+	*testAttributes, engineprimitives.PayloadID,
+] {
+	// Seed the cache so that no forkchoice update is needed to start
+	// building the payload.
+	pc := cache.NewPayloadIDCache[
+		engineprimitives.PayloadID, [32]byte, math.Slot,
+	]()
+	pc.Set(math.Slot(1), [32]byte{}, engineprimitives.PayloadID{1})
+
+	return builder.New[
+		builder.BeaconState[*testHeader], *testPayload, *testHeader,
+		*testAttributes, engineprimitives.PayloadID,
+	](
+		cfg, newTestChainSpec(), noop.NewLogger(), ee, pc, nil, nil,
+		proposeTimeout, testSink{},
+	)
+}
+
+func requestPayloadSync(
+	ctx context.Context,
+	pb *builder.PayloadBuilder[
+		builder.BeaconState[*testHeader], *testPayload, *testHeader,
+		*testAttributes, engineprimitives.PayloadID,
+	],
+) (engineprimitives.BuiltExecutionPayloadEnv[*testPayload], error) {
+	return pb.RequestPayloadSync(
+		ctx, nil, math.Slot(1), 0, common.Root{},
+		common.ExecutionHash{}, common.ExecutionHash{},
+	)
+}
+
+func TestPayloadBuilder_RequestPayloadSync(t *testing.T) {
+	tests := []struct {
+		name           string
+		payloadTimeout time.Duration
+		pollInterval   time.Duration
+		proposeTimeout time.Duration
+		ready          func(check int) (bool, error)
+		err            error
+		minElapsed     time.Duration
+		maxElapsed     time.Duration
+	}{
+		{
+			name:           "waits for the deadline without a ready signal",
+			payloadTimeout: 100 * time.Millisecond,
+			pollInterval:   10 * time.Millisecond,
+			minElapsed:     100 * time.Millisecond,
+			maxElapsed:     time.Second,
+		},
+		{
+			name:           "returns once the payload is ready",
+			payloadTimeout: 10 * time.Second,
+			pollInterval:   10 * time.Millisecond,
+			ready: func(check int) (bool, error) {
+				if check == 1 {
+					return false, errPayloadNotReady
+				}
+				return check >= 3, nil
+			},
+			maxElapsed: time.Second,
+		},
+		{
+			name:           "waits for the deadline while not ready",
+			payloadTimeout: 100 * time.Millisecond,
+			pollInterval:   10 * time.Millisecond,
+			ready: func(int) (bool, error) {
+				return false, nil
+			},
+			minElapsed: 100 * time.Millisecond,
+			maxElapsed: time.Second,
+		},
+		{
+			name:           "deadline is bounded by the propose timeout",
+			payloadTimeout: 10 * time.Second,
+			pollInterval:   10 * time.Millisecond,
+			proposeTimeout: 350 * time.Millisecond,
+			minElapsed:     100 * time.Millisecond,
+			maxElapsed:     time.Second,
+		},
+		{
+			name:           "polling disabled",
+			payloadTimeout: 50 * time.Millisecond,
+			ready: func(int) (bool, error) {
+				return true, nil
+			},
+			minElapsed: 50 * time.Millisecond,
+			maxElapsed: time.Second,
+		},
+		{
+			name:           "payload not built",
+			payloadTimeout: 50 * time.Millisecond,
+			pollInterval:   10 * time.Millisecond,
+			err:            errPayloadNotReady,
+			maxElapsed:     time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := builder.DefaultConfig()
+			cfg.PayloadTimeout = tt.payloadTimeout
+			cfg.PayloadPollInterval = tt.pollInterval
+			ee := &testEngine{value: 100, err: tt.err}
+			var pb *builder.PayloadBuilder[
+				builder.BeaconState[*testHeader], *testPayload, *testHeader,
+				*testAttributes, engineprimitives.PayloadID,
+			]
+			if tt.ready != nil {
+				pb = newSyncTestPayloadBuilder(
+					&cfg,
+					&readyTestEngine{testEngine: ee, ready: tt.ready},
+					tt.proposeTimeout,
+				)
+			} else {
+				pb = newSyncTestPayloadBuilder(&cfg, ee, tt.proposeTimeout)
+			}
+
+			start := time.Now()
+			envelope, err := requestPayloadSync(context.Background(), pb)
+			elapsed := time.Since(start)
+			require.GreaterOrEqual(t, elapsed, tt.minElapsed)
+			require.Less(t, elapsed, tt.maxElapsed)
+
+			// Retrieving the payload stops the execution client from
+			// improving it, so it must be retrieved exactly once.
+			require.Equal(t, 1, ee.numCalls())
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(
+				t, big.NewInt(100), envelope.GetValue().UnwrapBig(),
+			)
+		})
+	}
+}
+
+func TestPayloadBuilder_RequestPayloadSyncCanceled(t *testing.T) {
+	cfg := builder.DefaultConfig()
+	ee := &testEngine{err: errPayloadNotReady}
+	pb := newSyncTestPayloadBuilder(&cfg, ee, 0)
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 50*time.Millisecond,
+	)
+	defer cancel()
+	_, err := requestPayloadSync(ctx, pb)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"context"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// proposeTimeoutMargin is the time reserved out of the CometBFT
+// timeout_propose to assemble and broadcast the proposal once the payload
+// has been retrieved.
+const proposeTimeoutMargin = 250 * time.Millisecond
+
+// payloadDeadline returns how long to wait for the execution client to
+// build a payload. It is the PayloadTimeout, bounded by the propose timeout
+// less a safety margin.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT,
+]) payloadDeadline() time.Duration {
+	deadline := pb.cfg.PayloadTimeout
+	if pb.proposeTimeout > proposeTimeoutMargin {
+		deadline = min(deadline, pb.proposeTimeout-proposeTimeoutMargin)
+	}
+	return deadline
+}
+
+// waitForPayload waits for the execution client to build the payload with
+// the given ID and retrieves it. Retrieving a payload stops the execution
+// client from improving it, so the payload is only retrieved once, at the
+// deadline. If the execution engine reports whether a payload is ready, it
+// is polled and the payload is retrieved as soon as it is ready.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT,
+]) waitForPayload(
+	ctx context.Context,
+	slot math.Slot,
+	payloadID PayloadIDT,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	var (
+		start    = time.Now()
+		deadline = time.NewTimer(pb.payloadDeadline())
+		req      = &engineprimitives.GetPayloadRequest[PayloadIDT]{
+			PayloadID:   payloadID,
+			ForkVersion: pb.chainSpec.ActiveForkVersionForSlot(slot),
+		}
+	)
+	defer deadline.Stop()
+
+	// If polling is disabled, or the execution engine can not report
+	// whether the payload is ready, the payload is retrieved at the
+	// deadline.
+	var poll <-chan time.Time
+	checker, ok := pb.ee.(PayloadReadinessChecker[PayloadIDT])
+	if ok && pb.cfg.PayloadPollInterval > 0 {
+		ticker := time.NewTicker(pb.cfg.PayloadPollInterval)
+		defer ticker.Stop()
+		poll = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-poll:
+			ready, err := checker.IsPayloadReady(ctx, payloadID)
+			if err != nil || !ready {
+				continue
+			}
+			return pb.deliverPayload(ctx, start, req, true)
+		case <-deadline.C:
+			// We want to trigger delivery of the payload to the execution
+			// client before the timestamp expires.
+			return pb.deliverPayload(ctx, start, req, false)
+		}
+	}
+}
+
+// deliverPayload retrieves the payload from the execution client and
+// records the time it took to retrieve it.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT,
+]) deliverPayload(
+	ctx context.Context,
+	start time.Time,
+	req *engineprimitives.GetPayloadRequest[PayloadIDT],
+	early bool,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	envelope, err := pb.ee.GetPayload(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	pb.metrics.measureTimeToPayload(start, early)
+	return envelope, nil
+}
@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"context"
+	"slices"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// RelayEnabled returns true if a relay is configured.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT,
+]) RelayEnabled() bool {
+	return pb.relay != nil
+}
+
+// RegisterValidators registers the validators with the relay, so that
+// external block builders build payloads with their fee recipient and gas
+// limit.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT,
+]) RegisterValidators(
+	ctx context.Context,
+	registrations []*relay.SignedValidatorRegistration,
+) error {
+	if !pb.RelayEnabled() {
+		return ErrRelayDisabled
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pb.cfg.RelayTimeout)
+	defer cancel()
+	return pb.relay.RegisterValidators(ctx, registrations)
+}
+
+// RequestBuilderBid requests a bid for the slot from the relay and compares
+// it by value with the locally built payload. It returns the bid if the
+// external payload is worth more, and nil if the local payload should be
+// proposed instead, which is also the case whenever the relay does not
+// respond in time.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT,
+]) RequestBuilderBid(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+	pubkey crypto.BLSPubkey,
+	local engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+) *relay.SignedBuilderBid[ExecutionPayloadHeaderT] {
+	if !pb.RelayEnabled() {
+		return nil
+	}
+
+	// The execution client may ask us to ignore external builders, e.g.
+	// when it detects censorship.
+	if local != nil && local.ShouldOverrideBuilder() {
+		pb.logger.Info(
+			"Execution client requested local payload, skipping relay",
+			"for_slot", slot.Base10(),
+		)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pb.cfg.RelayTimeout)
+	defer cancel()
+	bid, err := pb.relay.GetHeader(ctx, slot, parentHash, pubkey)
+	switch {
+	case errors.Is(err, relay.ErrNoBid):
+		pb.logger.Info("No bid from relay", "for_slot", slot.Base10())
+		return nil
+	case err != nil:
+		pb.logger.Warn(
+			"Failed to get bid from relay, falling back to local payload",
+			"for_slot", slot.Base10(), "err", err,
+		)
+		return nil
+	case bid == nil || bid.Message == nil:
+		pb.logger.Warn("Received empty bid from relay", "for_slot", slot.Base10())
+		return nil
+	case bid.Message.Header.GetParentHash() != parentHash:
+		pb.logger.Warn(
+			"Received bid for a different parent from relay",
+			"for_slot", slot.Base10(),
+			"expected_parent_hash", parentHash,
+			"bid_parent_hash", bid.Message.Header.GetParentHash(),
+		)
+		return nil
+	}
+
+	bidValue, err := bid.Message.GetValue()
+	if err != nil {
+		pb.logger.Warn("Received invalid bid from relay", "err", err)
+		return nil
+	}
+
+	// Only propose the external payload if it pays more than the local one.
+	if local != nil {
+		localValue := local.GetValue()
+		if bidValue.Cmp(localValue.UnwrapBig()) <= 0 {
+			pb.logger.Info(
+				"Local payload is worth more than the relay bid 🏗️ ",
+				"for_slot", slot.Base10(),
+				"local_value", localValue.UnwrapBig(),
+				"bid_value", bidValue,
+			)
+			return nil
+		}
+	}
+
+	pb.logger.Info(
+		"Relay bid is worth more than the local payload 🤝",
+		"for_slot", slot.Base10(),
+		"bid_value", bidValue,
+		"bid_block_hash", bid.Message.Header.GetBlockHash(),
+	)
+	return bid
+}
+
+// UnblindPayload submits the signed blinded block built on top of the bid
+// to the relay, which reveals the execution payload. The revealed payload
+// and blobs must be the ones committed to by the bid.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT,
+]) UnblindPayload(
+	ctx context.Context,
+	slot math.Slot,
+	signedBlindedBlock any,
+	bid *relay.SignedBuilderBid[ExecutionPayloadHeaderT],
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	if !pb.RelayEnabled() {
+		return nil, ErrRelayDisabled
+	}
+
+	bidValue, err := bid.Message.GetValue()
+	if err != nil {
+		return nil, err
+	}
+	value, err := math.NewU256LFromBigInt(bidValue)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pb.cfg.RelayTimeout)
+	defer cancel()
+	envelope, err := pb.relay.SubmitBlindedBlock(
+		ctx,
+		signedBlindedBlock,
+		pb.chainSpec.ActiveForkVersionForSlot(slot),
+		value,
+	)
+	if err != nil {
+		return nil, err
+	} else if envelope == nil {
+		return nil, ErrNilPayloadEnvelope
+	}
+
+	payload := envelope.GetExecutionPayload()
+	if payload.IsNil() {
+		return nil, ErrNilPayload
+	} else if envelope.BlobsBundle == nil {
+		return nil, engineerrors.ErrNilBlobsBundle
+	} else if payload.GetBlockHash() != bid.Message.Header.GetBlockHash() {
+		return nil, errors.Wrapf(
+			ErrUnblindedPayloadMismatch,
+			"payload block hash: %s, bid block hash: %s",
+			payload.GetBlockHash(), bid.Message.Header.GetBlockHash(),
+		)
+	} else if !slices.Equal(
+		envelope.BlobsBundle.Commitments, bid.Message.BlobKZGCommitments,
+	) {
+		// The signed blinded block commits to the blobs of the bid.
+		return nil, errors.Wrapf(
+			ErrUnblindedPayloadMismatch,
+			"revealed %d blob commitments for %d bid commitments",
+			len(envelope.BlobsBundle.Commitments),
+			len(bid.Message.BlobKZGCommitments),
+		)
+	}
+
+	pb.logger.Info(
+		"Payload retrieved from relay 🤝",
+		"for_slot", slot.Base10(),
+		"payload_block_hash", payload.GetBlockHash(),
+		"num_blobs", len(envelope.GetBlobsBundle().GetBlobs()),
+	)
+	return envelope, nil
+}
+
+// PublishBlindedBlock submits a signed blinded block to the relay, which
+// reveals the execution payload the block commits to and publishes the
+// block.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT,
+]) PublishBlindedBlock(
+	ctx context.Context,
+	forkVersion uint32,
+	signedBlindedBlock any,
+) error {
+	if !pb.RelayEnabled() {
+		return ErrRelayDisabled
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pb.cfg.RelayTimeout)
+	defer cancel()
+	_, err := pb.relay.SubmitBlindedBlock(
+		ctx, signedBlindedBlock, forkVersion, math.Wei{},
+	)
+	return err
+}
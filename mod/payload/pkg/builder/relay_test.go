@@ -0,0 +1,342 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+type testPayload struct{}
+
+func (p *testPayload) Empty(uint32) *testPayload { return new(testPayload) }
+
+func (p *testPayload) Version() uint32 { return version.Deneb }
+
+func (p *testPayload) IsNil() bool { return p == nil }
+
+func (p *testPayload) GetBlockHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+func (p *testPayload) GetParentHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+func (p *testPayload) GetFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+type testHeader struct {
+	parentHash common.ExecutionHash
+}
+
+func (h *testHeader) GetBlockHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+func (h *testHeader) GetParentHash() common.ExecutionHash {
+	return h.parentHash
+}
+
+func (h *testHeader) HashTreeRoot() ([32]byte, error) {
+	return h.parentHash, nil
+}
+
+type testAttributes struct{}
+
+func (a *testAttributes) New(
+	uint32, uint64, common.Bytes32, common.ExecutionAddress,
+	[]*engineprimitives.Withdrawal, common.Root,
+) (*testAttributes, error) {
+	return new(testAttributes), nil
+}
+
+func (a *testAttributes) Version() uint32 { return version.Deneb }
+
+func (a *testAttributes) IsNil() bool { return a == nil }
+
+func (a *testAttributes) GetSuggestedFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+type testEnvelope struct {
+	value    math.Wei
+	override bool
+}
+
+func (e *testEnvelope) GetExecutionPayload() *testPayload {
+	return new(testPayload)
+}
+
+func (e *testEnvelope) GetValue() math.Wei { return e.value }
+
+func (e *testEnvelope) GetBlobsBundle() engineprimitives.BlobsBundle {
+	return nil
+}
+
+func (e *testEnvelope) ShouldOverrideBuilder() bool { return e.override }
+
+type testRelay struct {
+	getHeader func(
+		context.Context,
+	) (*relay.SignedBuilderBid[*testHeader], error)
+	submitBlindedBlock func(
+		context.Context, any, uint32,
+	) (*relay.ExecutionPayloadAndBlobsBundle[*testPayload], error)
+}
+
+func (r *testRelay) RegisterValidators(
+	context.Context, []*relay.SignedValidatorRegistration,
+) error {
+	return nil
+}
+
+func (r *testRelay) GetHeader(
+	ctx context.Context, _ math.Slot, _ common.ExecutionHash, _ crypto.BLSPubkey,
+) (*relay.SignedBuilderBid[*testHeader], error) {
+	return r.getHeader(ctx)
+}
+
+func (r *testRelay) SubmitBlindedBlock(
+	ctx context.Context, signedBlindedBlock any, forkVersion uint32, _ math.Wei,
+) (*relay.ExecutionPayloadAndBlobsBundle[*testPayload], error) {
+	return r.submitBlindedBlock(ctx, signedBlindedBlock, forkVersion)
+}
+
+func newTestPayloadBuilder(
+	r builder.Relay[*testPayload, *testHeader],
+) *builder.PayloadBuilder[
+	builder.BeaconState[*testHeader], *testPayload, *testHeader,
+	*testAttributes, engineprimitives.PayloadID,
+] {
+	cfg := builder.DefaultConfig()
+	cfg.RelayTimeout = 50 * time.Millisecond
+	return builder.New[
+		builder.BeaconState[*testHeader], *testPayload, *testHeader,
+		*testAttributes, engineprimitives.PayloadID,
+	](
+		&cfg, newTestChainSpec(), noop.NewLogger(), nil, nil, nil, r, 0, nil,
+	)
+}
+
+func newBid(
+	parentHash common.ExecutionHash, value string,
+) *relay.SignedBuilderBid[*testHeader] {
+	return &relay.SignedBuilderBid[*testHeader]{
+		Message: &relay.BuilderBid[*testHeader]{
+			Header: &testHeader{parentHash: parentHash},
+			Value:  value,
+		},
+	}
+}
+
+func TestPayloadBuilder_RequestBuilderBid(t *testing.T) {
+	parentHash := common.ExecutionHash{1}
+	local := &testEnvelope{value: math.MustNewU256LFromBigInt(big.NewInt(100))}
+
+	tests := []struct {
+		name      string
+		local     *testEnvelope
+		getHeader func(
+			context.Context,
+		) (*relay.SignedBuilderBid[*testHeader], error)
+		wantBid bool
+	}{
+		{
+			name:  "bid worth more than local payload",
+			local: local,
+			getHeader: func(
+				context.Context,
+			) (*relay.SignedBuilderBid[*testHeader], error) {
+				return newBid(parentHash, "101"), nil
+			},
+			wantBid: true,
+		},
+		{
+			name:  "bid worth as much as local payload",
+			local: local,
+			getHeader: func(
+				context.Context,
+			) (*relay.SignedBuilderBid[*testHeader], error) {
+				return newBid(parentHash, "100"), nil
+			},
+		},
+		{
+			name: "execution client overrides builder",
+			local: &testEnvelope{
+				value:    local.value,
+				override: true,
+			},
+			getHeader: func(
+				context.Context,
+			) (*relay.SignedBuilderBid[*testHeader], error) {
+				return newBid(parentHash, "1000"), nil
+			},
+		},
+		{
+			name:  "bid for a different parent",
+			local: local,
+			getHeader: func(
+				context.Context,
+			) (*relay.SignedBuilderBid[*testHeader], error) {
+				return newBid(common.ExecutionHash{2}, "1000"), nil
+			},
+		},
+		{
+			name:  "no bid",
+			local: local,
+			getHeader: func(
+				context.Context,
+			) (*relay.SignedBuilderBid[*testHeader], error) {
+				return nil, relay.ErrNoBid
+			},
+		},
+		{
+			name:  "relay times out",
+			local: local,
+			getHeader: func(
+				ctx context.Context,
+			) (*relay.SignedBuilderBid[*testHeader], error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pb := newTestPayloadBuilder(&testRelay{getHeader: tt.getHeader})
+			bid := pb.RequestBuilderBid(
+				context.Background(),
+				math.Slot(1),
+				parentHash,
+				crypto.BLSPubkey{},
+				tt.local,
+			)
+			require.Equal(t, tt.wantBid, bid != nil)
+		})
+	}
+}
+
+func TestPayloadBuilder_RelayDisabled(t *testing.T) {
+	pb := newTestPayloadBuilder(nil)
+	require.False(t, pb.RelayEnabled())
+	require.Nil(t, pb.RequestBuilderBid(
+		context.Background(),
+		math.Slot(1),
+		common.ExecutionHash{},
+		crypto.BLSPubkey{},
+		&testEnvelope{},
+	))
+	require.ErrorIs(
+		t,
+		pb.RegisterValidators(context.Background(), nil),
+		builder.ErrRelayDisabled,
+	)
+	require.ErrorIs(
+		t,
+		pb.PublishBlindedBlock(context.Background(), version.Deneb, nil),
+		builder.ErrRelayDisabled,
+	)
+}
+
+func TestPayloadBuilder_UnblindPayload(t *testing.T) {
+	bid := newBid(common.ExecutionHash{}, "1")
+	bid.Message.BlobKZGCommitments = []eip4844.KZGCommitment{{1}}
+
+	tests := []struct {
+		name        string
+		commitments []eip4844.KZGCommitment
+		wantErr     error
+	}{
+		{
+			name:        "revealed blobs match the bid",
+			commitments: []eip4844.KZGCommitment{{1}},
+		},
+		{
+			name:        "revealed blobs differ from the bid",
+			commitments: []eip4844.KZGCommitment{{2}},
+			wantErr:     builder.ErrUnblindedPayloadMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pb := newTestPayloadBuilder(&testRelay{
+				submitBlindedBlock: func(
+					context.Context, any, uint32,
+				) (*relay.ExecutionPayloadAndBlobsBundle[*testPayload], error) {
+					return &relay.ExecutionPayloadAndBlobsBundle[*testPayload]{
+						ExecutionPayload: new(testPayload),
+						BlobsBundle: &engineprimitives.BlobsBundleV1[
+							eip4844.KZGCommitment, eip4844.KZGProof,
+							eip4844.Blob,
+						]{Commitments: tt.commitments},
+					}, nil
+				},
+			})
+			envelope, err := pb.UnblindPayload(
+				context.Background(), math.Slot(1), "signed blinded block", bid,
+			)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(
+				t,
+				tt.commitments,
+				envelope.GetBlobsBundle().GetCommitments(),
+			)
+		})
+	}
+}
+
+func TestPayloadBuilder_PublishBlindedBlock(t *testing.T) {
+	var submitted any
+	pb := newTestPayloadBuilder(&testRelay{
+		submitBlindedBlock: func(
+			_ context.Context, signedBlindedBlock any, forkVersion uint32,
+		) (*relay.ExecutionPayloadAndBlobsBundle[*testPayload], error) {
+			require.Equal(t, version.Deneb, forkVersion)
+			submitted = signedBlindedBlock
+			return nil, nil
+		},
+	})
+	require.NoError(t, pb.PublishBlindedBlock(
+		context.Background(), version.Deneb, "signed blinded block",
+	))
+	require.Equal(t, "signed blinded block", submitted)
+}
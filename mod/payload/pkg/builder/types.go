@@ -22,8 +22,10 @@ package builder
 
 import (
 	"context"
+	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -67,3 +69,52 @@ type ExecutionEngine[
 		req *engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT],
 	) (*PayloadIDT, *common.ExecutionHash, error)
 }
+
+// PayloadReadinessChecker is optionally implemented by execution engines
+// that can tell whether the build of a payload is complete without
+// resolving it. Unlike GetPayload, which stops the execution client from
+// improving the payload, it may be called repeatedly while the payload is
+// being built.
+type PayloadReadinessChecker[PayloadIDT ~[8]byte] interface {
+	// IsPayloadReady returns true once the execution client will no longer
+	// improve the payload with the given ID.
+	IsPayloadReady(ctx context.Context, payloadID PayloadIDT) (bool, error)
+}
+
+// Relay is the interface for a builder-API relay serving payloads built by
+// external block builders.
+type Relay[
+	ExecutionPayloadT any,
+	ExecutionPayloadHeaderT relay.ExecutionPayloadHeader,
+] interface {
+	// RegisterValidators registers the validators with the relay.
+	RegisterValidators(
+		ctx context.Context,
+		registrations []*relay.SignedValidatorRegistration,
+	) error
+	// GetHeader returns the best bid of the relay for the given slot.
+	GetHeader(
+		ctx context.Context,
+		slot math.Slot,
+		parentHash common.ExecutionHash,
+		pubkey crypto.BLSPubkey,
+	) (*relay.SignedBuilderBid[ExecutionPayloadHeaderT], error)
+	// SubmitBlindedBlock reveals the payload committed to by the signed
+	// blinded block.
+	SubmitBlindedBlock(
+		ctx context.Context,
+		signedBlindedBlock any,
+		forkVersion uint32,
+		value math.Wei,
+	) (*relay.ExecutionPayloadAndBlobsBundle[ExecutionPayloadT], error)
+}
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+	// MeasureSince measures the time since the provided start time,
+	// identified by the provided keys.
+	MeasureSince(key string, start time.Time, args ...string)
+}
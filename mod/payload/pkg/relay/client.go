@@ -0,0 +1,331 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+const (
+	// statusPath is the builder-API route reporting the relay status.
+	statusPath = "/eth/v1/builder/status"
+	// registerValidatorsPath is the builder-API route registering
+	// validators with the relay.
+	registerValidatorsPath = "/eth/v1/builder/validators"
+	// headerPath is the builder-API route returning the best bid.
+	headerPath = "/eth/v1/builder/header/%d/%s/%s"
+	// blindedBlocksPath is the builder-API route unblinding a block.
+	blindedBlocksPath = "/eth/v1/builder/blinded_blocks"
+	// consensusVersionHeader is the header carrying the fork of the data
+	// sent to the relay.
+	consensusVersionHeader = "Eth-Consensus-Version"
+)
+
+// Client is a client for a relay implementing the builder-API.
+// https://github.com/ethereum/builder-specs
+//
+// The client only returns bids carrying a valid signature of the builder
+// they name.
+type Client[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+	ExecutionPayloadHeaderT interface {
+		constraints.EmptyWithVersion[ExecutionPayloadHeaderT]
+		json.Unmarshaler
+		ExecutionPayloadHeader
+	},
+] struct {
+	// baseURL is the url of the relay.
+	baseURL *url.URL
+	// client is the HTTP client used to reach the relay.
+	client *http.Client
+	// builderDomain is the domain of the builder signatures over bids.
+	builderDomain common.Domain
+	// maxBlobCommitmentsPerBlock is the maximum number of blob KZG
+	// commitments of a bid.
+	maxBlobCommitmentsPerBlock uint64
+	// verifySignatureFn verifies the builder signatures over bids.
+	verifySignatureFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error
+}
+
+// New creates a new relay client for the relay at rawURL. Requests that
+// take longer than timeout are aborted. Bids are verified against the given
+// builder domain with verifySignatureFn.
+func New[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+	ExecutionPayloadHeaderT interface {
+		constraints.EmptyWithVersion[ExecutionPayloadHeaderT]
+		json.Unmarshaler
+		ExecutionPayloadHeader
+	},
+](
+	rawURL string,
+	timeout time.Duration,
+	builderDomain common.Domain,
+	maxBlobCommitmentsPerBlock uint64,
+	verifySignatureFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) (*Client[ExecutionPayloadT, ExecutionPayloadHeaderT], error) {
+	baseURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return &Client[ExecutionPayloadT, ExecutionPayloadHeaderT]{
+		baseURL:                    baseURL,
+		client:                     &http.Client{Timeout: timeout},
+		builderDomain:              builderDomain,
+		maxBlobCommitmentsPerBlock: maxBlobCommitmentsPerBlock,
+		verifySignatureFn:          verifySignatureFn,
+	}, nil
+}
+
+// Status returns an error if the relay is not ready to serve requests.
+func (c *Client[_, _]) Status(ctx context.Context) error {
+	resp, err := c.do(ctx, http.MethodGet, statusPath, nil, nil)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// RegisterValidators registers the validators with the relay, so that
+// builders know which fee recipient and gas limit each validator expects.
+func (c *Client[_, _]) RegisterValidators(
+	ctx context.Context,
+	registrations []*SignedValidatorRegistration,
+) error {
+	body, err := json.Marshal(registrations)
+	if err != nil {
+		return err
+	}
+	resp, err := c.do(
+		ctx, http.MethodPost, registerValidatorsPath, nil, body,
+	)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// GetHeader returns the best bid of the relay for the given slot, parent
+// execution block and proposer. It returns ErrNoBid if the relay has no bid.
+func (c *Client[
+	_, ExecutionPayloadHeaderT,
+]) GetHeader(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+	pubkey crypto.BLSPubkey,
+) (*SignedBuilderBid[ExecutionPayloadHeaderT], error) {
+	resp, err := c.do(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf(headerPath, slot, parentHash.Hex(), pubkey.String()),
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	//#nosec:G307 // the body is only read from.
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, ErrNoBid
+	}
+
+	var (
+		header ExecutionPayloadHeaderT
+		bid    *SignedBuilderBid[ExecutionPayloadHeaderT]
+	)
+	if err = decodeVersioned(resp.Body, func(forkVersion uint32) any {
+		bid = &SignedBuilderBid[ExecutionPayloadHeaderT]{
+			Message: &BuilderBid[ExecutionPayloadHeaderT]{
+				Header: header.Empty(forkVersion),
+			},
+		}
+		return bid
+	}); err != nil {
+		return nil, err
+	}
+
+	// Empty bids are left to the caller, any other bid must be signed by
+	// the builder it names.
+	if bid.Message == nil {
+		return bid, nil
+	}
+	if err = bid.VerifySignature(
+		c.builderDomain, c.maxBlobCommitmentsPerBlock, c.verifySignatureFn,
+	); err != nil {
+		return nil, err
+	}
+	return bid, nil
+}
+
+// SubmitBlindedBlock sends the signed blinded block to the relay, which
+// reveals the execution payload and blobs bundle of the bid it commits to.
+// The value of the bid is attached to the returned envelope.
+func (c *Client[
+	ExecutionPayloadT, _,
+]) SubmitBlindedBlock(
+	ctx context.Context,
+	signedBlindedBlock any,
+	forkVersion uint32,
+	value math.Wei,
+) (*ExecutionPayloadAndBlobsBundle[ExecutionPayloadT], error) {
+	name, err := versionName(forkVersion)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(signedBlindedBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(
+		ctx,
+		http.MethodPost,
+		blindedBlocksPath,
+		http.Header{consensusVersionHeader: []string{name}},
+		body,
+	)
+	if err != nil {
+		return nil, err
+	}
+	//#nosec:G307 // the body is only read from.
+	defer resp.Body.Close()
+
+	var (
+		payload  ExecutionPayloadT
+		envelope *ExecutionPayloadAndBlobsBundle[ExecutionPayloadT]
+	)
+	if err = decodeVersioned(resp.Body, func(forkVersion uint32) any {
+		envelope = &ExecutionPayloadAndBlobsBundle[ExecutionPayloadT]{
+			ExecutionPayload: payload.Empty(forkVersion),
+			value:            value,
+		}
+		return envelope
+	}); err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+// do sends a request to the relay and returns the response if the relay
+// accepted it.
+func (c *Client[_, _]) do(
+	ctx context.Context,
+	method string,
+	path string,
+	header http.Header,
+	body []byte,
+) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(
+		ctx, method, c.baseURL.JoinPath(path).String(), bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusOK ||
+		resp.StatusCode >= http.StatusMultipleChoices {
+		//#nosec:G307 // the body is only read from.
+		defer resp.Body.Close()
+		return nil, readError(resp)
+	}
+	return resp, nil
+}
+
+// readError turns a failed relay response into an error.
+func readError(resp *http.Response) error {
+	var e errorResponse
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil || json.Unmarshal(bz, &e) != nil || e.Message == "" {
+		e.Message = string(bz)
+	}
+	return errors.Wrapf(
+		ErrRelayRequestFailed, "status %d: %s", resp.StatusCode, e.Message,
+	)
+}
+
+// decodeVersioned decodes a versioned relay response into the value
+// returned by newData for the fork version of the response.
+func decodeVersioned(r io.Reader, newData func(uint32) any) error {
+	var raw versionedResponse[json.RawMessage]
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return err
+	}
+
+	forkVersion, err := versionFromName(raw.Version)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw.Data, newData(forkVersion))
+}
+
+// versionName returns the name the builder-API uses for the fork version.
+func versionName(forkVersion uint32) (string, error) {
+	switch forkVersion {
+	case version.Deneb:
+		return "deneb", nil
+	default:
+		return "", errors.Wrapf(
+			ErrUnsupportedVersion, "version: %d", forkVersion,
+		)
+	}
+}
+
+// versionFromName returns the fork version for a builder-API fork name.
+func versionFromName(name string) (uint32, error) {
+	switch name {
+	case "deneb":
+		return version.Deneb, nil
+	default:
+		return 0, errors.Wrapf(ErrUnsupportedVersion, "version: %q", name)
+	}
+}
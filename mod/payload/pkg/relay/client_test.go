@@ -0,0 +1,284 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+type testPayload struct {
+	BlockHash common.ExecutionHash `json:"blockHash"`
+}
+
+func (p *testPayload) Empty(uint32) *testPayload { return new(testPayload) }
+
+func (p *testPayload) Version() uint32 { return version.Deneb }
+
+func (p *testPayload) IsNil() bool { return p == nil }
+
+func (p *testPayload) MarshalJSON() ([]byte, error) {
+	type payload testPayload
+	return json.Marshal((*payload)(p))
+}
+
+func (p *testPayload) UnmarshalJSON(input []byte) error {
+	type payload testPayload
+	return json.Unmarshal(input, (*payload)(p))
+}
+
+type testHeader struct {
+	ParentHash common.ExecutionHash `json:"parentHash"`
+	BlockHash  common.ExecutionHash `json:"blockHash"`
+}
+
+func (h *testHeader) Empty(uint32) *testHeader { return new(testHeader) }
+
+func (h *testHeader) UnmarshalJSON(input []byte) error {
+	type header testHeader
+	return json.Unmarshal(input, (*header)(h))
+}
+
+// HashTreeRoot returns the block hash, which is enough to tell headers apart
+// in tests.
+func (h *testHeader) HashTreeRoot() ([32]byte, error) {
+	return h.BlockHash, nil
+}
+
+func newTestClient(
+	t *testing.T, handler http.HandlerFunc,
+) *relay.Client[*testPayload, *testHeader] {
+	t.Helper()
+	return newVerifyingTestClient(
+		t, handler, func(crypto.BLSPubkey, []byte, crypto.BLSSignature) error {
+			return nil
+		},
+	)
+}
+
+func newVerifyingTestClient(
+	t *testing.T,
+	handler http.HandlerFunc,
+	verifySignatureFn func(crypto.BLSPubkey, []byte, crypto.BLSSignature) error,
+) *relay.Client[*testPayload, *testHeader] {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client, err := relay.New[*testPayload, *testHeader](
+		server.URL, 100*time.Millisecond, common.Domain{}, 16, verifySignatureFn,
+	)
+	require.NoError(t, err)
+	return client
+}
+
+func TestClient_RegisterValidators(t *testing.T) {
+	var body []byte
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/eth/v1/builder/validators", r.URL.Path)
+		var err error
+		body, err = io.ReadAll(r.Body)
+		require.NoError(t, err)
+	})
+
+	require.NoError(t, client.RegisterValidators(
+		context.Background(),
+		[]*relay.SignedValidatorRegistration{{
+			Message: &relay.ValidatorRegistration{
+				FeeRecipient: common.ExecutionAddress{1},
+				GasLimit:     30000000,
+				Timestamp:    1700000000,
+				Pubkey:       crypto.BLSPubkey{2},
+			},
+		}},
+	))
+
+	var registrations []struct {
+		Message map[string]any `json:"message"`
+	}
+	require.NoError(t, json.Unmarshal(body, &registrations))
+	require.Len(t, registrations, 1)
+	require.Equal(t, "30000000", registrations[0].Message["gas_limit"])
+	require.Equal(t, "1700000000", registrations[0].Message["timestamp"])
+}
+
+func TestClient_GetHeader(t *testing.T) {
+	parentHash := common.ExecutionHash{1}
+	pubkey := crypto.BLSPubkey{2}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(
+			t,
+			"/eth/v1/builder/header/5/"+parentHash.Hex()+"/"+pubkey.String(),
+			r.URL.Path,
+		)
+		_, err := w.Write([]byte(`{"version":"deneb","data":{"message":{` +
+			`"header":{"parentHash":"` + parentHash.Hex() + `",` +
+			`"blockHash":"` + common.ExecutionHash{3}.Hex() + `"},` +
+			`"blob_kzg_commitments":[],"value":"1000000000000000000",` +
+			`"pubkey":"` + crypto.BLSPubkey{4}.String() + `"},` +
+			`"signature":"` + crypto.BLSSignature{}.String() + `"}}`))
+		require.NoError(t, err)
+	})
+
+	bid, err := client.GetHeader(
+		context.Background(), math.Slot(5), parentHash, pubkey,
+	)
+	require.NoError(t, err)
+	require.Equal(t, parentHash, bid.Message.Header.ParentHash)
+	require.Equal(t, common.ExecutionHash{3}, bid.Message.Header.BlockHash)
+	value, err := bid.Message.GetValue()
+	require.NoError(t, err)
+	require.Equal(t, "1000000000000000000", value.String())
+}
+
+func TestClient_GetHeaderErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		wantErr error
+	}{
+		{
+			name: "no bid",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			},
+			wantErr: relay.ErrNoBid,
+		},
+		{
+			name: "relay error",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"code":400,"message":"bad slot"}`))
+			},
+			wantErr: relay.ErrRelayRequestFailed,
+		},
+		{
+			name: "unsupported version",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"version":"capella","data":{}}`))
+			},
+			wantErr: relay.ErrUnsupportedVersion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, tt.handler)
+			_, err := client.GetHeader(
+				context.Background(),
+				math.Slot(1),
+				common.ExecutionHash{},
+				crypto.BLSPubkey{},
+			)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestClient_GetHeaderInvalidSignature(t *testing.T) {
+	var verified bool
+	client := newVerifyingTestClient(
+		t,
+		func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"version":"deneb","data":{"message":{` +
+				`"header":{},"blob_kzg_commitments":[],"value":"1",` +
+				`"pubkey":"` + crypto.BLSPubkey{4}.String() + `"},` +
+				`"signature":"` + crypto.BLSSignature{5}.String() + `"}}`))
+		},
+		func(
+			pubkey crypto.BLSPubkey, _ []byte, signature crypto.BLSSignature,
+		) error {
+			verified = true
+			require.Equal(t, crypto.BLSPubkey{4}, pubkey)
+			require.Equal(t, crypto.BLSSignature{5}, signature)
+			return errors.New("invalid signature")
+		},
+	)
+
+	bid, err := client.GetHeader(
+		context.Background(),
+		math.Slot(1),
+		common.ExecutionHash{},
+		crypto.BLSPubkey{},
+	)
+	require.True(t, verified)
+	require.ErrorIs(t, err, relay.ErrInvalidBidSignature)
+	require.Nil(t, bid)
+}
+
+func TestClient_GetHeaderTimeout(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.GetHeader(
+		context.Background(),
+		math.Slot(1),
+		common.ExecutionHash{},
+		crypto.BLSPubkey{},
+	)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, relay.ErrNoBid)
+}
+
+func TestClient_SubmitBlindedBlock(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v1/builder/blinded_blocks", r.URL.Path)
+		require.Equal(t, "deneb", r.Header.Get("Eth-Consensus-Version"))
+		_, err := w.Write([]byte(`{"version":"deneb","data":{` +
+			`"execution_payload":{"blockHash":"` +
+			common.ExecutionHash{3}.Hex() + `"},` +
+			`"blobs_bundle":{"commitments":[],"proofs":[],"blobs":[]}}}`))
+		require.NoError(t, err)
+	})
+
+	value := math.Wei{1}
+	envelope, err := client.SubmitBlindedBlock(
+		context.Background(),
+		map[string]string{"message": "blinded"},
+		version.Deneb,
+		value,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, common.ExecutionHash{3}, envelope.GetExecutionPayload().BlockHash,
+	)
+	require.Equal(t, value, envelope.GetValue())
+	require.Empty(t, envelope.GetBlobsBundle().GetBlobs())
+	require.False(t, envelope.ShouldOverrideBuilder())
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrNoBid is returned when the relay has no bid for the requested slot.
+	ErrNoBid = errors.New("relay has no bid for the requested slot")
+
+	// ErrRelayRequestFailed is returned when the relay rejects a request.
+	ErrRelayRequestFailed = errors.New("relay request failed")
+
+	// ErrInvalidBidValue is returned when the value of a bid cannot be
+	// parsed.
+	ErrInvalidBidValue = errors.New("invalid bid value")
+
+	// ErrInvalidBidSignature is returned when a bid is not signed by the
+	// builder it names.
+	ErrInvalidBidSignature = errors.New("invalid bid signature")
+
+	// ErrUnsupportedVersion is returned when the relay responds with data
+	// for a fork that is not supported.
+	ErrUnsupportedVersion = errors.New("unsupported fork version")
+)
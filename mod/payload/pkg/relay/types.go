@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// ExecutionPayloadHeader is the header of the execution payload a bid
+// commits to.
+type ExecutionPayloadHeader interface {
+	// HashTreeRoot returns the hash tree root of the header.
+	HashTreeRoot() ([32]byte, error)
+}
+
+// ValidatorRegistration as defined in the builder-API specification.
+// https://github.com/ethereum/builder-specs/blob/main/specs/bellatrix/builder.md#validatorregistrationv1
+//
+//nolint:lll // link.
+type ValidatorRegistration struct {
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	GasLimit     uint64                  `json:"gas_limit,string"`
+	Timestamp    uint64                  `json:"timestamp,string"`
+	Pubkey       crypto.BLSPubkey        `json:"pubkey"`
+}
+
+// SignedValidatorRegistration is a ValidatorRegistration signed by the
+// validator.
+type SignedValidatorRegistration struct {
+	Message   *ValidatorRegistration `json:"message"`
+	Signature crypto.BLSSignature    `json:"signature"`
+}
+
+// BuilderBid as defined in the builder-API specification.
+// https://github.com/ethereum/builder-specs/blob/main/specs/deneb/builder.md#builderbid
+//
+//nolint:lll // link.
+type BuilderBid[ExecutionPayloadHeaderT ExecutionPayloadHeader] struct {
+	Header             ExecutionPayloadHeaderT `json:"header"`
+	BlobKZGCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+	// Value is the bid in Wei, encoded as a decimal string.
+	Value  string           `json:"value"`
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+}
+
+// GetValue returns the value of the bid in Wei.
+func (b *BuilderBid[_]) GetValue() (*big.Int, error) {
+	value, ok := new(big.Int).SetString(b.Value, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, errors.Wrapf(ErrInvalidBidValue, "value: %q", b.Value)
+	}
+	return value, nil
+}
+
+// HashTreeRoot returns the hash tree root of the bid, whose list of blob KZG
+// commitments holds at most the given number of commitments.
+func (b *BuilderBid[_]) HashTreeRoot(
+	maxBlobCommitmentsPerBlock uint64,
+) ([32]byte, error) {
+	headerRoot, err := b.Header.HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	bidValue, err := b.GetValue()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	value, err := math.NewU256LFromBigInt(bidValue)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if size := uint64(len(b.BlobKZGCommitments)); size >
+		maxBlobCommitmentsPerBlock {
+		return [32]byte{}, ssz.ErrListTooBigFn(
+			"BuilderBid.BlobKZGCommitments",
+			int(size), int(maxBlobCommitmentsPerBlock),
+		)
+	}
+
+	hh := ssz.DefaultHasherPool.Get()
+	defer ssz.DefaultHasherPool.Put(hh)
+	indx := hh.Index()
+
+	// Field (0) 'Header'
+	hh.PutBytes(headerRoot[:])
+
+	// Field (1) 'BlobKZGCommitments'
+	subIndx := hh.Index()
+	for _, commitment := range b.BlobKZGCommitments {
+		hh.PutBytes(commitment[:])
+	}
+	hh.MerkleizeWithMixin(
+		subIndx,
+		uint64(len(b.BlobKZGCommitments)),
+		maxBlobCommitmentsPerBlock,
+	)
+
+	// Field (2) 'Value'
+	hh.PutBytes(value[:])
+
+	// Field (3) 'Pubkey'
+	hh.PutBytes(b.Pubkey[:])
+
+	hh.Merkleize(indx)
+	return hh.HashRoot()
+}
+
+// SignedBuilderBid is a BuilderBid signed by the builder.
+type SignedBuilderBid[ExecutionPayloadHeaderT ExecutionPayloadHeader] struct {
+	Message   *BuilderBid[ExecutionPayloadHeaderT] `json:"message"`
+	Signature crypto.BLSSignature                  `json:"signature"`
+}
+
+// VerifySignature verifies the signature of the builder over the bid in the
+// given builder domain.
+func (b *SignedBuilderBid[_]) VerifySignature(
+	domain common.Domain,
+	maxBlobCommitmentsPerBlock uint64,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	bidRoot, err := b.Message.HashTreeRoot(maxBlobCommitmentsPerBlock)
+	if err != nil {
+		return err
+	}
+
+	// The signing root is the root of the SigningData container of the bid
+	// root and the domain.
+	signingRoot := sha256.Sum256(append(bidRoot[:], domain[:]...))
+
+	if err = signatureVerificationFn(
+		b.Message.Pubkey, signingRoot[:], b.Signature,
+	); err != nil {
+		return errors.Join(err, ErrInvalidBidSignature)
+	}
+	return nil
+}
+
+// SignedBlindedBeaconBlock is a beacon block signed by its proposer, whose
+// body commits to the execution payload of a bid through the header of the
+// payload in place of the payload itself.
+//
+//nolint:lll // struct tags.
+type SignedBlindedBeaconBlock[
+	BeaconBlockT any, ExecutionPayloadHeaderT ExecutionPayloadHeader,
+] struct {
+	// Message is the beacon block, without its execution payload.
+	Message BeaconBlockT `json:"message"`
+	// ExecutionPayloadHeader is the header of the execution payload the
+	// block commits to.
+	ExecutionPayloadHeader ExecutionPayloadHeaderT `json:"execution_payload_header"`
+	// Signature is the signature of the proposer over the block.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// ExecutionPayloadAndBlobsBundle as defined in the builder-API
+// specification. It is returned by the relay when unblinding a block.
+// https://github.com/ethereum/builder-specs/blob/main/specs/deneb/builder.md#executionpayloadandblobsbundle
+//
+//nolint:lll // link.
+type ExecutionPayloadAndBlobsBundle[ExecutionPayloadT any] struct {
+	ExecutionPayload ExecutionPayloadT `json:"execution_payload"`
+	BlobsBundle      *engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	] `json:"blobs_bundle"`
+	// value is the value of the bid the payload was revealed for.
+	value math.Wei
+}
+
+// GetExecutionPayload returns the revealed execution payload.
+func (e *ExecutionPayloadAndBlobsBundle[T]) GetExecutionPayload() T {
+	return e.ExecutionPayload
+}
+
+// GetValue returns the value of the bid the payload was revealed for.
+func (e *ExecutionPayloadAndBlobsBundle[_]) GetValue() math.Wei {
+	return e.value
+}
+
+// GetBlobsBundle returns the blobs bundle of the revealed payload.
+//
+//nolint:lll // generics.
+func (e *ExecutionPayloadAndBlobsBundle[_]) GetBlobsBundle() engineprimitives.BlobsBundle {
+	return e.BlobsBundle
+}
+
+// ShouldOverrideBuilder always returns false, since the payload was built
+// by an external builder.
+func (e *ExecutionPayloadAndBlobsBundle[_]) ShouldOverrideBuilder() bool {
+	return false
+}
+
+// versionedResponse is the envelope of the relay responses that carry
+// fork specific data.
+type versionedResponse[DataT any] struct {
+	Version string `json:"version"`
+	Data    DataT  `json:"data"`
+}
+
+// errorResponse is the body of a failed relay request.
+type errorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
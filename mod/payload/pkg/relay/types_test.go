@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/stretchr/testify/require"
+)
+
+// hashPair returns the SHA-256 hash of the concatenation of the two chunks.
+func hashPair(a, b [32]byte) [32]byte {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+// merkleizeChunks returns the root of the tree of the given chunks, padded
+// with zero chunks to the given number of leaves, a power of two.
+func merkleizeChunks(chunks [][32]byte, leaves int) [32]byte {
+	layer := make([][32]byte, leaves)
+	copy(layer, chunks)
+	for len(layer) > 1 {
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			next[i] = hashPair(layer[2*i], layer[2*i+1])
+		}
+		layer = next
+	}
+	return layer[0]
+}
+
+// bytes48Root returns the hash tree root of a 48 bytes vector.
+func bytes48Root(bz [48]byte) [32]byte {
+	var first, second [32]byte
+	copy(first[:], bz[:32])
+	copy(second[:], bz[32:])
+	return hashPair(first, second)
+}
+
+func TestSignedBuilderBid_VerifySignature(t *testing.T) {
+	const maxBlobCommitmentsPerBlock = 16
+	var (
+		domain     = common.Domain{7}
+		commitment = eip4844.KZGCommitment{5}
+		bid        = &relay.SignedBuilderBid[*testHeader]{
+			Message: &relay.BuilderBid[*testHeader]{
+				Header: &testHeader{BlockHash: common.ExecutionHash{3}},
+				BlobKZGCommitments: []eip4844.KZGCommitment{
+					commitment,
+				},
+				Value:  "1000",
+				Pubkey: crypto.BLSPubkey{4},
+			},
+			Signature: crypto.BLSSignature{6},
+		}
+	)
+
+	// Compute the signing root of the bid independently.
+	var length, value [32]byte
+	binary.LittleEndian.PutUint64(length[:], 1)
+	binary.LittleEndian.PutUint64(value[:], 1000)
+	commitmentsRoot := hashPair(
+		merkleizeChunks(
+			[][32]byte{bytes48Root(commitment)}, maxBlobCommitmentsPerBlock,
+		),
+		length,
+	)
+	bidRoot := merkleizeChunks([][32]byte{
+		common.ExecutionHash{3},
+		commitmentsRoot,
+		value,
+		bytes48Root(crypto.BLSPubkey{4}),
+	}, 4)
+	signingRoot := hashPair(bidRoot, domain)
+
+	var verified bool
+	require.NoError(t, bid.VerifySignature(
+		domain,
+		maxBlobCommitmentsPerBlock,
+		func(
+			pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+		) error {
+			verified = true
+			require.Equal(t, crypto.BLSPubkey{4}, pubkey)
+			require.Equal(t, signingRoot[:], message)
+			require.Equal(t, crypto.BLSSignature{6}, signature)
+			return nil
+		},
+	))
+	require.True(t, verified)
+}
+
+func TestSignedBuilderBid_VerifySignatureInvalid(t *testing.T) {
+	bid := &relay.SignedBuilderBid[*testHeader]{
+		Message: &relay.BuilderBid[*testHeader]{
+			Header: new(testHeader),
+			Value:  "1",
+		},
+	}
+	err := bid.VerifySignature(
+		common.Domain{},
+		16,
+		func(crypto.BLSPubkey, []byte, crypto.BLSSignature) error {
+			return relay.ErrRelayRequestFailed
+		},
+	)
+	require.ErrorIs(t, err, relay.ErrInvalidBidSignature)
+	require.ErrorIs(t, err, relay.ErrRelayRequestFailed)
+}
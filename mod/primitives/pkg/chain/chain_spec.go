@@ -51,6 +51,21 @@ type Spec[
 	// MinEpochsToInactivityPenalty returns the minimum number of epochs before
 	// an inactivity penalty is applied.
 	MinEpochsToInactivityPenalty() uint64
+	// MinValidatorWithdrawabilityDelay returns the minimum number of epochs
+	// between the exit of a validator and its withdrawability.
+	MinValidatorWithdrawabilityDelay() uint64
+	// ShardCommitteePeriod returns the minimum number of epochs a validator
+	// must be active for before it can voluntarily exit.
+	ShardCommitteePeriod() uint64
+
+	// Validator cycle.
+	//
+	// MinPerEpochChurnLimit returns the minimum number of validators that can
+	// exit per epoch.
+	MinPerEpochChurnLimit() uint64
+	// ChurnLimitQuotient returns the quotient used to derive the number of
+	// validators that can exit per epoch from the active validator count.
+	ChurnLimitQuotient() uint64
 
 	// Signature Domains
 	//
@@ -85,6 +100,9 @@ type Spec[
 	Eth1FollowDistance() uint64
 	// TargetSecondsPerEth1Block returns the target time between eth1 blocks.
 	TargetSecondsPerEth1Block() uint64
+	// EpochsPerEth1VotingPeriod returns the number of epochs in an eth1 data
+	// voting period.
+	EpochsPerEth1VotingPeriod() uint64
 
 	// Fork-related values.
 	//
@@ -112,6 +130,12 @@ type Spec[
 	// ProportionalSlashingMultiplier returns the multiplier for calculating
 	// slashing penalties.
 	ProportionalSlashingMultiplier() uint64
+	// MinSlashingPenaltyQuotient returns the quotient used to calculate the
+	// initial penalty applied to a slashed validator.
+	MinSlashingPenaltyQuotient() uint64
+	// WhistleblowerRewardQuotient returns the quotient used to calculate the
+	// reward paid out for including a slashing.
+	WhistleblowerRewardQuotient() uint64
 
 	// Capella Values
 	//
@@ -237,6 +261,38 @@ func (c chainSpec[
 	return c.Data.MinEpochsToInactivityPenalty
 }
 
+// MinValidatorWithdrawabilityDelay returns the minimum number of epochs
+// between the exit of a validator and its withdrawability.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinValidatorWithdrawabilityDelay() uint64 {
+	return c.Data.MinValidatorWithdrawabilityDelay
+}
+
+// ShardCommitteePeriod returns the minimum number of epochs a validator must
+// be active for before it can voluntarily exit.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ShardCommitteePeriod() uint64 {
+	return c.Data.ShardCommitteePeriod
+}
+
+// MinPerEpochChurnLimit returns the minimum number of validators that can exit
+// per epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinPerEpochChurnLimit() uint64 {
+	return c.Data.MinPerEpochChurnLimit
+}
+
+// ChurnLimitQuotient returns the quotient used to derive the churn limit from
+// the active validator count.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ChurnLimitQuotient() uint64 {
+	return c.Data.ChurnLimitQuotient
+}
+
 // DomainTypeProposer returns the domain for beacon proposer signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -330,6 +386,14 @@ func (c chainSpec[
 	return c.Data.TargetSecondsPerEth1Block
 }
 
+// EpochsPerEth1VotingPeriod returns the number of epochs in an eth1 data
+// voting period.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) EpochsPerEth1VotingPeriod() uint64 {
+	return c.Data.EpochsPerEth1VotingPeriod
+}
+
 // ElectraForkEpoch returns the epoch of the Electra fork.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -379,6 +443,20 @@ func (c chainSpec[
 	return c.Data.ProportionalSlashingMultiplier
 }
 
+// MinSlashingPenaltyQuotient returns the minimum slashing penalty quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinSlashingPenaltyQuotient() uint64 {
+	return c.Data.MinSlashingPenaltyQuotient
+}
+
+// WhistleblowerRewardQuotient returns the whistleblower reward quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) WhistleblowerRewardQuotient() uint64 {
+	return c.Data.WhistleblowerRewardQuotient
+}
+
 // MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
 // payload.
 func (c chainSpec[
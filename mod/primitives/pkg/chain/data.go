@@ -52,6 +52,21 @@ type SpecData[
 	// MinEpochsToInactivityPenalty is the minimum number of epochs before a
 	// validator is penalized for inactivity.
 	MinEpochsToInactivityPenalty uint64 `mapstructure:"min-epochs-to-inactivity-penalty"`
+	// MinValidatorWithdrawabilityDelay is the minimum number of epochs
+	// between the exit of a validator and its withdrawability.
+	MinValidatorWithdrawabilityDelay uint64 `mapstructure:"min-validator-withdrawability-delay"`
+	// ShardCommitteePeriod is the minimum number of epochs a validator must be
+	// active for before it can voluntarily exit.
+	ShardCommitteePeriod uint64 `mapstructure:"shard-committee-period"`
+
+	// Validator cycle.
+	//
+	// MinPerEpochChurnLimit is the minimum number of validators that can exit
+	// per epoch.
+	MinPerEpochChurnLimit uint64 `mapstructure:"min-per-epoch-churn-limit"`
+	// ChurnLimitQuotient is the quotient used to derive the churn limit from
+	// the active validator count.
+	ChurnLimitQuotient uint64 `mapstructure:"churn-limit-quotient"`
 
 	// Signature domains.
 	//
@@ -88,6 +103,9 @@ type SpecData[
 	Eth1FollowDistance uint64 `mapstructure:"eth1-follow-distance"`
 	// TargetSecondsPerEth1Block is the target time between eth1 blocks.
 	TargetSecondsPerEth1Block uint64 `mapstructure:"target-seconds-per-eth1-block"`
+	// EpochsPerEth1VotingPeriod is the number of epochs in an eth1 data
+	// voting period.
+	EpochsPerEth1VotingPeriod uint64 `mapstructure:"epochs-per-eth1-voting-period"`
 
 	// Fork-related values.
 	//
@@ -114,6 +132,12 @@ type SpecData[
 	// ProportionalSlashingMultiplier is the slashing multiplier relative to the
 	// base penalty.
 	ProportionalSlashingMultiplier uint64 `mapstructure:"proportional-slashing-multiplier"`
+	// MinSlashingPenaltyQuotient is the quotient used to calculate the initial
+	// penalty applied to a slashed validator.
+	MinSlashingPenaltyQuotient uint64 `mapstructure:"min-slashing-penalty-quotient"`
+	// WhistleblowerRewardQuotient is the quotient used to calculate the reward
+	// paid out to the proposer that includes a slashing.
+	WhistleblowerRewardQuotient uint64 `mapstructure:"whistleblower-reward-quotient"`
 
 	// Capella Values
 	//
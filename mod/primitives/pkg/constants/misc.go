@@ -31,4 +31,16 @@ const (
 	GenesisEpoch uint64 = 0
 	// FarFutureEpoch represents a far future epoch value.
 	FarFutureEpoch = ^uint64(0)
+	// DepositContractTreeDepth is the depth of the deposit contract Merkle
+	// tree.
+	DepositContractTreeDepth = 32
+	// HysteresisQuotient is the quotient used to derive the hysteresis
+	// increment from the effective balance increment.
+	HysteresisQuotient uint64 = 4
+	// HysteresisDownwardMultiplier is the number of hysteresis increments a
+	// balance must fall below the effective balance to lower it.
+	HysteresisDownwardMultiplier uint64 = 1
+	// HysteresisUpwardMultiplier is the number of hysteresis increments a
+	// balance must rise above the effective balance to raise it.
+	HysteresisUpwardMultiplier uint64 = 5
 )
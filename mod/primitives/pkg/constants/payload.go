@@ -34,6 +34,14 @@ const (
 	// MaxDepositsPerBlock is the maximum number of deposits per block.
 	MaxDepositsPerBlock uint64 = 16
 
+	// MaxProposerSlashingsPerBlock is the maximum number of proposer slashings
+	// per block.
+	MaxProposerSlashingsPerBlock uint64 = 16
+
+	// MaxVoluntaryExitsPerBlock is the maximum number of voluntary exits per
+	// block.
+	MaxVoluntaryExitsPerBlock uint64 = 16
+
 	// MaxWithdrawalsPerPayload is the maximum number of withdrawals in a
 	// execution payload.
 	MaxWithdrawalsPerPayload uint64 = 16
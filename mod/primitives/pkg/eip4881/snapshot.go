@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eip4881
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+)
+
+// DepositTreeSnapshot is a snapshot of the finalized part of the deposit
+// contract tree, as defined in EIP-4881. It holds just enough to rebuild the
+// deposit tree and keep pushing deposits to it, without replaying the
+// finalized deposits.
+//
+//nolint:lll // struct tags.
+type DepositTreeSnapshot struct {
+	// Finalized are the roots of the finalized subtrees, from left to right.
+	Finalized []common.Root `json:"finalized"`
+	// DepositRoot is the deposit root committed to by the snapshot.
+	DepositRoot common.Root `json:"deposit_root"`
+	// DepositCount is the number of finalized deposits.
+	DepositCount uint64 `json:"deposit_count,string"`
+	// ExecutionBlockHash is the hash of the execution block the deposits
+	// are finalized at.
+	ExecutionBlockHash common.ExecutionHash `json:"execution_block_hash"`
+	// ExecutionBlockHeight is the number of the execution block the
+	// deposits are finalized at.
+	ExecutionBlockHeight uint64 `json:"execution_block_height,string"`
+}
+
+// CalculateRoot computes the deposit root of the finalized deposits of the
+// snapshot, including the length mix-in.
+func (s *DepositTreeSnapshot) CalculateRoot() common.Root {
+	var (
+		size  = s.DepositCount
+		index = len(s.Finalized)
+		root  = common.Root(zero.Hashes[0])
+	)
+	for i := range constants.DepositContractTreeDepth {
+		if size&1 == 1 {
+			// A finalized deposit count beyond the finalized roots can
+			// never match the deposit root.
+			if index == 0 {
+				return common.Root{}
+			}
+			index--
+			root = HashPair(s.Finalized[index], root)
+		} else {
+			root = HashPair(root, zero.Hashes[i])
+		}
+		size >>= 1
+	}
+	return MixInLength(root, s.DepositCount)
+}
+
+// HashPair returns the hash of the concatenation of the given nodes.
+func HashPair(left, right common.Root) common.Root {
+	var bz [64]byte
+	copy(bz[:32], left[:])
+	copy(bz[32:], right[:])
+	return sha256.Sum256(bz[:])
+}
+
+// LengthMixIn returns the node mixed into the root of the deposit tree for
+// the given deposit count.
+func LengthMixIn(depositCount uint64) common.Root {
+	var node common.Root
+	binary.LittleEndian.PutUint64(node[:8], depositCount)
+	return node
+}
+
+// MixInLength mixes the given deposit count into the root of the deposit
+// tree.
+func MixInLength(root common.Root, depositCount uint64) common.Root {
+	return HashPair(root, LengthMixIn(depositCount))
+}
@@ -35,4 +35,5 @@ const (
 	BlobSidecarsProcessRequest  = "blob-sidecars-process-request"
 	BlobSidecarsProcessed       = "blob-sidecars-processed"
 	GenesisDataProcessRequest   = "genesis-data-process-request"
+	ServiceStatusUpdated        = "service-status-updated"
 )
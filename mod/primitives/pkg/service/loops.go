@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// errLoopDied is returned when a loop of a service exited before its
+// context was cancelled, or panicked.
+var errLoopDied = errors.New("loop died")
+
+// Loops runs the background loops of a service. A loop that exits before its
+// context is cancelled, or panics, is recorded as the failure of the service
+// so that it can be restarted.
+type Loops struct {
+	// wg waits for the loops to exit.
+	wg sync.WaitGroup
+	// err is the failure of the first loop that died.
+	err error
+	// mu guards err.
+	mu sync.Mutex
+}
+
+// Go runs the named loop in a new goroutine.
+func (l *Loops) Go(
+	ctx context.Context,
+	name string,
+	loop func(context.Context),
+) {
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		defer func() {
+			if r := recover(); r != nil {
+				l.fail(errors.Wrapf(errLoopDied, "%s panicked: %v", name, r))
+				return
+			}
+			if ctx.Err() == nil {
+				l.fail(errors.Wrapf(errLoopDied, "%s exited", name))
+			}
+		}()
+		loop(ctx)
+	}()
+}
+
+// Wait waits for every loop to exit.
+func (l *Loops) Wait() {
+	l.wg.Wait()
+}
+
+// Err returns the failure of the first loop that died since the last reset.
+func (l *Loops) Err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.err
+}
+
+// Reset clears the recorded failure, before the loops are started again.
+func (l *Loops) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.err = nil
+}
+
+// fail records the failure of a loop, keeping the first one.
+func (l *Loops) fail(err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.err == nil {
+		l.err = err
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoops_CancelledIsNotAFailure(t *testing.T) {
+	var loops service.Loops
+	ctx, cancel := context.WithCancel(context.Background())
+	loops.Go(ctx, "fetcher", func(ctx context.Context) { <-ctx.Done() })
+	cancel()
+	loops.Wait()
+	require.NoError(t, loops.Err())
+}
+
+func TestLoops_RecordsDeadLoops(t *testing.T) {
+	var loops service.Loops
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	loops.Go(ctx, "fetcher", func(context.Context) {})
+	loops.Wait()
+	require.ErrorContains(t, loops.Err(), "fetcher exited")
+
+	// Only the first failure is kept.
+	loops.Go(ctx, "syncer", func(context.Context) { panic("boom") })
+	loops.Wait()
+	require.ErrorContains(t, loops.Err(), "fetcher exited")
+
+	loops.Reset()
+	require.NoError(t, loops.Err())
+	loops.Go(ctx, "syncer", func(context.Context) { panic("boom") })
+	loops.Wait()
+	require.ErrorContains(t, loops.Err(), "syncer panicked: boom")
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transition
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// BlockRewards is the breakdown of the rewards earned by the proposer of a
+// block for including its contents.
+type BlockRewards struct {
+	// ProposerIndex is the index of the proposer of the block.
+	ProposerIndex math.ValidatorIndex
+	// Total is the sum of all the reward components.
+	Total math.Gwei
+	// Attestations is the reward earned for including attestations.
+	Attestations math.Gwei
+	// SyncAggregate is the reward earned for including the sync aggregate.
+	SyncAggregate math.Gwei
+	// ProposerSlashings is the reward earned for including proposer
+	// slashings.
+	ProposerSlashings math.Gwei
+	// AttesterSlashings is the reward earned for including attester
+	// slashings.
+	AttesterSlashings math.Gwei
+}
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/async/pkg/bus"
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
@@ -57,7 +58,7 @@ func (h *ABCIMiddleware[
 		return nil, err
 	}
 	// Send a request to the chain service to process the genesis data.
-	if err := h.genesisBroker.Publish(ctx, asynctypes.NewEvent(
+	if err := bus.Publish(ctx, h.eventBus, asynctypes.NewEvent(
 		ctx, events.GenesisDataProcessRequest, *data,
 	)); err != nil {
 		return nil, err
@@ -116,7 +117,7 @@ func (h *ABCIMiddleware[
 
 	// Send a request to the validator service to give us a beacon block
 	// and blob sidecards to pass to ABCI.
-	if err := h.slotBroker.Publish(ctx, asynctypes.NewEvent(
+	if err := bus.Publish(ctx, h.eventBus, asynctypes.NewEvent(
 		ctx, events.NewSlot, slot,
 	)); err != nil {
 		return nil, nil, err
@@ -233,8 +234,8 @@ func (h *ABCIMiddleware[
 	blk BeaconBlockT,
 ) error {
 	// Publish the received event.
-	if err := h.blkBroker.Publish(
-		ctx,
+	if err := bus.Publish(
+		ctx, h.eventBus,
 		asynctypes.NewEvent(ctx, events.BeaconBlockReceived, blk, nil),
 	); err != nil {
 		return err
@@ -264,8 +265,8 @@ func (h *ABCIMiddleware[
 	sidecars BlobSidecarsT,
 ) error {
 	// Publish the received event.
-	if err := h.sidecarsBroker.Publish(
-		ctx,
+	if err := bus.Publish(
+		ctx, h.eventBus,
 		asynctypes.NewEvent(ctx, events.BlobSidecarsReceived, sidecars),
 	); err != nil {
 		return err
@@ -357,7 +358,7 @@ func (h *ABCIMiddleware[
 	_, _, _, BlobSidecarsT, _, _, _,
 ]) processSidecars(ctx context.Context, blobs BlobSidecarsT) error {
 	// Publish the sidecars.
-	if err := h.sidecarsBroker.Publish(ctx, asynctypes.NewEvent(
+	if err := bus.Publish(ctx, h.eventBus, asynctypes.NewEvent(
 		ctx, events.BlobSidecarsProcessRequest, blobs,
 	)); err != nil {
 		return err
@@ -385,8 +386,8 @@ func (h *ABCIMiddleware[
 	ctx context.Context, blk BeaconBlockT,
 ) (transition.ValidatorUpdates, error) {
 	// Publish the verified block event.
-	if err := h.blkBroker.Publish(
-		ctx, asynctypes.NewEvent(
+	if err := bus.Publish(
+		ctx, h.eventBus, asynctypes.NewEvent(
 			ctx, events.BeaconBlockFinalizedRequest, blk,
 		)); err != nil {
 		return nil, err
@@ -22,15 +22,15 @@ package middleware
 
 import (
 	"context"
+	"sync"
 
-	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/async/pkg/bus"
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/p2p"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
 	rp2p "github.com/berachain/beacon-kit/mod/runtime/pkg/p2p"
@@ -74,16 +74,9 @@ type ABCIMiddleware[
 	// logger is the logger for the middleware.
 	logger log.Logger[any]
 
-	// Feeds
-	//
-	// genesisBroker is a feed for genesis data.
-	genesisBroker *broker.Broker[*asynctypes.Event[GenesisT]]
-	// blkBroker is a feed for blocks.
-	blkBroker *broker.Broker[*asynctypes.Event[BeaconBlockT]]
-	// sidecarsBroker is a feed for sidecars.
-	sidecarsBroker *broker.Broker[*asynctypes.Event[BlobSidecarsT]]
-	// slotBroker is a feed for slots.
-	slotBroker *broker.Broker[*asynctypes.Event[math.Slot]]
+	// eventBus carries the genesis, slot, block and sidecars events between
+	// the middleware and the services of the node.
+	eventBus *bus.Bus
 
 	// TODO: this is a temporary hack.
 	req *cmtabci.FinalizeBlockRequest
@@ -96,6 +89,8 @@ type ABCIMiddleware[
 	// valUpdateSub is the channel for listening for incoming validator set
 	// updates.
 	valUpdateSub chan *asynctypes.Event[transition.ValidatorUpdates]
+	// wg waits for the goroutine of the middleware to exit.
+	wg sync.WaitGroup
 }
 
 // NewABCIMiddleware creates a new instance of the Handler struct.
@@ -114,10 +109,7 @@ func NewABCIMiddleware[
 	],
 	logger log.Logger[any],
 	telemetrySink TelemetrySink,
-	genesisBroker *broker.Broker[*asynctypes.Event[GenesisT]],
-	blkBroker *broker.Broker[*asynctypes.Event[BeaconBlockT]],
-	sidecarsBroker *broker.Broker[*asynctypes.Event[BlobSidecarsT]],
-	slotBroker *broker.Broker[*asynctypes.Event[math.Slot]],
+	eventBus *bus.Bus,
 	valUpdateSub chan *asynctypes.Event[transition.ValidatorUpdates],
 ) *ABCIMiddleware[
 	AvailabilityStoreT, BeaconBlockT, BeaconStateT,
@@ -138,12 +130,9 @@ func NewABCIMiddleware[
 		](
 			chainSpec,
 		),
-		logger:         logger,
-		metrics:        newABCIMiddlewareMetrics(telemetrySink),
-		genesisBroker:  genesisBroker,
-		blkBroker:      blkBroker,
-		sidecarsBroker: sidecarsBroker,
-		slotBroker:     slotBroker,
+		logger:   logger,
+		metrics:  newABCIMiddlewareMetrics(telemetrySink),
+		eventBus: eventBus,
 		blkCh: make(
 			chan *asynctypes.Event[BeaconBlockT],
 			1,
@@ -166,19 +155,45 @@ func (am *ABCIMiddleware[
 
 // Start the middleware.
 func (am *ABCIMiddleware[
-	_, _, _, _, _, _, _,
+	_, BeaconBlockT, _, BlobSidecarsT, _, _, _,
 ]) Start(ctx context.Context) error {
-	subBlkCh, err := am.blkBroker.Subscribe()
+	subBlkCh, err := bus.Subscribe[BeaconBlockT](
+		am.eventBus, events.BeaconBlockBuilt, events.BeaconBlockVerified,
+	)
 	if err != nil {
 		return err
 	}
 
-	subSidecarsCh, err := am.sidecarsBroker.Subscribe()
+	subSidecarsCh, err := bus.Subscribe[BlobSidecarsT](
+		am.eventBus, events.BlobSidecarsBuilt, events.BlobSidecarsProcessed,
+	)
 	if err != nil {
 		return err
 	}
 
-	go am.start(ctx, subBlkCh, subSidecarsCh)
+	am.wg.Add(1)
+	go func() {
+		defer am.wg.Done()
+		defer am.eventBus.Unsubscribe(subBlkCh)
+		defer am.eventBus.Unsubscribe(subSidecarsCh)
+		am.start(ctx, subBlkCh, subSidecarsCh)
+	}()
+	return nil
+}
+
+// Stop waits for the middleware to stop forwarding events, and unsubscribes
+// it from the event bus.
+func (am *ABCIMiddleware[
+	_, _, _, _, _, _, _,
+]) Stop(context.Context) error {
+	am.wg.Wait()
+	return nil
+}
+
+// Status returns the status of the middleware.
+func (am *ABCIMiddleware[
+	_, _, _, _, _, _, _,
+]) Status() error {
 	return nil
 }
 
@@ -195,19 +210,9 @@ func (am *ABCIMiddleware[
 		case <-ctx.Done():
 			return
 		case msg := <-blkCh:
-			switch msg.Type() {
-			case events.BeaconBlockBuilt:
-				fallthrough
-			case events.BeaconBlockVerified:
-				am.blkCh <- msg
-			}
+			am.blkCh <- msg
 		case msg := <-sidecarsCh:
-			switch msg.Type() {
-			case events.BlobSidecarsBuilt:
-				fallthrough
-			case events.BlobSidecarsProcessed:
-				am.sidecarsCh <- msg
-			}
+			am.sidecarsCh <- msg
 		}
 	}
 }
@@ -43,4 +43,36 @@ var (
 	errUnknownService = func(serviceType interface{}) error {
 		return errors.Newf("unknown service: %T", serviceType)
 	}
+
+	// errUnknownDependency is returned when a service depends on a service
+	// that is not registered.
+	errUnknownDependency = func(serviceName, dependency string) error {
+		return errors.Newf(
+			"service %v depends on unknown service: %v",
+			serviceName, dependency,
+		)
+	}
+
+	// errDependencyCycle is returned when a service depends on itself,
+	// directly or through other services.
+	errDependencyCycle = func(serviceName string) error {
+		return errors.Newf("dependency cycle at service: %v", serviceName)
+	}
+
+	// errStopTimeout is returned when a service does not stop in time.
+	errStopTimeout = func(serviceName string) error {
+		return errors.Newf("service did not stop in time: %v", serviceName)
+	}
+
+	// errServiceNotRunning is returned when a service that is not running
+	// is restarted.
+	errServiceNotRunning = func(serviceName string) error {
+		return errors.Newf("service is not running: %v", serviceName)
+	}
+
+	// errServiceUnhealthy is returned when a service reported itself as
+	// unhealthy.
+	errServiceUnhealthy = func(serviceName string) error {
+		return errors.Newf("service reported unhealthy: %v", serviceName)
+	}
 )
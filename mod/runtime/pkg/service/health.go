@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package service
+
+import (
+	"context"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+)
+
+// StatusEvent is the status of a service, as reported on the status feed.
+type StatusEvent interface {
+	// Name returns the name of the service.
+	Name() string
+	// IsHealthy returns whether the service is healthy.
+	IsHealthy() bool
+}
+
+// Health is the health of the node, as a map of service name -> the error
+// the service is unhealthy with, nil if it is healthy.
+type Health map[string]error
+
+// Healthy returns whether every service of the node is healthy.
+func (h Health) Healthy() bool {
+	for _, err := range h {
+		if err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Health returns the health of the node, aggregating the status of the
+// registered services with the statuses reported on the status feed.
+func (s *Registry) Health() Health {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	health := make(Health, len(s.services)+len(s.reported))
+	for name, healthy := range s.reported {
+		if !healthy {
+			health[name] = errServiceUnhealthy(name)
+			continue
+		}
+		health[name] = nil
+	}
+	for typeName, svc := range s.services {
+		if err := svc.Status(); err != nil {
+			health[typeName] = err
+		} else if _, ok := health[typeName]; !ok {
+			health[typeName] = nil
+		}
+	}
+	return health
+}
+
+// watchStatusFeed records the statuses reported on the status feed until
+// the context is done.
+func watchStatusFeed[StatusEventT StatusEvent](
+	r *Registry,
+	feed chan *asynctypes.Event[StatusEventT],
+) func(context.Context) {
+	return func(ctx context.Context) {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-feed:
+				if !ok {
+					return
+				}
+				status := event.Data()
+				r.mu.Lock()
+				r.reported[status.Name()] = status.IsHealthy()
+				r.mu.Unlock()
+			}
+		}
+	}
+}
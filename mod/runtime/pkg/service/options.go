@@ -20,7 +20,12 @@
 
 package service
 
-import "github.com/berachain/beacon-kit/mod/log"
+import (
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log"
+)
 
 // RegistryOption is a functional option for the Registry.
 type RegistryOption func(*Registry) error
@@ -33,9 +38,69 @@ func WithLogger(logger log.Logger[any]) RegistryOption {
 	}
 }
 
-// WithService is an Option that registers a service with the Registry.
-func WithService(svc Basic) RegistryOption {
+// WithService is an Option that registers a service with the Registry,
+// along with the services it depends on.
+func WithService(svc Basic, dependencies ...Basic) RegistryOption {
+	return func(r *Registry) error {
+		return r.RegisterService(svc, dependencies...)
+	}
+}
+
+// WithStopTimeout is an Option that sets the time each service has to stop.
+func WithStopTimeout(timeout time.Duration) RegistryOption {
+	return func(r *Registry) error {
+		r.stopTimeout = timeout
+		return nil
+	}
+}
+
+// WithStatusFeed is an Option that sets the feed the services report their
+// status on, which is aggregated into the health of the node.
+func WithStatusFeed[StatusEventT StatusEvent](
+	feed chan *asynctypes.Event[StatusEventT],
+) RegistryOption {
+	return func(r *Registry) error {
+		r.watchStatus = watchStatusFeed(r, feed)
+		return nil
+	}
+}
+
+// WithRestartPolicy is an Option that supervises a registered service,
+// restarting it according to the policy whenever its status reports an
+// error.
+func WithRestartPolicy(svc Basic, policy RestartPolicy) RegistryOption {
 	return func(r *Registry) error {
-		return r.RegisterService(svc)
+		if _, ok := r.services[svc.Name()]; !ok {
+			return errUnknownService(svc)
+		}
+		r.policies[svc.Name()] = policy
+		return nil
+	}
+}
+
+// WithHealthCheckInterval is an Option that sets the interval the status of
+// the supervised services is checked at.
+func WithHealthCheckInterval(interval time.Duration) RegistryOption {
+	return func(r *Registry) error {
+		r.healthCheckInterval = interval
+		return nil
+	}
+}
+
+// WithStatusPublisher is an Option that sets the publisher the restarts of
+// the services are published as status events with.
+func WithStatusPublisher(publisher StatusPublisher) RegistryOption {
+	return func(r *Registry) error {
+		r.statusPublisher = publisher
+		return nil
+	}
+}
+
+// WithTelemetrySink is an Option that sets the telemetry sink the restarts
+// of the services are counted with.
+func WithTelemetrySink(sink TelemetrySink) RegistryOption {
+	return func(r *Registry) error {
+		r.telemetrySink = sink
+		return nil
 	}
 }
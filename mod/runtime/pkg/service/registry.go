@@ -22,15 +22,29 @@ package service
 
 import (
 	"context"
+	"io"
 	"reflect"
+	"sync"
+	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 )
 
+// defaultStopTimeout is the default time each service has to stop.
+const defaultStopTimeout = 10 * time.Second
+
 // Basic is the minimal interface for a service.
 type Basic interface {
 	// Start spawns any goroutines required by the service.
 	Start(ctx context.Context) error
+	// Stop waits for the goroutines of the service to exit. It is called once
+	// the context given to Start is cancelled, either to restart the service
+	// or to shut it down. A service holding resources releases them in Close,
+	// by implementing io.Closer, once it is stopped for good.
+	Stop(ctx context.Context) error
+	// Status returns an error if the service is unhealthy.
+	Status() error
 	// Name returns the name of the service.
 	Name() string
 }
@@ -45,12 +59,54 @@ type Registry struct {
 	services map[string]Basic
 	// serviceTypes is an ordered slice of registered service types.
 	serviceTypes []string
+	// dependencies is a map of service type -> the service types it
+	// depends on.
+	dependencies map[string][]string
+	// stopTimeout bounds the time each service has to stop.
+	stopTimeout time.Duration
+	// watchStatus consumes the status feed of the Registry, if any.
+	watchStatus func(ctx context.Context)
+	// policies is a map of supervised service type -> its restart policy.
+	policies map[string]RestartPolicy
+	// healthCheckInterval is the interval the status of the supervised
+	// services is checked at.
+	healthCheckInterval time.Duration
+	// statusPublisher publishes the restarts of the services, if any.
+	statusPublisher StatusPublisher
+	// telemetrySink counts the restarts of the services, if any.
+	telemetrySink TelemetrySink
+	// locks is a map of service type -> the lock serializing the restarts
+	// of the service with its shutdown.
+	locks map[string]*sync.Mutex
+	// stopSupervision stops the supervision of the services once closed.
+	stopSupervision chan struct{}
+	// supervisors waits for the supervisors of the services to exit.
+	supervisors sync.WaitGroup
+
+	// mu guards the running services and the reported statuses.
+	mu sync.Mutex
+	// running is the ordered slice of the started service types.
+	running []string
+	// cancels is a map of running service type -> the function cancelling
+	// the context of the service.
+	cancels map[string]context.CancelFunc
+	// reported is a map of service name -> the health last reported on the
+	// status feed.
+	reported map[string]bool
 }
 
 // NewRegistry starts a registry instance for convenience.
 func NewRegistry(opts ...RegistryOption) *Registry {
 	r := &Registry{
-		services: make(map[string]Basic),
+		services:     make(map[string]Basic),
+		dependencies: make(map[string][]string),
+		stopTimeout:  defaultStopTimeout,
+		cancels:      make(map[string]context.CancelFunc),
+		reported:     make(map[string]bool),
+		policies:     make(map[string]RestartPolicy),
+		locks:        make(map[string]*sync.Mutex),
+
+		healthCheckInterval: defaultHealthCheckInterval,
 	}
 
 	for _, opt := range opts {
@@ -61,33 +117,99 @@ func NewRegistry(opts ...RegistryOption) *Registry {
 	return r
 }
 
-// StartAll initialized each service in order of registration.
+// StartAll initialized each service after the services it depends on, in
+// order of registration otherwise. Once all services are started, the
+// services with a restart policy are supervised.
 func (s *Registry) StartAll(ctx context.Context) error {
-	s.logger.Info("Starting services", "num", len(s.serviceTypes))
-	for _, typeName := range s.serviceTypes {
+	order, err := s.startOrder()
+	if err != nil {
+		return err
+	}
+
+	if s.watchStatus != nil {
+		go s.watchStatus(ctx)
+	}
+
+	s.logger.Info("Starting services", "num", len(order))
+	for _, typeName := range order {
 		s.logger.Info("Starting service", "type", typeName)
-		svc := s.services[typeName]
-		if svc == nil {
-			s.logger.Error("service not found", "type", typeName)
-			continue
+		svcCtx, cancel := context.WithCancel(ctx)
+		s.mu.Lock()
+		s.running = append(s.running, typeName)
+		s.cancels[typeName] = cancel
+		s.mu.Unlock()
+		if err = s.services[typeName].Start(svcCtx); err != nil {
+			return err
 		}
+	}
 
-		if err := svc.Start(ctx); err != nil {
-			return err
+	stop := make(chan struct{})
+	s.stopSupervision = stop
+	for _, typeName := range order {
+		policy, ok := s.policies[typeName]
+		if !ok {
+			continue
 		}
+		s.supervisors.Add(1)
+		go func() {
+			defer s.supervisors.Done()
+			s.supervise(ctx, stop, typeName, policy)
+		}()
 	}
 	return nil
 }
 
+// StopAll stops the running services in the reverse order they were
+// started in, so that no service is stopped before the services depending
+// on it. A service that does not stop within the stop timeout is left
+// behind, without holding up the services it depends on. Every stopped
+// service implementing io.Closer is closed.
+func (s *Registry) StopAll(ctx context.Context) error {
+	if s.stopSupervision != nil {
+		close(s.stopSupervision)
+		s.stopSupervision = nil
+		defer s.supervisors.Wait()
+	}
+
+	s.mu.Lock()
+	running, cancels := s.running, s.cancels
+	s.running, s.cancels = nil, make(map[string]context.CancelFunc)
+	s.mu.Unlock()
+
+	s.logger.Info("Stopping services", "num", len(running))
+	var errs []error
+	for i := len(running) - 1; i >= 0; i-- {
+		typeName := running[i]
+		s.logger.Info("Stopping service", "type", typeName)
+		cancels[typeName]()
+		if err := s.stopService(ctx, typeName); err != nil {
+			s.logger.Error(
+				"failed to stop service", "type", typeName, "error", err,
+			)
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // RegisterService appends a service constructor function to the service
-// registry.
-func (s *Registry) RegisterService(service Basic) error {
+// registry, along with the services it depends on.
+func (s *Registry) RegisterService(
+	service Basic,
+	dependencies ...Basic,
+) error {
 	typeName := service.Name()
 	if _, exists := s.services[typeName]; exists {
 		return errServiceAlreadyExists(typeName)
 	}
 	s.services[typeName] = service
 	s.serviceTypes = append(s.serviceTypes, typeName)
+	s.locks[typeName] = new(sync.Mutex)
+	for _, dependency := range dependencies {
+		s.dependencies[typeName] = append(
+			s.dependencies[typeName], dependency.Name(),
+		)
+	}
 	return nil
 }
 
@@ -123,3 +245,81 @@ func (s *Registry) FetchService(service interface{}) error {
 	}
 	return errUnknownService(serviceType)
 }
+
+// startOrder sorts the registered services so that every service comes
+// after the services it depends on, keeping the order of registration
+// otherwise.
+func (s *Registry) startOrder() ([]string, error) {
+	var (
+		order    = make([]string, 0, len(s.serviceTypes))
+		visiting = make(map[string]bool)
+		visited  = make(map[string]bool)
+		visit    func(typeName string) error
+	)
+	visit = func(typeName string) error {
+		if visited[typeName] {
+			return nil
+		}
+		if visiting[typeName] {
+			return errDependencyCycle(typeName)
+		}
+		visiting[typeName] = true
+		for _, dependency := range s.dependencies[typeName] {
+			if _, ok := s.services[dependency]; !ok {
+				return errUnknownDependency(typeName, dependency)
+			}
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		visited[typeName] = true
+		order = append(order, typeName)
+		return nil
+	}
+
+	for _, typeName := range s.serviceTypes {
+		if err := visit(typeName); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// stopService stops the service once no restart of it is in progress, and
+// closes it if it stopped and implements io.Closer.
+func (s *Registry) stopService(ctx context.Context, typeName string) error {
+	lock := s.locks[typeName]
+	lock.Lock()
+	defer lock.Unlock()
+
+	svc := s.services[typeName]
+	if err := stopService(ctx, svc, s.stopTimeout); err != nil {
+		return err
+	}
+	if closer, ok := svc.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// stopService stops the service, giving up once the timeout elapsed.
+func stopService(
+	ctx context.Context,
+	svc Basic,
+	timeout time.Duration,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- svc.Stop(ctx)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return errStopTimeout(svc.Name())
+	}
+}
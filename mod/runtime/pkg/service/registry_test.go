@@ -22,10 +22,12 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
 
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service/mocks"
@@ -81,3 +83,130 @@ func TestRegistry_FetchService(t *testing.T) {
 		t.Errorf("Fetched service type mismatch")
 	}
 }
+
+// newService returns a mock service recording its start and stop in order.
+func newService(name string, order *[]string) *mocks.Basic {
+	svc := &mocks.Basic{}
+	svc.On("Name").Return(name)
+	svc.On("Start", mock.Anything).Return(nil).Run(func(mock.Arguments) {
+		*order = append(*order, "start "+name)
+	})
+	svc.On("Stop", mock.Anything).Return(nil).Run(func(mock.Arguments) {
+		*order = append(*order, "stop "+name)
+	})
+	svc.On("Status").Return(nil)
+	return svc
+}
+
+func TestRegistry_DependencyOrder(t *testing.T) {
+	var order []string
+	bus := newService("bus", &order)
+	store := newService("store", &order)
+	chain := newService("chain", &order)
+
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger()),
+		service.WithService(chain, bus, store),
+		service.WithService(store, bus),
+		service.WithService(bus),
+	)
+
+	require.NoError(t, registry.StartAll(context.Background()))
+	require.NoError(t, registry.StopAll(context.Background()))
+	require.Equal(t, []string{
+		"start bus", "start store", "start chain",
+		"stop chain", "stop store", "stop bus",
+	}, order)
+}
+
+func TestRegistry_InvalidDependencies(t *testing.T) {
+	var order []string
+	a, b := newService("a", &order), newService("b", &order)
+
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger()),
+		service.WithService(a, b),
+	)
+	require.ErrorContains(
+		t, registry.StartAll(context.Background()), "unknown service",
+	)
+
+	require.NoError(t, registry.RegisterService(b, a))
+	require.ErrorContains(
+		t, registry.StartAll(context.Background()), "dependency cycle",
+	)
+	require.Empty(t, order)
+}
+
+func TestRegistry_StopTimeout(t *testing.T) {
+	var order []string
+	fast := newService("fast", &order)
+	slow := &mocks.Basic{}
+	slow.On("Name").Return("slow")
+	slow.On("Start", mock.Anything).Return(nil)
+	slow.On("Stop", mock.Anything).Return(nil).Run(func(mock.Arguments) {
+		time.Sleep(time.Second)
+	})
+
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger()),
+		service.WithStopTimeout(50*time.Millisecond),
+		service.WithService(fast),
+		service.WithService(slow, fast),
+	)
+	require.NoError(t, registry.StartAll(context.Background()))
+
+	// The slow service does not hold up the shutdown of the others.
+	start := time.Now()
+	require.ErrorContains(
+		t, registry.StopAll(context.Background()), "did not stop in time",
+	)
+	require.Less(t, time.Since(start), time.Second)
+	require.Contains(t, order, "stop fast")
+}
+
+// statusEvent is the status of a service, as reported on the status feed.
+type statusEvent struct {
+	name    string
+	healthy bool
+}
+
+func (e *statusEvent) Name() string { return e.name }
+
+func (e *statusEvent) IsHealthy() bool { return e.healthy }
+
+func TestRegistry_Health(t *testing.T) {
+	var order []string
+	feed := make(chan *asynctypes.Event[*statusEvent])
+	engine := newService("engine", &order)
+	client := &mocks.Basic{}
+	client.On("Name").Return("client")
+	client.On("Start", mock.Anything).Return(nil)
+	client.On("Status").Return(errors.New("no execution client"))
+
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger()),
+		service.WithStatusFeed(feed),
+		service.WithService(engine),
+		service.WithService(client),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, registry.StartAll(ctx))
+
+	feed <- asynctypes.NewEvent(
+		ctx, "status", &statusEvent{name: "engine", healthy: false},
+	)
+	feed <- asynctypes.NewEvent(
+		ctx, "status", &statusEvent{name: "p2p", healthy: true},
+	)
+
+	require.Eventually(t, func() bool {
+		health := registry.Health()
+		return len(health) == 3 && health["engine"] != nil
+	}, time.Second, 10*time.Millisecond)
+	health := registry.Health()
+	require.False(t, health.Healthy())
+	require.Error(t, health["client"])
+	require.NoError(t, health["p2p"])
+}
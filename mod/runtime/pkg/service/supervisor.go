@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	primservice "github.com/berachain/beacon-kit/mod/primitives/pkg/service"
+)
+
+const (
+	// defaultHealthCheckInterval is the default interval the status of the
+	// supervised services is checked at.
+	defaultHealthCheckInterval = 5 * time.Second
+	// restartsMetric is the counter of the restarts of the services.
+	restartsMetric = "beacon_kit.runtime.service.restarts"
+)
+
+// RestartPolicy defines how a supervised service is restarted once its
+// status reports an error.
+type RestartPolicy struct {
+	// MaxRestarts is the number of consecutive restarts after which the
+	// service is given up on, zero for no limit.
+	MaxRestarts int
+	// InitialBackoff is the time waited before the first restart.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the time waited before a restart, which doubles with
+	// every consecutive restart. A service that stays healthy for that long
+	// after a restart is no longer considered to be restarting.
+	MaxBackoff time.Duration
+}
+
+// DefaultRestartPolicy returns the default RestartPolicy, restarting the
+// service indefinitely with a backoff from one second up to one minute.
+func DefaultRestartPolicy() RestartPolicy {
+	return RestartPolicy{
+		MaxRestarts:    0,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+	}
+}
+
+// supervise restarts the service according to its restart policy whenever
+// its status reports an error, until the supervision is stopped.
+func (s *Registry) supervise(
+	ctx context.Context,
+	stop <-chan struct{},
+	typeName string,
+	policy RestartPolicy,
+) {
+	var (
+		ticker      = time.NewTicker(s.healthCheckInterval)
+		backoff     = policy.InitialBackoff
+		restarts    int
+		lastRestart time.Time
+		restartErr  error
+	)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		err := restartErr
+		if err == nil {
+			err = s.services[typeName].Status()
+		}
+		if err == nil {
+			if restarts > 0 && time.Since(lastRestart) >= policy.MaxBackoff {
+				restarts, backoff = 0, policy.InitialBackoff
+			}
+			continue
+		}
+
+		s.publishStatus(ctx, typeName, false)
+		if policy.MaxRestarts > 0 && restarts >= policy.MaxRestarts {
+			s.logger.Error(
+				"Giving up on unhealthy service",
+				"type", typeName, "restarts", restarts, "error", err,
+			)
+			return
+		}
+
+		s.logger.Warn(
+			"Restarting unhealthy service",
+			"type", typeName, "backoff", backoff, "error", err,
+		)
+		select {
+		case <-ctx.Done():
+			return
+		case <-stop:
+			return
+		case <-time.After(backoff):
+		}
+
+		restarts++
+		lastRestart = time.Now()
+		backoff = min(2*backoff, policy.MaxBackoff)
+		restartErr = s.restart(ctx, typeName)
+		s.incrementRestarts(typeName, restartErr == nil)
+		if restartErr != nil {
+			s.logger.Error(
+				"Failed to restart service",
+				"type", typeName, "error", restartErr,
+			)
+			continue
+		}
+		s.logger.Info(
+			"Restarted service", "type", typeName, "restarts", restarts,
+		)
+		s.publishStatus(ctx, typeName, true)
+	}
+}
+
+// restart stops the service and starts it again, with a new context derived
+// from the given one.
+func (s *Registry) restart(ctx context.Context, typeName string) error {
+	lock := s.locks[typeName]
+	lock.Lock()
+	defer lock.Unlock()
+
+	svcCtx, cancel := context.WithCancel(ctx)
+	s.mu.Lock()
+	prevCancel, running := s.cancels[typeName]
+	if !running {
+		s.mu.Unlock()
+		cancel()
+		return errServiceNotRunning(typeName)
+	}
+	s.cancels[typeName] = cancel
+	s.mu.Unlock()
+
+	prevCancel()
+	svc := s.services[typeName]
+	if err := stopService(ctx, svc, s.stopTimeout); err != nil {
+		return err
+	}
+	return svc.Start(svcCtx)
+}
+
+// publishStatus publishes the health of the service, if the Registry has a
+// status publisher.
+func (s *Registry) publishStatus(
+	ctx context.Context,
+	typeName string,
+	healthy bool,
+) {
+	if s.statusPublisher == nil {
+		return
+	}
+	if err := s.statusPublisher.Publish(ctx, asynctypes.NewEvent(
+		ctx, events.ServiceStatusUpdated,
+		primservice.NewStatusEvent(typeName, healthy),
+	)); err != nil {
+		s.logger.Error(
+			"Failed to publish service status", "type", typeName, "error", err,
+		)
+	}
+}
+
+// incrementRestarts counts the restart of the service, if the Registry has
+// a telemetry sink.
+func (s *Registry) incrementRestarts(typeName string, success bool) {
+	if s.telemetrySink == nil {
+		return
+	}
+	s.telemetrySink.IncrementCounter(
+		restartsMetric,
+		"service", typeName, "success", strconv.FormatBool(success),
+	)
+}
@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package service_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	primservice "github.com/berachain/beacon-kit/mod/primitives/pkg/service"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
+	"github.com/stretchr/testify/require"
+)
+
+// loopService is a service running a loop that can be made to die.
+type loopService struct {
+	loops  primservice.Loops
+	die    chan struct{}
+	starts atomic.Int32
+	closed atomic.Bool
+}
+
+func newLoopService() *loopService {
+	return &loopService{die: make(chan struct{}, 1)}
+}
+
+func (s *loopService) Start(ctx context.Context) error {
+	s.starts.Add(1)
+	s.loops.Reset()
+	s.loops.Go(ctx, "loop", func(ctx context.Context) {
+		select {
+		case <-ctx.Done():
+		case <-s.die:
+		}
+	})
+	return nil
+}
+
+func (s *loopService) Stop(context.Context) error {
+	s.loops.Wait()
+	return nil
+}
+
+func (s *loopService) Close() error {
+	s.closed.Store(true)
+	return nil
+}
+
+func (s *loopService) Status() error { return s.loops.Err() }
+
+func (s *loopService) Name() string { return "loop" }
+
+// statusRecorder records the published status events and counted restarts.
+type statusRecorder struct {
+	mu       sync.Mutex
+	statuses []bool
+	restarts []string
+}
+
+func (r *statusRecorder) Publish(
+	_ context.Context,
+	event *asynctypes.Event[*primservice.StatusEvent],
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.statuses = append(r.statuses, event.Data().IsHealthy())
+	return nil
+}
+
+func (r *statusRecorder) IncrementCounter(_ string, args ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.restarts = append(r.restarts, args[len(args)-1])
+}
+
+func (r *statusRecorder) snapshot() ([]bool, []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]bool(nil), r.statuses...),
+		append([]string(nil), r.restarts...)
+}
+
+func newSupervisedRegistry(
+	svc service.Basic,
+	policy service.RestartPolicy,
+	recorder *statusRecorder,
+) *service.Registry {
+	return service.NewRegistry(
+		service.WithLogger(noop.NewLogger()),
+		service.WithHealthCheckInterval(5*time.Millisecond),
+		service.WithStatusPublisher(recorder),
+		service.WithTelemetrySink(recorder),
+		service.WithService(svc),
+		service.WithRestartPolicy(svc, policy),
+	)
+}
+
+func TestRegistry_RestartsDeadService(t *testing.T) {
+	svc := newLoopService()
+	recorder := new(statusRecorder)
+	registry := newSupervisedRegistry(svc, service.RestartPolicy{
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     time.Second,
+	}, recorder)
+	require.NoError(t, registry.StartAll(context.Background()))
+
+	svc.die <- struct{}{}
+	require.Eventually(t, func() bool {
+		return svc.starts.Load() == 2 && svc.Status() == nil
+	}, time.Second, 5*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		statuses, restarts := recorder.snapshot()
+		return len(statuses) == 2 && len(restarts) == 1
+	}, time.Second, 5*time.Millisecond)
+	statuses, restarts := recorder.snapshot()
+	require.Equal(t, []bool{false, true}, statuses)
+	require.Equal(t, []string{"true"}, restarts)
+
+	// Restarts do not close the service, the final shutdown does.
+	require.False(t, svc.closed.Load())
+	require.NoError(t, registry.StopAll(context.Background()))
+	require.True(t, svc.closed.Load())
+}
+
+func TestRegistry_GivesUpAfterMaxRestarts(t *testing.T) {
+	svc := newLoopService()
+	recorder := new(statusRecorder)
+	registry := newSupervisedRegistry(svc, service.RestartPolicy{
+		MaxRestarts:    2,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Second,
+	}, recorder)
+	require.NoError(t, registry.StartAll(context.Background()))
+
+	for range 3 {
+		svc.die <- struct{}{}
+	}
+	require.Eventually(t, func() bool {
+		_, restarts := recorder.snapshot()
+		return len(restarts) == 2 && svc.Status() != nil
+	}, time.Second, 5*time.Millisecond)
+
+	// The supervisor no longer restarts the service.
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, int32(3), svc.starts.Load())
+	require.Error(t, svc.Status())
+	require.NoError(t, registry.StopAll(context.Background()))
+}
+
+func TestRegistry_RestartPolicyOfUnknownService(t *testing.T) {
+	require.Panics(t, func() {
+		service.NewRegistry(
+			service.WithRestartPolicy(
+				newLoopService(), service.DefaultRestartPolicy(),
+			),
+		)
+	})
+}
+
+func TestRegistry_StopAllDuringBackoff(t *testing.T) {
+	svc := newLoopService()
+	registry := newSupervisedRegistry(svc, service.RestartPolicy{
+		InitialBackoff: time.Hour,
+		MaxBackoff:     time.Hour,
+	}, new(statusRecorder))
+	require.NoError(t, registry.StartAll(context.Background()))
+
+	svc.die <- struct{}{}
+	require.Eventually(t, func() bool {
+		return svc.Status() != nil
+	}, time.Second, 5*time.Millisecond)
+
+	start := time.Now()
+	require.NoError(t, registry.StopAll(context.Background()))
+	require.Less(t, time.Since(start), time.Second)
+	require.Equal(t, int32(1), svc.starts.Load())
+}
@@ -51,6 +51,14 @@ var (
 	// deposit limit.
 	ErrExceedsBlockDepositLimit = errors.New("block exceeds deposit limit")
 
+	// ErrDepositCountMismatch is returned when the block does not include the
+	// expected number of deposits.
+	ErrDepositCountMismatch = errors.New("deposit count mismatch")
+
+	// ErrInvalidDepositProof is returned when the Merkle proof of a deposit
+	// does not verify against the deposit root.
+	ErrInvalidDepositProof = errors.New("invalid deposit merkle proof")
+
 	// ErrRewardsLengthMismatch is returned when the length of the rewards
 	// in a block does not match the expected value.
 	ErrRewardsLengthMismatch = errors.New("rewards length mismatch")
@@ -68,6 +76,59 @@ var (
 	ErrSlashedProposer = errors.New(
 		"attempted to process a block with a slashed proposer")
 
+	// ErrExceedsBlockProposerSlashingLimit is returned when the block exceeds
+	// the proposer slashing limit.
+	ErrExceedsBlockProposerSlashingLimit = errors.New(
+		"block exceeds proposer slashing limit")
+
+	// ErrMalformedProposerSlashing is returned when a proposer slashing is
+	// missing either of its signed headers.
+	ErrMalformedProposerSlashing = errors.New(
+		"proposer slashing is missing a signed header")
+
+	// ErrProposerSlashingSlotMismatch is returned when the headers of a
+	// proposer slashing are for different slots.
+	ErrProposerSlashingSlotMismatch = errors.New(
+		"proposer slashing headers slot mismatch")
+
+	// ErrProposerSlashingProposerMismatch is returned when the headers of a
+	// proposer slashing are for different proposers.
+	ErrProposerSlashingProposerMismatch = errors.New(
+		"proposer slashing headers proposer mismatch")
+
+	// ErrProposerSlashingSameHeaders is returned when the headers of a
+	// proposer slashing are identical.
+	ErrProposerSlashingSameHeaders = errors.New(
+		"proposer slashing headers are identical")
+
+	// ErrValidatorNotSlashable is returned when a slashing is processed for a
+	// validator that is not slashable.
+	ErrValidatorNotSlashable = errors.New("validator is not slashable")
+
+	// ErrExceedsBlockVoluntaryExitLimit is returned when the block exceeds
+	// the voluntary exit limit.
+	ErrExceedsBlockVoluntaryExitLimit = errors.New(
+		"block exceeds voluntary exit limit")
+
+	// ErrValidatorNotActive is returned when a voluntary exit is processed for
+	// a validator that is not active.
+	ErrValidatorNotActive = errors.New("validator is not active")
+
+	// ErrValidatorAlreadyExiting is returned when a voluntary exit is
+	// processed for a validator that already initiated an exit.
+	ErrValidatorAlreadyExiting = errors.New(
+		"validator already initiated an exit")
+
+	// ErrVoluntaryExitNotYetValid is returned when a voluntary exit is
+	// processed before its epoch.
+	ErrVoluntaryExitNotYetValid = errors.New(
+		"voluntary exit is not yet valid")
+
+	// ErrValidatorTooYoungToExit is returned when a voluntary exit is
+	// processed for a validator that has not been active for long enough.
+	ErrValidatorTooYoungToExit = errors.New(
+		"validator has not been active long enough to exit")
+
 	// ErrStateRootMismatch is returned when the state root in a block header
 	// does not match the expected value.
 	ErrStateRootMismatch = errors.New("state root mismatch")
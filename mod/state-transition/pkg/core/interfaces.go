@@ -71,6 +71,7 @@ type ReadOnlyBeaconState[
 	GetTotalActiveBalances(uint64) (math.Gwei, error)
 	GetValidators() ([]ValidatorT, error)
 	GetTotalSlashing() (math.Gwei, error)
+	GetSlashingAtIndex(uint64) (math.Gwei, error)
 	GetNextWithdrawalIndex() (uint64, error)
 	GetNextWithdrawalValidatorIndex() (math.ValidatorIndex, error)
 	GetTotalValidators() (uint64, error)
@@ -168,6 +169,8 @@ type ReadOnlyValidators[ValidatorT any] interface {
 // WriteOnlyEth1Data has write access to eth1 data.
 type WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	SetEth1Data(Eth1DataT) error
+	AddEth1DataVote(Eth1DataT) error
+	ResetEth1DataVotes() error
 	SetEth1DepositIndex(uint64) error
 	SetLatestExecutionPayloadHeader(
 		ExecutionPayloadHeaderT,
@@ -177,6 +180,7 @@ type WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 // ReadOnlyEth1Data has read access to eth1 data.
 type ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	GetEth1Data() (Eth1DataT, error)
+	GetEth1DataVotes() ([]Eth1DataT, error)
 	GetEth1DepositIndex() (uint64, error)
 	GetLatestExecutionPayloadHeader() (
 		ExecutionPayloadHeaderT, error,
@@ -90,6 +90,12 @@ type KVStore[
 	GetEth1Data() (Eth1DataT, error)
 	// SetEth1Data sets the eth1 data.
 	SetEth1Data(data Eth1DataT) error
+	// GetEth1DataVotes retrieves the eth1 data votes of the voting period.
+	GetEth1DataVotes() ([]Eth1DataT, error)
+	// AddEth1DataVote appends a vote to the eth1 data votes.
+	AddEth1DataVote(vote Eth1DataT) error
+	// ResetEth1DataVotes clears the eth1 data votes.
+	ResetEth1DataVotes() error
 	// GetValidators retrieves all validators.
 	GetValidators() ([]ValidatorT, error)
 	// GetBalances retrieves all balances.
@@ -347,6 +347,11 @@ func (s *StateDB[
 		return [32]byte{}, err
 	}
 
+	eth1DataVotes, err := s.GetEth1DataVotes()
+	if err != nil {
+		return [32]byte{}, err
+	}
+
 	eth1DepositIndex, err := s.GetEth1DepositIndex()
 	if err != nil {
 		return [32]byte{}, err
@@ -400,6 +405,7 @@ func (s *StateDB[
 		blockRoots,
 		stateRoots,
 		eth1Data,
+		eth1DataVotes,
 		eth1DepositIndex,
 		latestExecutionPayloadHeader,
 		validators,
@@ -47,6 +47,7 @@ type BeaconStateMarshallable[
 		blockRoots []common.Bytes32,
 		stateRoots []common.Bytes32,
 		eth1Data Eth1DataT,
+		eth1DataVotes []Eth1DataT,
 		eth1DepositIndex uint64,
 		latestExecutionPayloadHeader ExecutionPayloadHeaderT,
 		validators []ValidatorT,
@@ -35,12 +35,14 @@ import (
 // main state transition for the beacon chain.
 type StateProcessor[
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
+		DepositT, BeaconBlockBodyT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ProposerSlashingT, VoluntaryExitT, WithdrawalT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
+		BeaconBlockBodyT, DepositT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ProposerSlashingT, VoluntaryExitT, WithdrawalT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -54,6 +56,8 @@ type StateProcessor[
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
+		GetDepositRoot() common.Root
+		Equals(Eth1DataT) bool
 	},
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
@@ -64,7 +68,9 @@ type StateProcessor[
 		New(common.Version, common.Version, math.Epoch) ForkT
 	},
 	ForkDataT ForkData[ForkDataT],
+	ProposerSlashingT ProposerSlashing[BeaconBlockHeaderT, ForkDataT],
 	ValidatorT Validator[ValidatorT, WithdrawalCredentialsT],
+	VoluntaryExitT VoluntaryExit[ForkDataT],
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalCredentialsT ~[32]byte,
 ] struct {
@@ -83,14 +89,15 @@ type StateProcessor[
 // NewStateProcessor creates a new state processor.
 func NewStateProcessor[
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
+		DepositT, BeaconBlockBodyT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ProposerSlashingT, VoluntaryExitT, WithdrawalT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
 		BeaconBlockBodyT,
-		DepositT, ExecutionPayloadT,
+		DepositT, Eth1DataT, ExecutionPayloadT,
 		ExecutionPayloadHeaderT,
-		WithdrawalT,
+		ProposerSlashingT, VoluntaryExitT, WithdrawalT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -103,6 +110,8 @@ func NewStateProcessor[
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
+		GetDepositRoot() common.Root
+		Equals(Eth1DataT) bool
 	},
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
@@ -112,7 +121,9 @@ func NewStateProcessor[
 		New(common.Version, common.Version, math.Epoch) ForkT
 	},
 	ForkDataT ForkData[ForkDataT],
+	ProposerSlashingT ProposerSlashing[BeaconBlockHeaderT, ForkDataT],
 	ValidatorT Validator[ValidatorT, WithdrawalCredentialsT],
+	VoluntaryExitT VoluntaryExit[ForkDataT],
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalCredentialsT ~[32]byte,
 ](
@@ -125,14 +136,15 @@ func NewStateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ] {
 	return &StateProcessor[
 		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, BlobSidecarsT, ContextT,
 		DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-		ForkT, ForkDataT, ValidatorT, WithdrawalT,
-		WithdrawalCredentialsT,
+		ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+		WithdrawalT, WithdrawalCredentialsT,
 	]{
 		cs:              cs,
 		executionEngine: executionEngine,
@@ -148,7 +160,8 @@ func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+	ForkT, ForkDataT, ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	WithdrawalT, WithdrawalCredentialsT,
 ]) Transition(
 	ctx ContextT,
 	st BeaconStateT,
@@ -176,7 +189,7 @@ func (sp *StateProcessor[
 }
 
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) ProcessSlots(
 	st BeaconStateT, slot math.U64,
 ) (transition.ValidatorUpdates, error) {
@@ -221,7 +234,7 @@ func (sp *StateProcessor[
 
 // processSlot is run when a slot is missed.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processSlot(
 	st BeaconStateT,
 ) error {
@@ -278,7 +291,8 @@ func (sp *StateProcessor[
 // ProcessBlock processes the block, it optionally verifies the
 // state root.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, _, ContextT, _, _, _, _, _, _, _, _, _,
+	BeaconBlockT, _, _, BeaconStateT, _, ContextT, _, _, _, _, _, _, _, _, _, _,
+	_,
 ]) ProcessBlock(
 	ctx ContextT,
 	st BeaconStateT,
@@ -303,9 +317,15 @@ func (sp *StateProcessor[
 		return err
 	}
 
+	// process the proposer slashings.
+	if err := sp.processProposerSlashings(
+		st, blk.GetBody(), blk.GetProposerIndex(),
+	); err != nil {
+		return err
+	}
+
 	// TODO:
 	//
-	// phase0.ProcessProposerSlashings
 	// phase0.ProcessAttesterSlashings
 
 	// process the randao reveal.
@@ -315,9 +335,10 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	// TODO:
-	//
-	// phase0.ProcessEth1Vote
+	// process the eth1 data vote.
+	if err := sp.processEth1Data(st, blk); err != nil {
+		return err
+	}
 
 	// process the deposits and ensure they match the local state.
 	if err := sp.processOperations(st, blk); err != nil {
@@ -346,12 +367,18 @@ func (sp *StateProcessor[
 
 // processEpoch processes the epoch and ensures it matches the local state.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processEpoch(
 	st BeaconStateT,
 ) (transition.ValidatorUpdates, error) {
 	if err := sp.processRewardsAndPenalties(st); err != nil {
 		return nil, err
+	} else if err = sp.processSlashings(st); err != nil {
+		return nil, err
+	} else if err = sp.processEth1DataReset(st); err != nil {
+		return nil, err
+	} else if err = sp.processEffectiveBalanceUpdates(st); err != nil {
+		return nil, err
 	} else if err = sp.processSlashingsReset(st); err != nil {
 		return nil, err
 	} else if err = sp.processRandaoMixesReset(st); err != nil {
@@ -364,7 +391,7 @@ func (sp *StateProcessor[
 // state.
 func (sp *StateProcessor[
 	BeaconBlockT, _, BeaconBlockHeaderT, BeaconStateT,
-	_, _, _, _, _, _, _, _, ValidatorT, _, _,
+	_, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
 ]) processBlockHeader(
 	st BeaconStateT,
 	blk BeaconBlockT,
@@ -450,7 +477,7 @@ func (sp *StateProcessor[
 //
 //nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) getAttestationDeltas(
 	st BeaconStateT,
 ) ([]math.Gwei, []math.Gwei, error) {
@@ -468,7 +495,7 @@ func (sp *StateProcessor[
 //
 //nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processRewardsAndPenalties(
 	st BeaconStateT,
 ) error {
@@ -22,12 +22,14 @@ package core
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
-	"github.com/sourcegraph/conc/iter"
 )
 
-// processSyncCommitteeUpdates processes the sync committee updates.
+// processSyncCommitteeUpdates processes the sync committee updates. Validators
+// exiting at the next epoch are removed from the CometBFT validator set by
+// setting their voting power to zero, and are left out of the updates once
+// they have exited.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processSyncCommitteeUpdates(
 	st BeaconStateT,
 ) (transition.ValidatorUpdates, error) {
@@ -36,14 +38,28 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
-	return iter.MapErr(
-		vals,
-		func(val *ValidatorT) (*transition.ValidatorUpdate, error) {
-			v := (*val)
-			return &transition.ValidatorUpdate{
-				Pubkey:           v.GetPubkey(),
-				EffectiveBalance: v.GetEffectiveBalance(),
-			}, nil
-		},
-	)
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	updates := make(transition.ValidatorUpdates, 0, len(vals))
+	for _, val := range vals {
+		switch exitEpoch := val.GetExitEpoch(); {
+		case exitEpoch <= epoch:
+			continue
+		case exitEpoch == epoch+1:
+			updates = append(updates, &transition.ValidatorUpdate{
+				Pubkey:           val.GetPubkey(),
+				EffectiveBalance: 0,
+			})
+		default:
+			updates = append(updates, &transition.ValidatorUpdate{
+				Pubkey:           val.GetPubkey(),
+				EffectiveBalance: val.GetEffectiveBalance(),
+			})
+		}
+	}
+	return updates, nil
 }
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+// processEth1Data as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#eth1-data
+//
+//nolint:lll
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processEth1Data(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	vote := blk.GetBody().GetEth1Data()
+	if err := st.AddEth1DataVote(vote); err != nil {
+		return err
+	}
+
+	votes, err := st.GetEth1DataVotes()
+	if err != nil {
+		return err
+	}
+
+	// Adopt the eth1 data once a strict majority of the voting period has
+	// voted for it.
+	var count uint64
+	for _, v := range votes {
+		if v.Equals(vote) {
+			count++
+		}
+	}
+	if count*2 <= sp.cs.EpochsPerEth1VotingPeriod()*sp.cs.SlotsPerEpoch() {
+		return nil
+	}
+	return st.SetEth1Data(vote)
+}
+
+// processEth1DataReset as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#eth1-data-votes-updates
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processEth1DataReset(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	// Reset the votes at the end of each voting period.
+	nextEpoch := sp.cs.SlotToEpoch(slot) + 1
+	if uint64(nextEpoch)%sp.cs.EpochsPerEth1VotingPeriod() != 0 {
+		return nil
+	}
+	return st.ResetEth1DataVotes()
+}
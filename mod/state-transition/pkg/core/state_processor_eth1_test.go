@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateProcessor_ProcessEth1Data(t *testing.T) {
+	const (
+		genesisDeposits = 2
+		totalDeposits   = 4
+		// majority is the number of votes needed to adopt the eth1 data.
+		majority = testEpochsPerEth1VotingPeriod*testSlotsPerEpoch/2 + 1
+	)
+
+	var (
+		sp       = newTestStateProcessor(1)
+		deposits = newTestDeposits(0, totalDeposits)
+		st       = newGenesisState(t, deposits[:genesisDeposits])
+		genesis  = *st.Eth1Data
+		ctx      = &transition.Context{
+			SkipPayloadVerification: true,
+			SkipValidateRandao:      true,
+			SkipValidateResult:      true,
+		}
+	)
+
+	// Vote for the eth1 data committing to the pending deposits.
+	vote := (&types.Eth1Data{}).New(
+		proveTestDeposits(t, deposits),
+		totalDeposits,
+		common.ExecutionHash{0x01},
+	)
+
+	// The eth1 data is kept until a majority of the voting period votes for
+	// the same eth1 data.
+	for slot := math.Slot(1); slot < majority; slot++ {
+		_, err := sp.ProcessSlots(st, slot)
+		require.NoError(t, err)
+		require.NoError(t, sp.ProcessBlock(
+			ctx, st, newTestBlock(t, st, vote, nil),
+		))
+		require.Equal(t, &genesis, st.Eth1Data)
+	}
+
+	// A block adopting the eth1 data must include the deposits it commits to.
+	_, err := sp.ProcessSlots(st, majority)
+	require.NoError(t, err)
+	require.ErrorIs(t, sp.ProcessBlock(
+		ctx, st.Copy(), newTestBlock(t, st, vote, nil),
+	), core.ErrDepositCountMismatch)
+
+	require.NoError(t, sp.ProcessBlock(
+		ctx, st, newTestBlock(t, st, vote, deposits[genesisDeposits:]),
+	))
+	require.Equal(t, vote, st.Eth1Data)
+	require.Len(t, st.Eth1DataVotes, majority)
+	require.Len(t, st.Validators, totalDeposits)
+
+	// The votes are reset at the end of the voting period.
+	_, err = sp.ProcessSlots(
+		st, testEpochsPerEth1VotingPeriod*testSlotsPerEpoch,
+	)
+	require.NoError(t, err)
+	require.Empty(t, st.Eth1DataVotes)
+	require.Equal(t, vote, st.Eth1Data)
+}
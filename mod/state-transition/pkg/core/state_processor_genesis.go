@@ -24,6 +24,8 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz/merkleizer"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
@@ -34,7 +36,7 @@ import (
 //nolint:gocognit,funlen // todo fix.
 func (sp *StateProcessor[
 	_, BeaconBlockBodyT, BeaconBlockHeaderT, BeaconStateT, _, _, DepositT,
-	Eth1DataT, _, ExecutionPayloadHeaderT, ForkT, _, ValidatorT, _, _,
+	Eth1DataT, _, ExecutionPayloadHeaderT, ForkT, _, _, ValidatorT, _, _, _,
 ]) InitializePreminedBeaconStateFromEth1(
 	st BeaconStateT,
 	deposits []DepositT,
@@ -65,9 +67,16 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
-	if err := st.SetEth1Data(eth1Data.New(
-		common.Bytes32(common.ZeroHash),
-		0,
+	// Commit to the genesis deposits in the eth1 data, so that each of them
+	// is processed against its proof like any other deposit.
+	depositRoot, err := sp.proveGenesisDeposits(deposits)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = st.SetEth1Data(eth1Data.New(
+		depositRoot,
+		math.U64(len(deposits)),
 		executionPayloadHeader.GetBlockHash(),
 	)); err != nil {
 		return nil, err
@@ -97,7 +106,6 @@ func (sp *StateProcessor[
 	}
 
 	for _, deposit := range deposits {
-		// TODO: process deposits into eth1 data.
 		if err = sp.processDeposit(st, deposit); err != nil {
 			return nil, err
 		}
@@ -110,6 +118,28 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
+	// Genesis deposits may top up a validator, so the effective balances
+	// are computed from the balances once every deposit is applied.
+	var (
+		balance             math.Gwei
+		increment           = math.Gwei(sp.cs.EffectiveBalanceIncrement())
+		maxEffectiveBalance = math.Gwei(sp.cs.MaxEffectiveBalance())
+	)
+	for idx, val := range validators {
+		balance, err = st.GetBalance(math.ValidatorIndex(idx))
+		if err != nil {
+			return nil, err
+		}
+		val.SetEffectiveBalance(
+			min(balance-balance%increment, maxEffectiveBalance),
+		)
+		if err = st.UpdateValidatorAtIndex(
+			math.ValidatorIndex(idx), val,
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	var validatorsRoot common.Root
 	merkleizer := merkleizer.New[common.ChainSpec, [32]byte, ValidatorT]()
 	validatorsRoot, err = merkleizer.MerkleizeListComposite(
@@ -163,3 +193,47 @@ func (sp *StateProcessor[
 	st.Save()
 	return updates, nil
 }
+
+// proveGenesisDeposits builds the deposit tree from the genesis deposits,
+// sets the proof of each deposit against it and returns its root.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, DepositT, _, _, _, _, _, _, _, _, _, _,
+]) proveGenesisDeposits(
+	deposits []DepositT,
+) (common.Root, error) {
+	var err error
+	if len(deposits) == 0 {
+		return merkle.MixinLength(
+			common.Root(zero.Hashes[constants.DepositContractTreeDepth]), 0,
+		), nil
+	}
+
+	leaves := make([]common.Root, len(deposits))
+	for i, deposit := range deposits {
+		if leaves[i], err = deposit.GetDataRoot(); err != nil {
+			return common.Root{}, err
+		}
+	}
+
+	tree, err := merkle.NewTreeFromLeavesWithDepth(
+		leaves, constants.DepositContractTreeDepth,
+	)
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	for i, deposit := range deposits {
+		var branch [][32]byte
+		if branch, err = tree.MerkleProofWithMixin(uint64(i)); err != nil {
+			return common.Root{}, err
+		}
+
+		proof := make([]common.Root, len(branch))
+		for j, node := range branch {
+			proof[j] = node
+		}
+		deposit.SetProof(proof)
+	}
+
+	return tree.HashTreeRoot()
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// ComputeBlockRewards re-executes the given block on top of its pre-state
+// and returns the rewards earned by its proposer, broken down by component.
+// Each component is measured as the change in the proposer's balance caused
+// by the operations it covers. The given state is mutated, so callers must
+// pass in a copy of the pre-state if it is to be reused.
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ComputeBlockRewards(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) (*transition.BlockRewards, error) {
+	var (
+		err           error
+		proposerIndex = blk.GetProposerIndex()
+		rewards       = &transition.BlockRewards{
+			ProposerIndex: proposerIndex,
+		}
+	)
+
+	// Bring the pre-state up to the slot of the block, so that the block
+	// is processed exactly as it was during the state transition.
+	if _, err = sp.ProcessSlots(st, blk.GetSlot()); err != nil {
+		return nil, err
+	}
+
+	// Ensure the block applies on top of the given state.
+	if err = sp.processBlockHeader(st, blk); err != nil {
+		return nil, err
+	}
+
+	// Attestations, sync aggregates and attester slashings are not yet
+	// supported, so their reward components are always zero.
+	if rewards.ProposerSlashings, err = sp.proposerBalanceDiff(
+		st, proposerIndex, func() error {
+			return sp.processProposerSlashings(
+				st, blk.GetBody(), proposerIndex,
+			)
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	rewards.Total = rewards.Attestations +
+		rewards.SyncAggregate +
+		rewards.ProposerSlashings +
+		rewards.AttesterSlashings
+	return rewards, nil
+}
+
+// proposerBalanceDiff runs the given operation and returns the amount by
+// which it increased the balance of the proposer. A decrease in balance is
+// not a reward, and is reported as zero.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) proposerBalanceDiff(
+	st BeaconStateT,
+	proposerIndex math.ValidatorIndex,
+	operation func() error,
+) (math.Gwei, error) {
+	before, err := st.GetBalance(proposerIndex)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = operation(); err != nil {
+		return 0, err
+	}
+
+	after, err := st.GetBalance(proposerIndex)
+	if err != nil {
+		return 0, err
+	}
+
+	if after < before {
+		return 0, nil
+	}
+	return after - before, nil
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestProposerSlashing returns a proposer slashing of the validator at the
+// given index, made of two conflicting headers for the given slot.
+func newTestProposerSlashing(
+	slot math.Slot,
+	idx math.ValidatorIndex,
+) *types.ProposerSlashing {
+	return types.NewProposerSlashing(
+		&types.SignedBeaconBlockHeader{
+			Header: types.NewBeaconBlockHeader(
+				slot, idx, common.Root{}, common.Root{}, common.Root{0x01},
+			),
+		},
+		&types.SignedBeaconBlockHeader{
+			Header: types.NewBeaconBlockHeader(
+				slot, idx, common.Root{}, common.Root{}, common.Root{0x02},
+			),
+		},
+	)
+}
+
+func TestStateProcessor_ComputeBlockRewards(t *testing.T) {
+	tests := []struct {
+		name              string
+		proposerSlashings []*types.ProposerSlashing
+		expected          math.Gwei
+	}{
+		{
+			name:     "empty block",
+			expected: 0,
+		},
+		{
+			name: "single proposer slashing",
+			proposerSlashings: []*types.ProposerSlashing{
+				newTestProposerSlashing(0, 1),
+			},
+			// The whistleblower reward is 32e9 / 512.
+			expected: 62_500_000,
+		},
+		{
+			name: "multiple proposer slashings",
+			proposerSlashings: []*types.ProposerSlashing{
+				newTestProposerSlashing(0, 1),
+				newTestProposerSlashing(0, 2),
+			},
+			expected: 125_000_000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sp := newTestStateProcessor(1)
+			preState := newGenesisState(t, newTestDeposits(0, 3))
+
+			// Build the block for the next slot on top of an advanced copy
+			// of the pre-state.
+			st, ok := preState.Copy().(*denebState)
+			require.True(t, ok)
+			_, err := sp.ProcessSlots(st, 1)
+			require.NoError(t, err)
+			blk := newTestBlock(t, st, st.Eth1Data, nil)
+			blk.GetBody().SetProposerSlashings(tt.proposerSlashings)
+
+			rewards, err := sp.ComputeBlockRewards(preState.Copy(), blk)
+			require.NoError(t, err)
+			require.Equal(t, blk.GetProposerIndex(), rewards.ProposerIndex)
+			require.Equal(t, tt.expected, rewards.ProposerSlashings)
+			require.Equal(t, tt.expected, rewards.Total)
+			require.Zero(t, rewards.Attestations)
+			require.Zero(t, rewards.SyncAggregate)
+			require.Zero(t, rewards.AttesterSlashings)
+		})
+	}
+}
+
+func TestStateProcessor_ComputeBlockRewardsWrongPreState(t *testing.T) {
+	sp := newTestStateProcessor(1)
+	st := newGenesisState(t, newTestDeposits(0, 3))
+	_, err := sp.ProcessSlots(st, 1)
+	require.NoError(t, err)
+
+	blk := newTestBlock(t, st, st.Eth1Data, nil)
+
+	// The block does not build on top of a state with other validators.
+	_, err = sp.ComputeBlockRewards(
+		newGenesisState(t, newTestDeposits(3, 3)), blk,
+	)
+	require.ErrorIs(t, err, core.ErrParentRootMismatch)
+}
@@ -21,7 +21,11 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // processSlashingsReset as defined in the Ethereum 2.0 specification.
@@ -29,7 +33,7 @@ import (
 //
 //nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processSlashingsReset(
 	st BeaconStateT,
 ) error {
@@ -43,17 +47,139 @@ func (sp *StateProcessor[
 	return st.UpdateSlashingAtIndex(index, 0)
 }
 
+// processProposerSlashings processes the proposer slashings included in the
+// block body.
+func (sp *StateProcessor[
+	_, BeaconBlockBodyT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processProposerSlashings(
+	st BeaconStateT,
+	body BeaconBlockBodyT,
+	proposerIndex math.ValidatorIndex,
+) error {
+	proposerSlashings := body.GetProposerSlashings()
+	if uint64(
+		len(proposerSlashings),
+	) > constants.MaxProposerSlashingsPerBlock {
+		return errors.Wrapf(
+			ErrExceedsBlockProposerSlashingLimit, "expected: %d, got: %d",
+			constants.MaxProposerSlashingsPerBlock, len(proposerSlashings),
+		)
+	}
+
+	for _, ps := range proposerSlashings {
+		if err := sp.processProposerSlashing(
+			st, ps, proposerIndex,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // processProposerSlashing as defined in the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#proposer-slashings
 //
-//nolint:lll,unused // will be used later
+//nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ProposerSlashingT,
+	_, _, _, _,
 ]) processProposerSlashing(
-	_ BeaconStateT,
-	// ps ProposerSlashing,
+	st BeaconStateT,
+	ps ProposerSlashingT,
+	whistleblowerIndex math.ValidatorIndex,
 ) error {
-	return nil
+	if err := sp.ValidateProposerSlashing(st, ps); err != nil {
+		return err
+	}
+	return sp.slashValidator(
+		st, ps.GetHeader1().GetProposerIndex(), whistleblowerIndex,
+	)
+}
+
+// ValidateProposerSlashing returns an error if the proposer slashing can
+// not be included in a block on top of the given state. The state is not
+// modified.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, ForkDataT, ProposerSlashingT,
+	_, _, _, _,
+]) ValidateProposerSlashing(
+	st BeaconStateT,
+	ps ProposerSlashingT,
+) error {
+	if ps.IsNil() {
+		return ErrMalformedProposerSlashing
+	}
+	header1, header2 := ps.GetHeader1(), ps.GetHeader2()
+
+	// Verify the headers are for the same slot.
+	if header1.GetSlot() != header2.GetSlot() {
+		return errors.Wrapf(
+			ErrProposerSlashingSlotMismatch, "header 1: %d, header 2: %d",
+			header1.GetSlot(), header2.GetSlot(),
+		)
+	}
+
+	// Verify the headers are for the same proposer.
+	proposerIndex := header1.GetProposerIndex()
+	if proposerIndex != header2.GetProposerIndex() {
+		return errors.Wrapf(
+			ErrProposerSlashingProposerMismatch, "header 1: %d, header 2: %d",
+			proposerIndex, header2.GetProposerIndex(),
+		)
+	}
+
+	// Verify the headers are different.
+	root1, err := header1.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	root2, err := header2.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if root1 == root2 {
+		return errors.Wrapf(
+			ErrProposerSlashingSameHeaders, "root: %s", common.Root(root1),
+		)
+	}
+
+	// Verify the proposer is slashable.
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	proposer, err := st.ValidatorByIndex(proposerIndex)
+	if err != nil {
+		return err
+	}
+
+	if !proposer.IsSlashable(sp.cs.SlotToEpoch(slot)) {
+		return errors.Wrapf(
+			ErrValidatorNotSlashable, "index: %d", proposerIndex,
+		)
+	}
+
+	// Verify the signatures of both headers.
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+
+	var fd ForkDataT
+	fd = fd.New(
+		version.FromUint32[common.Version](
+			sp.cs.ActiveForkVersionForEpoch(
+				sp.cs.SlotToEpoch(header1.GetSlot()),
+			),
+		), genesisValidatorsRoot,
+	)
+	return ps.VerifySignatures(
+		fd,
+		sp.cs.DomainTypeProposer(),
+		proposer.GetPubkey(),
+		sp.signer.VerifySignature,
+	)
 }
 
 // processAttesterSlashing as defined in the Ethereum 2.0 specification.
@@ -61,7 +187,7 @@ func (sp *StateProcessor[
 //
 //nolint:lll,unused // will be used later
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processAttesterSlashing(
 	_ BeaconStateT,
 	// as AttesterSlashing,
@@ -69,15 +195,84 @@ func (sp *StateProcessor[
 	return nil
 }
 
+// slashValidator as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slash_validator
+//
+// The whistleblower is always the proposer of the block that includes the
+// slashing, so the proposer receives the full whistleblower reward.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) slashValidator(
+	st BeaconStateT,
+	slashedIndex math.ValidatorIndex,
+	whistleblowerIndex math.ValidatorIndex,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	if err = sp.initiateValidatorExit(st, slashedIndex); err != nil {
+		return err
+	}
+
+	val, err := st.ValidatorByIndex(slashedIndex)
+	if err != nil {
+		return err
+	}
+
+	val.SetSlashed(true)
+	val.SetWithdrawableEpoch(max(
+		val.GetWithdrawableEpoch(),
+		epoch+math.Epoch(sp.cs.EpochsPerSlashingsVector()),
+	))
+	if err = st.UpdateValidatorAtIndex(slashedIndex, val); err != nil {
+		return err
+	}
+
+	// Record the slashed balance so that it contributes to the correlated
+	// penalty applied in processSlashings.
+	index := uint64(epoch) % sp.cs.EpochsPerSlashingsVector()
+	slashing, err := st.GetSlashingAtIndex(index)
+	if err != nil {
+		return err
+	}
+	if err = st.UpdateSlashingAtIndex(
+		index, slashing+val.GetEffectiveBalance(),
+	); err != nil {
+		return err
+	}
+
+	// Apply the initial penalty.
+	if err = st.DecreaseBalance(
+		slashedIndex,
+		val.GetEffectiveBalance()/math.Gwei(sp.cs.MinSlashingPenaltyQuotient()),
+	); err != nil {
+		return err
+	}
+
+	// Reward the whistleblower.
+	return st.IncreaseBalance(
+		whistleblowerIndex,
+		val.GetEffectiveBalance()/math.Gwei(
+			sp.cs.WhistleblowerRewardQuotient(),
+		),
+	)
+}
+
 // processSlashings as defined in the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slashings
 //
-// processSlashings processes the slashings and ensures they match the local
-// state.
+// processSlashings applies the correlated penalty to every slashed validator
+// that is halfway through its withdrawability delay, proportionally to the
+// total balance slashed within the slashings vector.
 //
-//nolint:lll,unused // will be used later
+//nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processSlashings(
 	st BeaconStateT,
 ) error {
@@ -86,6 +281,12 @@ func (sp *StateProcessor[
 		return err
 	}
 
+	// Without any active balance there is nothing to weigh the penalty
+	// against.
+	if totalBalance == 0 {
+		return nil
+	}
+
 	totalSlashings, err := st.GetTotalSlashing()
 	if err != nil {
 		return err
@@ -108,31 +309,34 @@ func (sp *StateProcessor[
 	}
 
 	//nolint:mnd // this is in the spec
-	slashableEpoch := (uint64(sp.cs.SlotToEpoch(slot)) + sp.cs.EpochsPerSlashingsVector()) / 2
+	slashableEpoch := sp.cs.SlotToEpoch(slot) +
+		math.Epoch(sp.cs.EpochsPerSlashingsVector()/2)
 
 	// Iterate through the validators and slash if needed.
-	for _, val := range vals {
-		if val.IsSlashed() &&
-			(slashableEpoch == uint64(val.GetWithdrawableEpoch())) {
-			if err = sp.processSlash(
-				st, val,
-				adjustedTotalSlashingBalance,
-				uint64(totalBalance),
-			); err != nil {
-				return err
-			}
+	for idx, val := range vals {
+		if !val.IsSlashed() || val.GetWithdrawableEpoch() != slashableEpoch {
+			continue
+		}
+
+		if err = sp.processSlash(
+			st,
+			math.ValidatorIndex(idx),
+			val,
+			adjustedTotalSlashingBalance,
+			uint64(totalBalance),
+		); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-// processSlash handles the logic for slashing a validator.
-//
-//nolint:unused // will be used later
+// processSlash applies the correlated slashing penalty to a validator.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
 ]) processSlash(
 	st BeaconStateT,
+	idx math.ValidatorIndex,
 	val ValidatorT,
 	adjustedTotalSlashingBalance uint64,
 	totalBalance uint64,
@@ -143,11 +347,5 @@ func (sp *StateProcessor[
 	penaltyNumerator := balDivIncrement * adjustedTotalSlashingBalance
 	penalty := penaltyNumerator / totalBalance * increment
 
-	// Get the val index and decrease the balance of the validator.
-	idx, err := st.ValidatorIndexByPubkey(val.GetPubkey())
-	if err != nil {
-		return err
-	}
-
 	return st.DecreaseBalance(idx, math.Gwei(penalty))
 }
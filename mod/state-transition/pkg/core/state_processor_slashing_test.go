@@ -0,0 +1,431 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSlotsPerEpoch            = 4
+	testEpochsPerSlashingsVector = 8
+	// testEpochsPerEth1VotingPeriod makes for an eth1 data voting period of
+	// 8 slots.
+	testEpochsPerEth1VotingPeriod = 2
+	// testMinPerEpochChurnLimit is the number of validators that can exit
+	// per epoch.
+	testMinPerEpochChurnLimit = 2
+	// testShardCommitteePeriod is the number of epochs a validator must be
+	// active for before it can exit.
+	testShardCommitteePeriod = 1
+	// testMinValidatorWithdrawabilityDelay is the number of epochs between
+	// the exit of a validator and its withdrawability.
+	testMinValidatorWithdrawabilityDelay = 2
+)
+
+// testBlobSidecars satisfies the BlobSidecars constraint of the state
+// processor.
+type testBlobSidecars struct{}
+
+func (testBlobSidecars) Len() int { return 0 }
+
+// testStateProcessor is the state processor operating on the deneb backed
+// test state.
+type testStateProcessor = core.StateProcessor[
+	*types.BeaconBlock,
+	*types.BeaconBlockBody,
+	*types.BeaconBlockHeader,
+	testBeaconState,
+	testBlobSidecars,
+	*transition.Context,
+	*types.Deposit,
+	*types.Eth1Data,
+	*types.ExecutionPayload,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.ForkData,
+	*types.ProposerSlashing,
+	*types.Validator,
+	*types.SignedVoluntaryExit,
+	*engineprimitives.Withdrawal,
+	types.WithdrawalCredentials,
+]
+
+// newTestStateProcessor returns a state processor operating on the deneb
+// backed test state, accepting every signature.
+func newTestStateProcessor(
+	proportionalSlashingMultiplier uint64,
+) *testStateProcessor {
+	return newTestStateProcessorWithSignatureErr(
+		proportionalSlashingMultiplier, nil,
+	)
+}
+
+// newTestStateProcessorWithSignatureErr returns a state processor operating
+// on the deneb backed test state, failing every signature verification with
+// the given error.
+func newTestStateProcessorWithSignatureErr(
+	proportionalSlashingMultiplier uint64,
+	signatureErr error,
+) *core.StateProcessor[
+	*types.BeaconBlock,
+	*types.BeaconBlockBody,
+	*types.BeaconBlockHeader,
+	testBeaconState,
+	testBlobSidecars,
+	*transition.Context,
+	*types.Deposit,
+	*types.Eth1Data,
+	*types.ExecutionPayload,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.ForkData,
+	*types.ProposerSlashing,
+	*types.Validator,
+	*types.SignedVoluntaryExit,
+	*engineprimitives.Withdrawal,
+	types.WithdrawalCredentials,
+] {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			MaxEffectiveBalance:              32e9,
+			EffectiveBalanceIncrement:        1e9,
+			SlotsPerEpoch:                    testSlotsPerEpoch,
+			SlotsPerHistoricalRoot:           8,
+			EpochsPerHistoricalVector:        8,
+			EpochsPerSlashingsVector:         testEpochsPerSlashingsVector,
+			ProportionalSlashingMultiplier:   proportionalSlashingMultiplier,
+			MinSlashingPenaltyQuotient:       128,
+			WhistleblowerRewardQuotient:      512,
+			EpochsPerEth1VotingPeriod:        testEpochsPerEth1VotingPeriod,
+			MaxDepositsPerBlock:              16,
+			MaxWithdrawalsPerPayload:         16,
+			MinPerEpochChurnLimit:            testMinPerEpochChurnLimit,
+			ShardCommitteePeriod:             testShardCommitteePeriod,
+			MinValidatorWithdrawabilityDelay: testMinValidatorWithdrawabilityDelay,
+			ChurnLimitQuotient:               1 << 16,
+		},
+	)
+
+	signer := &mocks.BLSSigner{}
+	signer.On(
+		"VerifySignature", mock.Anything, mock.Anything, mock.Anything,
+	).Return(signatureErr)
+
+	return core.NewStateProcessor[
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		testBeaconState,
+		testBlobSidecars,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.ForkData,
+		*types.ProposerSlashing,
+		*types.Validator,
+		*types.SignedVoluntaryExit,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	](cs, nil, signer)
+}
+
+// newTestValidator returns a validator active since genesis with the given
+// effective balance. Slashed validators exited in the epoch following their
+// slashing and are withdrawable at the given epoch.
+func newTestValidator(
+	effectiveBalance math.Gwei,
+	slashed bool,
+	withdrawableEpoch math.Epoch,
+) *types.Validator {
+	val := &types.Validator{
+		EffectiveBalance:           effectiveBalance,
+		ActivationEligibilityEpoch: 0,
+		ActivationEpoch:            0,
+		ExitEpoch:                  math.Epoch(constants.FarFutureEpoch),
+		WithdrawableEpoch:          math.Epoch(constants.FarFutureEpoch),
+	}
+	if slashed {
+		val.Slashed = true
+		val.ExitEpoch = withdrawableEpoch - testEpochsPerSlashingsVector + 1
+		val.WithdrawableEpoch = withdrawableEpoch
+	}
+	return val
+}
+
+func TestStateProcessor_ProcessSlashings(t *testing.T) {
+	// The state is at the last slot of epoch 5, validators slashed in epoch
+	// 1 are halfway through their withdrawability delay and receive the
+	// correlated penalty.
+	const (
+		epoch             = 5
+		slot              = (epoch+1)*testSlotsPerEpoch - 1
+		slashedEpoch      = 1
+		withdrawableEpoch = slashedEpoch + testEpochsPerSlashingsVector
+	)
+
+	tests := []struct {
+		name                           string
+		proportionalSlashingMultiplier uint64
+		validators                     []*types.Validator
+		slashings                      map[uint64]math.Gwei
+		expectedBalances               []math.Gwei
+	}{
+		{
+			name:                           "no slashed validators",
+			proportionalSlashingMultiplier: 1,
+			validators: []*types.Validator{
+				newTestValidator(32e9, false, 0),
+				newTestValidator(32e9, false, 0),
+			},
+			expectedBalances: []math.Gwei{32e9, 32e9},
+		},
+		{
+			name:                           "multiple slashed validators",
+			proportionalSlashingMultiplier: 1,
+			validators: []*types.Validator{
+				newTestValidator(32e9, true, withdrawableEpoch),
+				newTestValidator(32e9, true, withdrawableEpoch),
+				newTestValidator(32e9, false, 0),
+				newTestValidator(32e9, false, 0),
+				newTestValidator(32e9, false, 0),
+				newTestValidator(32e9, false, 0),
+			},
+			slashings: map[uint64]math.Gwei{slashedEpoch: 64e9},
+			expectedBalances: []math.Gwei{
+				16e9, 16e9, 32e9, 32e9, 32e9, 32e9,
+			},
+		},
+		{
+			name:                           "penalty capped at total balance",
+			proportionalSlashingMultiplier: 3,
+			validators: []*types.Validator{
+				newTestValidator(32e9, true, withdrawableEpoch),
+				newTestValidator(32e9, true, withdrawableEpoch),
+				newTestValidator(32e9, false, 0),
+				newTestValidator(32e9, false, 0),
+				newTestValidator(32e9, false, 0),
+				newTestValidator(32e9, false, 0),
+			},
+			slashings: map[uint64]math.Gwei{slashedEpoch: 64e9},
+			expectedBalances: []math.Gwei{
+				0, 0, 32e9, 32e9, 32e9, 32e9,
+			},
+		},
+		{
+			name:                           "different effective balances",
+			proportionalSlashingMultiplier: 1,
+			validators: []*types.Validator{
+				newTestValidator(32e9, true, withdrawableEpoch),
+				newTestValidator(16e9, true, withdrawableEpoch),
+				newTestValidator(32e9, false, 0),
+				newTestValidator(32e9, false, 0),
+				newTestValidator(32e9, false, 0),
+				newTestValidator(32e9, false, 0),
+			},
+			slashings: map[uint64]math.Gwei{slashedEpoch: 48e9},
+			// penalty = eb / increment * 48e9 / 128e9 * increment
+			expectedBalances: []math.Gwei{
+				20e9, 10e9, 32e9, 32e9, 32e9, 32e9,
+			},
+		},
+		{
+			name:                           "only validators halfway to withdrawable",
+			proportionalSlashingMultiplier: 1,
+			validators: []*types.Validator{
+				newTestValidator(32e9, true, withdrawableEpoch),
+				newTestValidator(32e9, true, withdrawableEpoch-1),
+				newTestValidator(32e9, false, 0),
+				newTestValidator(32e9, false, 0),
+				newTestValidator(32e9, false, 0),
+				newTestValidator(32e9, false, 0),
+			},
+			slashings: map[uint64]math.Gwei{
+				slashedEpoch - 1: 32e9,
+				slashedEpoch:     32e9,
+			},
+			expectedBalances: []math.Gwei{
+				16e9, 32e9, 32e9, 32e9, 32e9, 32e9,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sp := newTestStateProcessor(tt.proportionalSlashingMultiplier)
+			st := newDenebState(
+				slot, testEpochsPerSlashingsVector, tt.validators,
+			)
+			for idx, amount := range tt.slashings {
+				require.NoError(t, st.UpdateSlashingAtIndex(idx, amount))
+			}
+
+			_, err := sp.ProcessSlots(st, slot+1)
+			require.NoError(t, err)
+
+			for i, expected := range tt.expectedBalances {
+				balance, err := st.GetBalance(math.ValidatorIndex(i))
+				require.NoError(t, err)
+				require.Equal(t, expected, balance, "validator %d", i)
+			}
+		})
+	}
+}
+
+func TestStateProcessor_ProcessProposerSlashings(t *testing.T) {
+	const slashedIndex = 1
+	errSignature := errors.New("invalid signature")
+
+	tests := []struct {
+		name          string
+		setup         func(st *denebState)
+		slashing      func() *types.ProposerSlashing
+		signatureErr  error
+		expectedError error
+	}{
+		{
+			name: "valid slashing",
+			slashing: func() *types.ProposerSlashing {
+				return newTestProposerSlashing(1, slashedIndex)
+			},
+		},
+		{
+			name: "slot mismatch",
+			slashing: func() *types.ProposerSlashing {
+				ps := newTestProposerSlashing(1, slashedIndex)
+				ps.SignedHeader2.Header.Slot = 2
+				return ps
+			},
+			expectedError: core.ErrProposerSlashingSlotMismatch,
+		},
+		{
+			name: "proposer mismatch",
+			slashing: func() *types.ProposerSlashing {
+				ps := newTestProposerSlashing(1, slashedIndex)
+				ps.SignedHeader2.Header.ProposerIndex = 0
+				return ps
+			},
+			expectedError: core.ErrProposerSlashingProposerMismatch,
+		},
+		{
+			name: "identical headers",
+			slashing: func() *types.ProposerSlashing {
+				ps := newTestProposerSlashing(1, slashedIndex)
+				ps.SignedHeader2.Header.BodyRoot = ps.GetHeader1().BodyRoot
+				return ps
+			},
+			expectedError: core.ErrProposerSlashingSameHeaders,
+		},
+		{
+			name: "proposer not slashable",
+			setup: func(st *denebState) {
+				st.Validators[slashedIndex].Slashed = true
+			},
+			slashing: func() *types.ProposerSlashing {
+				return newTestProposerSlashing(1, slashedIndex)
+			},
+			expectedError: core.ErrValidatorNotSlashable,
+		},
+		{
+			name: "bad signature",
+			slashing: func() *types.ProposerSlashing {
+				return newTestProposerSlashing(1, slashedIndex)
+			},
+			signatureErr:  errSignature,
+			expectedError: errSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := newGenesisState(t, newTestDeposits(0, 2))
+			if tt.setup != nil {
+				tt.setup(st)
+			}
+
+			sp := newTestStateProcessorWithSignatureErr(1, tt.signatureErr)
+			_, err := sp.ProcessSlots(st, 1)
+			require.NoError(t, err)
+
+			blk := newTestBlock(t, st, st.Eth1Data, nil)
+			blk.GetBody().SetProposerSlashings(
+				[]*types.ProposerSlashing{tt.slashing()},
+			)
+			err = sp.ProcessBlock(
+				&transition.Context{
+					SkipPayloadVerification: true,
+					SkipValidateRandao:      true,
+					SkipValidateResult:      true,
+				},
+				st,
+				blk,
+			)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, st.Validators[slashedIndex].Slashed)
+			require.False(t, st.Validators[0].Slashed)
+		})
+	}
+}
+
+func TestStateProcessor_ValidateProposerSlashing(t *testing.T) {
+	const slashedIndex = 1
+
+	st := newGenesisState(t, newTestDeposits(0, 2))
+	sp := newTestStateProcessor(1)
+	_, err := sp.ProcessSlots(st, 1)
+	require.NoError(t, err)
+
+	ps := newTestProposerSlashing(1, slashedIndex)
+	require.NoError(t, sp.ValidateProposerSlashing(st, ps))
+
+	ps.SignedHeader2.Header.Slot = 2
+	require.ErrorIs(
+		t,
+		sp.ValidateProposerSlashing(st, ps),
+		core.ErrProposerSlashingSlotMismatch,
+	)
+
+	// Validation leaves the proposer untouched.
+	require.False(t, st.Validators[slashedIndex].Slashed)
+}
@@ -23,7 +23,9 @@ package core
 import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/davecgh/go-spew/spew"
 )
@@ -31,7 +33,7 @@ import (
 // processOperations processes the operations and ensures they match the
 // local state.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
+	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processOperations(
 	st BeaconStateT,
 	blk BeaconBlockT,
@@ -51,18 +53,22 @@ func (sp *StateProcessor[
 		sp.cs.MaxDepositsPerBlock(),
 		uint64(eth1Data.GetDepositCount())-index,
 	)
-	_ = depositCount
-	// TODO: Update eth1data count and check this.
-	// if uint64(len(deposits)) != depositCount {
-	// 	return errors.New("deposit count mismatch")
-	// }
-	return sp.processDeposits(st, deposits)
+	if uint64(len(deposits)) != depositCount {
+		return errors.Wrapf(
+			ErrDepositCountMismatch, "expected: %d, got: %d",
+			depositCount, len(deposits),
+		)
+	}
+	if err = sp.processDeposits(st, deposits); err != nil {
+		return err
+	}
+	return sp.processVoluntaryExits(st, blk.GetBody())
 }
 
 // processDeposits processes the deposits and ensures they match the
 // local state.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, _, _, _, _, _,
 ]) processDeposits(
 	st BeaconStateT,
 	deposits []DepositT,
@@ -78,27 +84,39 @@ func (sp *StateProcessor[
 
 // processDeposit processes the deposit and ensures it matches the local state.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, _, _, _, _, _,
 ]) processDeposit(
 	st BeaconStateT,
 	dep DepositT,
 ) error {
-	// TODO: fill this in properly
-	// if !sp.isValidMerkleBranch(
-	// 	leaf,
-	// 	dep.Credentials,
-	// 	32 + 1,
-	// 	dep.Index,
-	// 	st.root,
-	// ) {
-	// 	return errors.New("invalid merkle branch")
-	// }
-
 	depositIndex, err := st.GetEth1DepositIndex()
 	if err != nil {
 		return err
 	}
 
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return err
+	}
+
+	// Verify the Merkle branch of the deposit against the deposit root.
+	leaf, err := dep.GetDataRoot()
+	if err != nil {
+		return err
+	}
+	if !merkle.IsValidMerkleBranch(
+		leaf,
+		dep.GetProof(),
+		constants.DepositContractTreeDepth+1,
+		depositIndex,
+		eth1Data.GetDepositRoot(),
+	) {
+		return errors.Wrapf(
+			ErrInvalidDepositProof, "index: %d, deposit root: %s",
+			depositIndex, eth1Data.GetDepositRoot(),
+		)
+	}
+
 	if err = st.SetEth1DepositIndex(
 		depositIndex + 1,
 	); err != nil {
@@ -110,24 +128,16 @@ func (sp *StateProcessor[
 
 // applyDeposit processes the deposit and ensures it matches the local state.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, ValidatorT, _, _,
+	_, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, _, _, _, _, _,
 ]) applyDeposit(
 	st BeaconStateT,
 	dep DepositT,
 ) error {
 	idx, err := st.ValidatorIndexByPubkey(dep.GetPubkey())
-	// If the validator already exists, we update the balance.
+	// If the validator already exists, we only increase the balance, the
+	// effective balance is updated at the next epoch boundary.
 	if err == nil {
-		var val ValidatorT
-		val, err = st.ValidatorByIndex(idx)
-		if err != nil {
-			return err
-		}
-
-		// TODO: Modify balance here and then effective balance once per epoch.
-		val.SetEffectiveBalance(min(val.GetEffectiveBalance()+dep.GetAmount(),
-			math.Gwei(sp.cs.MaxEffectiveBalance())))
-		return st.UpdateValidatorAtIndex(idx, val)
+		return st.IncreaseBalance(idx, dep.GetAmount())
 	}
 
 	// If the validator does not exist, we add the validator.
@@ -137,7 +147,7 @@ func (sp *StateProcessor[
 
 // createValidator creates a validator if the deposit is valid.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, ForkDataT, _, _, _,
+	_, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, ForkDataT, _, _, _, _, _,
 ]) createValidator(
 	st BeaconStateT,
 	dep DepositT,
@@ -188,11 +198,17 @@ func (sp *StateProcessor[
 
 // addValidatorToRegistry adds a validator to the registry.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, ValidatorT, _, _,
+	_, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, _, ValidatorT, _, _,
+	_,
 ]) addValidatorToRegistry(
 	st BeaconStateT,
 	dep DepositT,
 ) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
 	var val ValidatorT
 	val = val.New(
 		dep.GetPubkey(),
@@ -202,7 +218,16 @@ func (sp *StateProcessor[
 		math.Gwei(sp.cs.MaxEffectiveBalance()),
 	)
 
-	if err := st.AddValidator(val); err != nil {
+	// Validators join the CometBFT validator set at the next epoch boundary,
+	// so they are considered active from the next epoch onwards. Genesis
+	// validators are active from the genesis epoch.
+	activationEpoch := sp.cs.SlotToEpoch(slot)
+	if slot != 0 {
+		activationEpoch++
+	}
+	val.SetActivationEpoch(activationEpoch)
+
+	if err = st.AddValidator(val); err != nil {
 		return err
 	}
 
@@ -214,12 +239,204 @@ func (sp *StateProcessor[
 	return st.IncreaseBalance(idx, dep.GetAmount())
 }
 
+// processVoluntaryExits processes the voluntary exits included in the block
+// body.
+func (sp *StateProcessor[
+	_, BeaconBlockBodyT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processVoluntaryExits(
+	st BeaconStateT,
+	body BeaconBlockBodyT,
+) error {
+	voluntaryExits := body.GetVoluntaryExits()
+	if uint64(len(voluntaryExits)) > constants.MaxVoluntaryExitsPerBlock {
+		return errors.Wrapf(
+			ErrExceedsBlockVoluntaryExitLimit, "expected: %d, got: %d",
+			constants.MaxVoluntaryExitsPerBlock, len(voluntaryExits),
+		)
+	}
+
+	for _, exit := range voluntaryExits {
+		if err := sp.processVoluntaryExit(st, exit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processVoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#voluntary-exits
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, VoluntaryExitT, _, _,
+]) processVoluntaryExit(
+	st BeaconStateT,
+	exit VoluntaryExitT,
+) error {
+	if err := sp.ValidateVoluntaryExit(st, exit); err != nil {
+		return err
+	}
+
+	// Initiate the exit.
+	return sp.initiateValidatorExit(st, exit.GetValidatorIndex())
+}
+
+// ValidateVoluntaryExit returns an error if the voluntary exit can not be
+// included in a block on top of the given state. The state is not modified.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, ForkDataT, _, _, VoluntaryExitT,
+	_, _,
+]) ValidateVoluntaryExit(
+	st BeaconStateT,
+	exit VoluntaryExitT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	idx := exit.GetValidatorIndex()
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	// Verify the validator is active.
+	if !val.IsActive(epoch) {
+		return errors.Wrapf(ErrValidatorNotActive, "index: %d", idx)
+	}
+
+	// Verify the validator has not yet initiated an exit.
+	if val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return errors.Wrapf(
+			ErrValidatorAlreadyExiting, "index: %d, exit epoch: %d",
+			idx, val.GetExitEpoch(),
+		)
+	}
+
+	// Exits must specify an epoch when they become valid, they are not valid
+	// before then.
+	if epoch < exit.GetEpoch() {
+		return errors.Wrapf(
+			ErrVoluntaryExitNotYetValid, "current epoch: %d, exit epoch: %d",
+			epoch, exit.GetEpoch(),
+		)
+	}
+
+	// Verify the validator has been active long enough.
+	if epoch < val.GetActivationEpoch()+math.Epoch(
+		sp.cs.ShardCommitteePeriod(),
+	) {
+		return errors.Wrapf(
+			ErrValidatorTooYoungToExit, "index: %d, activation epoch: %d",
+			idx, val.GetActivationEpoch(),
+		)
+	}
+
+	// Verify the signature of the validator.
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+
+	var fd ForkDataT
+	return exit.VerifySignature(
+		fd.New(
+			version.FromUint32[common.Version](
+				sp.cs.ActiveForkVersionForEpoch(exit.GetEpoch()),
+			), genesisValidatorsRoot,
+		),
+		sp.cs.DomainTypeVoluntaryExit(),
+		val.GetPubkey(),
+		sp.signer.VerifySignature,
+	)
+}
+
+// initiateValidatorExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#initiate_validator_exit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) initiateValidatorExit(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+) error {
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	// Return if the validator already initiated an exit.
+	if val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return nil
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	vals, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	// Compute the exit queue epoch, validators leave the CometBFT validator
+	// set at the next epoch boundary at the earliest.
+	var (
+		exitQueueEpoch = epoch + 1
+		exitQueueChurn uint64
+		activeCount    uint64
+	)
+	for _, v := range vals {
+		if v.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+			exitQueueEpoch = max(exitQueueEpoch, v.GetExitEpoch())
+		}
+		if v.IsActive(epoch) {
+			activeCount++
+		}
+	}
+	for _, v := range vals {
+		if v.GetExitEpoch() == exitQueueEpoch {
+			exitQueueChurn++
+		}
+	}
+
+	// Move to the next epoch if the exit queue epoch is full.
+	if exitQueueChurn >= sp.getValidatorChurnLimit(activeCount) {
+		exitQueueEpoch++
+	}
+
+	val.SetExitEpoch(exitQueueEpoch)
+	val.SetWithdrawableEpoch(
+		exitQueueEpoch + math.Epoch(sp.cs.MinValidatorWithdrawabilityDelay()),
+	)
+	return st.UpdateValidatorAtIndex(idx, val)
+}
+
+// getValidatorChurnLimit as defined in the Ethereum 2.0 specification, given
+// the number of active validators.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_validator_churn_limit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) getValidatorChurnLimit(activeCount uint64) uint64 {
+	return max(
+		sp.cs.MinPerEpochChurnLimit(),
+		activeCount/sp.cs.ChurnLimitQuotient(),
+	)
+}
+
 // processWithdrawals as per the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#new-process_withdrawals
 //
 //nolint:lll
 func (sp *StateProcessor[
-	_, BeaconBlockBodyT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockBodyT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processWithdrawals(
 	st BeaconStateT,
 	body BeaconBlockBodyT,
@@ -300,3 +517,58 @@ func (sp *StateProcessor[
 
 	return st.SetNextWithdrawalValidatorIndex(nextValidatorIndex)
 }
+
+// processEffectiveBalanceUpdates as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#effective-balances-updates
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processEffectiveBalanceUpdates(
+	st BeaconStateT,
+) error {
+	validators, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	var (
+		balance             math.Gwei
+		increment           = math.Gwei(sp.cs.EffectiveBalanceIncrement())
+		maxEffectiveBalance = math.Gwei(sp.cs.MaxEffectiveBalance())
+		hysteresisIncrement = increment / math.Gwei(
+			constants.HysteresisQuotient,
+		)
+		downwardThreshold = hysteresisIncrement * math.Gwei(
+			constants.HysteresisDownwardMultiplier,
+		)
+		upwardThreshold = hysteresisIncrement * math.Gwei(
+			constants.HysteresisUpwardMultiplier,
+		)
+	)
+
+	// Update the effective balance of each validator whose balance moved
+	// past the hysteresis thresholds.
+	for idx, val := range validators {
+		balance, err = st.GetBalance(math.ValidatorIndex(idx))
+		if err != nil {
+			return err
+		}
+
+		effectiveBalance := val.GetEffectiveBalance()
+		if balance+downwardThreshold >= effectiveBalance &&
+			effectiveBalance+upwardThreshold >= balance {
+			continue
+		}
+
+		val.SetEffectiveBalance(
+			min(balance-balance%increment, maxEffectiveBalance),
+		)
+		if err = st.UpdateValidatorAtIndex(
+			math.ValidatorIndex(idx), val,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,545 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDeposits returns count deposits of distinct validators, starting at
+// the given deposit index.
+func newTestDeposits(start, count uint64) []*types.Deposit {
+	deposits := make([]*types.Deposit, count)
+	for i := range count {
+		deposits[i] = types.NewDeposit(
+			crypto.BLSPubkey{byte(start + i + 1)},
+			types.WithdrawalCredentials{0x01},
+			32e9,
+			crypto.BLSSignature{},
+			start+i,
+		)
+	}
+	return deposits
+}
+
+// proveTestDeposits builds the deposit tree from the given deposits, sets
+// the proof of each deposit against it and returns its root.
+func proveTestDeposits(
+	t *testing.T,
+	deposits []*types.Deposit,
+) common.Root {
+	t.Helper()
+	leaves := make([]common.Root, len(deposits))
+	for i, deposit := range deposits {
+		leaf, err := deposit.GetDataRoot()
+		require.NoError(t, err)
+		leaves[i] = leaf
+	}
+
+	tree, err := merkle.NewTreeFromLeavesWithDepth(
+		leaves, constants.DepositContractTreeDepth,
+	)
+	require.NoError(t, err)
+	for i, deposit := range deposits {
+		proof, err := tree.MerkleProofWithMixin(uint64(i))
+		require.NoError(t, err)
+		for j, node := range proof {
+			deposit.Proof[j] = common.Root(node)
+		}
+	}
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	return root
+}
+
+// newGenesisState returns a deneb backed state initialized from the given
+// genesis deposits.
+func newGenesisState(
+	t *testing.T,
+	deposits []*types.Deposit,
+) *denebState {
+	t.Helper()
+	st := newDenebState(0, testEpochsPerSlashingsVector, nil)
+	_, err := newTestStateProcessor(1).InitializePreminedBeaconStateFromEth1(
+		st,
+		deposits,
+		(&types.ExecutionPayloadHeader{}).Empty(version.Deneb),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+	return st
+}
+
+// newTestBlock returns a block for the slot of the given state, proposed by
+// the first validator, voting for the given eth1 data and carrying the given
+// deposits.
+func newTestBlock(
+	t *testing.T,
+	st *denebState,
+	eth1Data *types.Eth1Data,
+	deposits []*types.Deposit,
+) *types.BeaconBlock {
+	t.Helper()
+	parentRoot, err := st.LatestBlockHeader.HashTreeRoot()
+	require.NoError(t, err)
+
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		st.Slot, 0, parentRoot, version.Deneb,
+	)
+	require.NoError(t, err)
+
+	body, ok := (&types.BeaconBlockBody{}).Empty(version.Deneb).
+		RawBeaconBlockBody.(*types.BeaconBlockBodyDeneb)
+	require.True(t, ok)
+	body.Eth1Data = eth1Data
+	body.Deposits = deposits
+
+	raw, ok := blk.RawBeaconBlock.(*types.BeaconBlockDeneb)
+	require.True(t, ok)
+	raw.Body = body
+	return blk
+}
+
+func TestStateProcessor_InitializeGenesisDeposits(t *testing.T) {
+	deposits := newTestDeposits(0, 3)
+	st := newGenesisState(t, deposits)
+
+	eth1Data, err := st.GetEth1Data()
+	require.NoError(t, err)
+	require.Equal(t, math.U64(len(deposits)), eth1Data.GetDepositCount())
+
+	index, err := st.GetEth1DepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(deposits)), index)
+	require.Len(t, st.Validators, len(deposits))
+
+	// Every genesis deposit carries a valid proof against the deposit root.
+	for i, deposit := range deposits {
+		leaf, err := deposit.GetDataRoot()
+		require.NoError(t, err)
+		require.True(t, merkle.IsValidMerkleBranch(
+			leaf,
+			deposit.GetProof(),
+			constants.DepositContractTreeDepth+1,
+			uint64(i),
+			eth1Data.GetDepositRoot(),
+		))
+	}
+}
+
+func TestStateProcessor_ProcessBlockDeposits(t *testing.T) {
+	const (
+		genesisDeposits = 2
+		totalDeposits   = 4
+	)
+
+	tests := []struct {
+		name string
+		// deposits returns the deposits to include in the block out of the
+		// pending ones.
+		deposits      func(pending []*types.Deposit) []*types.Deposit
+		expectedError error
+	}{
+		{
+			name: "all pending deposits",
+			deposits: func(pending []*types.Deposit) []*types.Deposit {
+				return pending
+			},
+		},
+		{
+			name: "missing deposit",
+			deposits: func(pending []*types.Deposit) []*types.Deposit {
+				return pending[:1]
+			},
+			expectedError: core.ErrDepositCountMismatch,
+		},
+		{
+			name: "no deposits",
+			deposits: func([]*types.Deposit) []*types.Deposit {
+				return nil
+			},
+			expectedError: core.ErrDepositCountMismatch,
+		},
+		{
+			name: "deposits out of order",
+			deposits: func(pending []*types.Deposit) []*types.Deposit {
+				return []*types.Deposit{pending[1], pending[0]}
+			},
+			expectedError: core.ErrInvalidDepositProof,
+		},
+		{
+			name: "tampered deposit amount",
+			deposits: func(pending []*types.Deposit) []*types.Deposit {
+				pending[0].Amount += 1e9
+				return pending
+			},
+			expectedError: core.ErrInvalidDepositProof,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deposits := newTestDeposits(0, totalDeposits)
+			st := newGenesisState(t, deposits[:genesisDeposits])
+
+			// Commit to the pending deposits in the eth1 data.
+			depositRoot := proveTestDeposits(t, deposits)
+			require.NoError(t, st.SetEth1Data(
+				(&types.Eth1Data{}).New(
+					depositRoot, totalDeposits, common.ExecutionHash{},
+				),
+			))
+
+			sp := newTestStateProcessor(1)
+			_, err := sp.ProcessSlots(st, 1)
+			require.NoError(t, err)
+
+			err = sp.ProcessBlock(
+				&transition.Context{
+					SkipPayloadVerification: true,
+					SkipValidateRandao:      true,
+					SkipValidateResult:      true,
+				},
+				st,
+				newTestBlock(
+					t, st, st.Eth1Data,
+					tt.deposits(deposits[genesisDeposits:]),
+				),
+			)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			index, err := st.GetEth1DepositIndex()
+			require.NoError(t, err)
+			require.Equal(t, uint64(totalDeposits), index)
+			require.Len(t, st.Validators, totalDeposits)
+		})
+	}
+}
+
+func TestStateProcessor_TopUpDeposit(t *testing.T) {
+	deposits := newTestDeposits(0, 2)
+	deposits[0].Amount = 16e9
+	st := newGenesisState(t, deposits)
+	require.Equal(t, math.Gwei(16e9), st.Validators[0].EffectiveBalance)
+
+	// Top up the first validator.
+	topUp := types.NewDeposit(
+		deposits[0].Pubkey,
+		deposits[0].Credentials,
+		8e9,
+		crypto.BLSSignature{},
+		2,
+	)
+	depositRoot := proveTestDeposits(
+		t, append(newTestDeposits(0, 2), topUp),
+	)
+	require.NoError(t, st.SetEth1Data(
+		(&types.Eth1Data{}).New(depositRoot, 3, common.ExecutionHash{}),
+	))
+
+	sp := newTestStateProcessor(1)
+	_, err := sp.ProcessSlots(st, 1)
+	require.NoError(t, err)
+	require.NoError(t, sp.ProcessBlock(
+		&transition.Context{
+			SkipPayloadVerification: true,
+			SkipValidateRandao:      true,
+			SkipValidateResult:      true,
+		},
+		st,
+		newTestBlock(t, st, st.Eth1Data, []*types.Deposit{topUp}),
+	))
+
+	// The top-up only increases the balance within the epoch.
+	require.Len(t, st.Validators, 2)
+	require.Equal(t, uint64(24e9), st.Balances[0])
+	require.Equal(t, math.Gwei(16e9), st.Validators[0].EffectiveBalance)
+
+	// The effective balance follows at the epoch boundary.
+	_, err = sp.ProcessSlots(st, testSlotsPerEpoch)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(24e9), st.Validators[0].EffectiveBalance)
+}
+
+func TestStateProcessor_ProcessEffectiveBalanceUpdates(t *testing.T) {
+	tests := []struct {
+		name             string
+		effectiveBalance math.Gwei
+		balance          uint64
+		expected         math.Gwei
+	}{
+		{
+			name:             "within downward threshold",
+			effectiveBalance: 32e9,
+			balance:          31.75e9,
+			expected:         32e9,
+		},
+		{
+			name:             "below downward threshold",
+			effectiveBalance: 32e9,
+			balance:          31.74e9,
+			expected:         31e9,
+		},
+		{
+			name:             "within upward threshold",
+			effectiveBalance: 16e9,
+			balance:          17.25e9,
+			expected:         16e9,
+		},
+		{
+			name:             "above upward threshold",
+			effectiveBalance: 16e9,
+			balance:          17.26e9,
+			expected:         17e9,
+		},
+		{
+			name:             "capped at max effective balance",
+			effectiveBalance: 31e9,
+			balance:          40e9,
+			expected:         32e9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := newGenesisState(t, newTestDeposits(0, 1))
+			st.Validators[0].EffectiveBalance = tt.effectiveBalance
+			st.Balances[0] = tt.balance
+
+			// Effective balances are only updated at epoch boundaries.
+			sp := newTestStateProcessor(1)
+			_, err := sp.ProcessSlots(st, testSlotsPerEpoch-1)
+			require.NoError(t, err)
+			require.Equal(
+				t, tt.effectiveBalance, st.Validators[0].EffectiveBalance,
+			)
+
+			_, err = sp.ProcessSlots(st, testSlotsPerEpoch)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, st.Validators[0].EffectiveBalance)
+		})
+	}
+}
+
+// newTestVoluntaryExit returns a voluntary exit of the validator at the given
+// index, valid from the given epoch.
+func newTestVoluntaryExit(
+	epoch math.Epoch,
+	idx math.ValidatorIndex,
+) *types.SignedVoluntaryExit {
+	return &types.SignedVoluntaryExit{
+		Message: &types.VoluntaryExit{
+			Epoch:          epoch,
+			ValidatorIndex: idx,
+		},
+	}
+}
+
+// processTestExits processes a block at the first slot of the given epoch,
+// carrying the given voluntary exits.
+func processTestExits(
+	t *testing.T,
+	st *denebState,
+	epoch math.Epoch,
+	exits []*types.SignedVoluntaryExit,
+) error {
+	t.Helper()
+	sp := newTestStateProcessor(1)
+	_, err := sp.ProcessSlots(st, math.Slot(epoch*testSlotsPerEpoch))
+	require.NoError(t, err)
+
+	blk := newTestBlock(t, st, st.Eth1Data, nil)
+	blk.GetBody().SetVoluntaryExits(exits)
+	return sp.ProcessBlock(
+		&transition.Context{
+			SkipPayloadVerification: true,
+			SkipValidateRandao:      true,
+			SkipValidateResult:      true,
+		},
+		st,
+		blk,
+	)
+}
+
+func TestStateProcessor_ProcessVoluntaryExits(t *testing.T) {
+	const exitEpoch = testShardCommitteePeriod
+
+	tests := []struct {
+		name          string
+		setup         func(st *denebState)
+		exits         []*types.SignedVoluntaryExit
+		expectedError error
+	}{
+		{
+			name:  "valid exit",
+			exits: []*types.SignedVoluntaryExit{newTestVoluntaryExit(1, 0)},
+		},
+		{
+			name: "exit not yet valid",
+			exits: []*types.SignedVoluntaryExit{
+				newTestVoluntaryExit(exitEpoch+1, 0),
+			},
+			expectedError: core.ErrVoluntaryExitNotYetValid,
+		},
+		{
+			name: "validator already exiting",
+			setup: func(st *denebState) {
+				st.Validators[0].ExitEpoch = exitEpoch + 1
+			},
+			exits:         []*types.SignedVoluntaryExit{newTestVoluntaryExit(1, 0)},
+			expectedError: core.ErrValidatorAlreadyExiting,
+		},
+		{
+			name: "validator not active",
+			setup: func(st *denebState) {
+				st.Validators[0].ActivationEpoch = exitEpoch + 1
+			},
+			exits:         []*types.SignedVoluntaryExit{newTestVoluntaryExit(1, 0)},
+			expectedError: core.ErrValidatorNotActive,
+		},
+		{
+			name: "validator too young to exit",
+			setup: func(st *denebState) {
+				st.Validators[0].ActivationEpoch = exitEpoch
+			},
+			exits:         []*types.SignedVoluntaryExit{newTestVoluntaryExit(1, 0)},
+			expectedError: core.ErrValidatorTooYoungToExit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := newGenesisState(t, newTestDeposits(0, 2))
+			if tt.setup != nil {
+				tt.setup(st)
+			}
+
+			err := processTestExits(t, st, exitEpoch, tt.exits)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(
+				t, math.Epoch(exitEpoch+1), st.Validators[0].ExitEpoch,
+			)
+			require.Equal(
+				t,
+				math.Epoch(exitEpoch+1+testMinValidatorWithdrawabilityDelay),
+				st.Validators[0].WithdrawableEpoch,
+			)
+			require.Equal(
+				t,
+				math.Epoch(constants.FarFutureEpoch),
+				st.Validators[1].ExitEpoch,
+			)
+		})
+	}
+}
+
+func TestStateProcessor_ValidateVoluntaryExit(t *testing.T) {
+	const exitEpoch = testShardCommitteePeriod
+
+	st := newGenesisState(t, newTestDeposits(0, 2))
+	sp := newTestStateProcessor(1)
+	_, err := sp.ProcessSlots(st, math.Slot(exitEpoch*testSlotsPerEpoch))
+	require.NoError(t, err)
+
+	require.NoError(t, sp.ValidateVoluntaryExit(st, newTestVoluntaryExit(1, 0)))
+	require.ErrorIs(
+		t,
+		sp.ValidateVoluntaryExit(st, newTestVoluntaryExit(exitEpoch+1, 0)),
+		core.ErrVoluntaryExitNotYetValid,
+	)
+
+	// Validation leaves the validator untouched.
+	require.Equal(
+		t, math.Epoch(constants.FarFutureEpoch), st.Validators[0].ExitEpoch,
+	)
+}
+
+func TestStateProcessor_ExitQueueChurn(t *testing.T) {
+	const (
+		exitEpoch     = testShardCommitteePeriod
+		numValidators = 2*testMinPerEpochChurnLimit + 1
+	)
+
+	st := newGenesisState(t, newTestDeposits(0, numValidators))
+	exits := make([]*types.SignedVoluntaryExit, numValidators)
+	for i := range exits {
+		exits[i] = newTestVoluntaryExit(exitEpoch, math.ValidatorIndex(i))
+	}
+	require.NoError(t, processTestExits(t, st, exitEpoch, exits))
+
+	// Exits are assigned to the earliest epoch with room in the exit queue.
+	for i, val := range st.Validators {
+		expected := math.Epoch(
+			exitEpoch + 1 + uint64(i)/testMinPerEpochChurnLimit,
+		)
+		require.Equal(t, expected, val.ExitEpoch, "validator %d", i)
+	}
+}
+
+func TestStateProcessor_ExitValidatorUpdates(t *testing.T) {
+	const exitEpoch = testShardCommitteePeriod
+
+	deposits := newTestDeposits(0, 2)
+	st := newGenesisState(t, deposits)
+	require.NoError(t, processTestExits(
+		t, st, exitEpoch,
+		[]*types.SignedVoluntaryExit{newTestVoluntaryExit(exitEpoch, 0)},
+	))
+
+	// The exiting validator is removed from the set at the epoch boundary.
+	sp := newTestStateProcessor(1)
+	updates, err := sp.ProcessSlots(
+		st, math.Slot((exitEpoch+1)*testSlotsPerEpoch),
+	)
+	require.NoError(t, err)
+	require.ElementsMatch(t, transition.ValidatorUpdates{
+		{Pubkey: deposits[0].Pubkey, EffectiveBalance: 0},
+		{Pubkey: deposits[1].Pubkey, EffectiveBalance: 32e9},
+	}, updates)
+
+	// It is left out of the updates once it has exited.
+	updates, err = sp.ProcessSlots(
+		st, math.Slot((exitEpoch+2)*testSlotsPerEpoch),
+	)
+	require.NoError(t, err)
+	require.Equal(t, transition.ValidatorUpdates{
+		{Pubkey: deposits[1].Pubkey, EffectiveBalance: 32e9},
+	}, updates)
+}
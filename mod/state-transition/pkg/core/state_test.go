@@ -0,0 +1,429 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/deneb"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+)
+
+var (
+	errValidatorNotFound = errors.New("validator not found")
+	errNotSupported      = errors.New("not supported by the test state")
+)
+
+// testBeaconState is the BeaconState used by the state processor tests.
+type testBeaconState = core.BeaconState[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	*engineprimitives.Withdrawal,
+]
+
+// denebState is an in-memory BeaconState backed by the deneb BeaconState.
+type denebState struct {
+	*deneb.BeaconState
+	latestExecutionPayloadHeader *types.ExecutionPayloadHeader
+}
+
+// newDenebState returns a deneb backed state at the given slot holding the
+// given validators, each with a balance equal to its effective balance.
+func newDenebState(
+	slot math.Slot,
+	epochsPerSlashingsVector uint64,
+	vals []*types.Validator,
+) *denebState {
+	balances := make([]uint64, len(vals))
+	for i, val := range vals {
+		balances[i] = uint64(val.EffectiveBalance)
+	}
+	return &denebState{
+		BeaconState: &deneb.BeaconState{
+			Slot:              slot,
+			Fork:              &types.Fork{},
+			LatestBlockHeader: &types.BeaconBlockHeader{},
+			Eth1Data:          &types.Eth1Data{},
+			LatestExecutionPayloadHeader: &types.ExecutionPayloadHeaderDeneb{
+				LogsBloom: make([]byte, 256),
+			},
+			Validators: vals,
+			Balances:   balances,
+			Slashings:  make([]uint64, epochsPerSlashingsVector),
+		},
+		latestExecutionPayloadHeader: &types.ExecutionPayloadHeader{
+			InnerExecutionPayloadHeader: &types.ExecutionPayloadHeaderDeneb{},
+		},
+	}
+}
+
+func (s *denebState) Copy() testBeaconState {
+	st := *s.BeaconState
+	st.Validators = make([]*types.Validator, len(s.Validators))
+	for i, val := range s.Validators {
+		v := *val
+		st.Validators[i] = &v
+	}
+	st.Eth1DataVotes = slices.Clone(s.Eth1DataVotes)
+	st.Balances = slices.Clone(s.Balances)
+	st.BlockRoots = slices.Clone(s.BlockRoots)
+	st.StateRoots = slices.Clone(s.StateRoots)
+	st.RandaoMixes = slices.Clone(s.RandaoMixes)
+	st.Slashings = slices.Clone(s.Slashings)
+	return &denebState{
+		BeaconState:                  &st,
+		latestExecutionPayloadHeader: s.latestExecutionPayloadHeader,
+	}
+}
+
+func (s *denebState) Save() {}
+
+func (s *denebState) Context() context.Context {
+	return context.Background()
+}
+
+func (s *denebState) GetSlot() (math.Slot, error) {
+	return s.Slot, nil
+}
+
+func (s *denebState) SetSlot(slot math.Slot) error {
+	s.Slot = slot
+	return nil
+}
+
+func (s *denebState) SetFork(fork *types.Fork) error {
+	s.Fork = fork
+	return nil
+}
+
+func (s *denebState) GetGenesisValidatorsRoot() (common.Root, error) {
+	return s.GenesisValidatorsRoot, nil
+}
+
+func (s *denebState) SetGenesisValidatorsRoot(root common.Root) error {
+	s.GenesisValidatorsRoot = root
+	return nil
+}
+
+func (s *denebState) GetLatestBlockHeader() (
+	*types.BeaconBlockHeader, error,
+) {
+	header := *s.LatestBlockHeader
+	return &header, nil
+}
+
+func (s *denebState) SetLatestBlockHeader(
+	header *types.BeaconBlockHeader,
+) error {
+	s.LatestBlockHeader = header
+	return nil
+}
+
+func (s *denebState) GetBlockRootAtIndex(idx uint64) (common.Root, error) {
+	return getAtIndex(s.BlockRoots, idx), nil
+}
+
+func (s *denebState) UpdateBlockRootAtIndex(
+	idx uint64, root common.Root,
+) error {
+	s.BlockRoots = setAtIndex(s.BlockRoots, idx, root)
+	return nil
+}
+
+func (s *denebState) StateRootAtIndex(idx uint64) (common.Root, error) {
+	return getAtIndex(s.StateRoots, idx), nil
+}
+
+func (s *denebState) UpdateStateRootAtIndex(
+	idx uint64, root common.Root,
+) error {
+	s.StateRoots = setAtIndex(s.StateRoots, idx, root)
+	return nil
+}
+
+func (s *denebState) GetRandaoMixAtIndex(idx uint64) (common.Bytes32, error) {
+	return getAtIndex(s.RandaoMixes, idx), nil
+}
+
+func (s *denebState) UpdateRandaoMixAtIndex(
+	idx uint64, mix common.Bytes32,
+) error {
+	s.RandaoMixes = setAtIndex(s.RandaoMixes, idx, mix)
+	return nil
+}
+
+func (s *denebState) GetEth1Data() (*types.Eth1Data, error) {
+	return s.Eth1Data, nil
+}
+
+func (s *denebState) SetEth1Data(data *types.Eth1Data) error {
+	s.Eth1Data = data
+	return nil
+}
+
+func (s *denebState) GetEth1DataVotes() ([]*types.Eth1Data, error) {
+	return slices.Clone(s.Eth1DataVotes), nil
+}
+
+func (s *denebState) AddEth1DataVote(vote *types.Eth1Data) error {
+	s.Eth1DataVotes = append(s.Eth1DataVotes, vote)
+	return nil
+}
+
+func (s *denebState) ResetEth1DataVotes() error {
+	s.Eth1DataVotes = nil
+	return nil
+}
+
+func (s *denebState) GetEth1DepositIndex() (uint64, error) {
+	return s.Eth1DepositIndex, nil
+}
+
+func (s *denebState) SetEth1DepositIndex(idx uint64) error {
+	s.Eth1DepositIndex = idx
+	return nil
+}
+
+func (s *denebState) GetLatestExecutionPayloadHeader() (
+	*types.ExecutionPayloadHeader, error,
+) {
+	return s.latestExecutionPayloadHeader, nil
+}
+
+func (s *denebState) SetLatestExecutionPayloadHeader(
+	header *types.ExecutionPayloadHeader,
+) error {
+	s.latestExecutionPayloadHeader = header
+	return nil
+}
+
+func (s *denebState) AddValidator(val *types.Validator) error {
+	s.Validators = append(s.Validators, val)
+	s.Balances = append(s.Balances, 0)
+	return nil
+}
+
+func (s *denebState) UpdateValidatorAtIndex(
+	idx math.ValidatorIndex, val *types.Validator,
+) error {
+	if uint64(idx) >= uint64(len(s.Validators)) {
+		return errValidatorNotFound
+	}
+	s.Validators[idx] = val
+	return nil
+}
+
+func (s *denebState) RemoveValidatorAtIndex(idx math.ValidatorIndex) error {
+	if uint64(idx) >= uint64(len(s.Validators)) {
+		return errValidatorNotFound
+	}
+	s.Validators = slices.Delete(s.Validators, int(idx), int(idx)+1)
+	s.Balances = slices.Delete(s.Balances, int(idx), int(idx)+1)
+	return nil
+}
+
+func (s *denebState) ValidatorIndexByPubkey(
+	pubkey crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	for i, val := range s.Validators {
+		if val.Pubkey == pubkey {
+			return math.ValidatorIndex(i), nil
+		}
+	}
+	return 0, errValidatorNotFound
+}
+
+func (s *denebState) ValidatorIndexByCometBFTAddress(
+	[]byte,
+) (math.ValidatorIndex, error) {
+	return 0, errNotSupported
+}
+
+// ValidatorByIndex returns a copy of the validator, mirroring a store that
+// decodes the validator on every read.
+func (s *denebState) ValidatorByIndex(
+	idx math.ValidatorIndex,
+) (*types.Validator, error) {
+	if uint64(idx) >= uint64(len(s.Validators)) {
+		return nil, errValidatorNotFound
+	}
+	val := *s.Validators[idx]
+	return &val, nil
+}
+
+func (s *denebState) GetValidators() ([]*types.Validator, error) {
+	vals := make([]*types.Validator, len(s.Validators))
+	for i, val := range s.Validators {
+		v := *val
+		vals[i] = &v
+	}
+	return vals, nil
+}
+
+func (s *denebState) GetTotalValidators() (uint64, error) {
+	return uint64(len(s.Validators)), nil
+}
+
+func (s *denebState) GetValidatorsByEffectiveBalance() (
+	[]*types.Validator, error,
+) {
+	vals, err := s.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(vals, func(a, b *types.Validator) int {
+		switch {
+		case a.EffectiveBalance < b.EffectiveBalance:
+			return -1
+		case a.EffectiveBalance > b.EffectiveBalance:
+			return 1
+		default:
+			return 0
+		}
+	})
+	return vals, nil
+}
+
+func (s *denebState) GetTotalActiveBalances(
+	slotsPerEpoch uint64,
+) (math.Gwei, error) {
+	var total math.Gwei
+	epoch := math.Epoch(uint64(s.Slot) / slotsPerEpoch)
+	for _, val := range s.Validators {
+		if val.IsActive(epoch) {
+			total += val.EffectiveBalance
+		}
+	}
+	return total, nil
+}
+
+func (s *denebState) GetBalance(idx math.ValidatorIndex) (math.Gwei, error) {
+	if uint64(idx) >= uint64(len(s.Balances)) {
+		return 0, errValidatorNotFound
+	}
+	return math.Gwei(s.Balances[idx]), nil
+}
+
+func (s *denebState) IncreaseBalance(
+	idx math.ValidatorIndex, delta math.Gwei,
+) error {
+	balance, err := s.GetBalance(idx)
+	if err != nil {
+		return err
+	}
+	s.Balances[idx] = uint64(balance + delta)
+	return nil
+}
+
+func (s *denebState) DecreaseBalance(
+	idx math.ValidatorIndex, delta math.Gwei,
+) error {
+	balance, err := s.GetBalance(idx)
+	if err != nil {
+		return err
+	}
+	s.Balances[idx] = uint64(balance - min(balance, delta))
+	return nil
+}
+
+func (s *denebState) GetSlashingAtIndex(idx uint64) (math.Gwei, error) {
+	return math.Gwei(getAtIndex(s.Slashings, idx)), nil
+}
+
+// UpdateSlashingAtIndex keeps the total slashing in sync with the slashings
+// vector, mirroring the StateDB.
+func (s *denebState) UpdateSlashingAtIndex(
+	idx uint64, amount math.Gwei,
+) error {
+	s.TotalSlashing = s.TotalSlashing +
+		amount - math.Gwei(getAtIndex(s.Slashings, idx))
+	s.Slashings = setAtIndex(s.Slashings, idx, uint64(amount))
+	return nil
+}
+
+func (s *denebState) GetTotalSlashing() (math.Gwei, error) {
+	return s.TotalSlashing, nil
+}
+
+func (s *denebState) SetTotalSlashing(total math.Gwei) error {
+	s.TotalSlashing = total
+	return nil
+}
+
+func (s *denebState) GetNextWithdrawalIndex() (uint64, error) {
+	return s.NextWithdrawalIndex, nil
+}
+
+func (s *denebState) SetNextWithdrawalIndex(idx uint64) error {
+	s.NextWithdrawalIndex = idx
+	return nil
+}
+
+func (s *denebState) GetNextWithdrawalValidatorIndex() (
+	math.ValidatorIndex, error,
+) {
+	return s.NextWithdrawalValidatorIndex, nil
+}
+
+func (s *denebState) SetNextWithdrawalValidatorIndex(
+	idx math.ValidatorIndex,
+) error {
+	s.NextWithdrawalValidatorIndex = idx
+	return nil
+}
+
+// ExpectedWithdrawals returns no withdrawals, the withdrawal sweep is not
+// covered by the test state.
+func (s *denebState) ExpectedWithdrawals() (
+	[]*engineprimitives.Withdrawal, error,
+) {
+	return []*engineprimitives.Withdrawal{}, nil
+}
+
+// getAtIndex returns the element at the given index or the zero value if the
+// index is out of range.
+func getAtIndex[T any](list []T, idx uint64) T {
+	var zero T
+	if idx >= uint64(len(list)) {
+		return zero
+	}
+	return list[idx]
+}
+
+// setAtIndex sets the element at the given index, growing the list if
+// needed.
+func setAtIndex[T any](list []T, idx uint64, value T) []T {
+	if idx >= uint64(len(list)) {
+		list = append(list, make([]T, idx-uint64(len(list))+1)...)
+	}
+	list[idx] = value
+	return list
+}
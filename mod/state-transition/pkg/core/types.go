@@ -50,13 +50,17 @@ type AvailabilityStore[BeaconBlockBodyT any, BlobSidecarsT any] interface {
 type BeaconBlock[
 	DepositT any,
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+		BeaconBlockBodyT, DepositT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ProposerSlashingT, VoluntaryExitT, WithdrawalsT,
 	],
+	Eth1DataT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	ProposerSlashingT any,
+	VoluntaryExitT any,
 	WithdrawalsT any,
 ] interface {
 	IsNil() bool
@@ -77,10 +81,13 @@ type BeaconBlock[
 type BeaconBlockBody[
 	BeaconBlockBodyT any,
 	DepositT any,
+	Eth1DataT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT,
 	],
 	ExecutionPayloadHeaderT interface{ GetBlockHash() common.ExecutionHash },
+	ProposerSlashingT any,
+	VoluntaryExitT any,
 	WithdrawalT any,
 ] interface {
 	constraints.EmptyWithVersion[BeaconBlockBodyT]
@@ -88,8 +95,14 @@ type BeaconBlockBody[
 	GetRandaoReveal() crypto.BLSSignature
 	// GetExecutionPayload returns the execution payload.
 	GetExecutionPayload() ExecutionPayloadT
+	// GetEth1Data returns the eth1 data voted for by the proposer.
+	GetEth1Data() Eth1DataT
 	// GetDeposits returns the list of deposits.
 	GetDeposits() []DepositT
+	// GetProposerSlashings returns the list of proposer slashings.
+	GetProposerSlashings() []ProposerSlashingT
+	// GetVoluntaryExits returns the list of voluntary exits.
+	GetVoluntaryExits() []VoluntaryExitT
 	// HashTreeRoot returns the hash tree root of the block body.
 	HashTreeRoot() ([32]byte, error)
 	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
@@ -130,8 +143,14 @@ type Deposit[
 ] interface {
 	// GetAmount returns the amount of the deposit.
 	GetAmount() math.Gwei
+	// GetDataRoot returns the hash tree root of the deposit data.
+	GetDataRoot() (common.Root, error)
 	// GetIndex returns the index of the deposit.
 	GetIndex() uint64
+	// GetProof returns the Merkle proof of the deposit.
+	GetProof() []common.Root
+	// SetProof sets the Merkle proof of the deposit.
+	SetProof([]common.Root)
 	// GetPubkey returns the public key of the validator.
 	GetPubkey() crypto.BLSPubkey
 	// GetSignature returns the signature of the deposit.
@@ -208,6 +227,46 @@ type ExecutionEngine[
 	) error
 }
 
+// ProposerSlashing is the interface for a proposer slashing.
+type ProposerSlashing[BeaconBlockHeaderT, ForkDataT any] interface {
+	// IsNil returns true if either of the signed headers is missing.
+	IsNil() bool
+	// GetHeader1 returns the first of the two conflicting headers.
+	GetHeader1() BeaconBlockHeaderT
+	// GetHeader2 returns the second of the two conflicting headers.
+	GetHeader2() BeaconBlockHeaderT
+	// VerifySignatures verifies that both headers were signed by the given
+	// proposer.
+	VerifySignatures(
+		forkData ForkDataT,
+		domainType common.DomainType,
+		pubkey crypto.BLSPubkey,
+		signatureVerificationFn func(
+			pubkey crypto.BLSPubkey,
+			message []byte, signature crypto.BLSSignature,
+		) error,
+	) error
+}
+
+// VoluntaryExit is the interface for a signed voluntary exit.
+type VoluntaryExit[ForkDataT any] interface {
+	// GetEpoch returns the earliest epoch in which the exit can be processed.
+	GetEpoch() math.Epoch
+	// GetValidatorIndex returns the index of the exiting validator.
+	GetValidatorIndex() math.ValidatorIndex
+	// VerifySignature verifies that the exit was signed by the given
+	// validator.
+	VerifySignature(
+		forkData ForkDataT,
+		domainType common.DomainType,
+		pubkey crypto.BLSPubkey,
+		signatureVerificationFn func(
+			pubkey crypto.BLSPubkey,
+			message []byte, signature crypto.BLSSignature,
+		) error,
+	) error
+}
+
 // ForkData is the interface for the fork data.
 type ForkData[ForkDataT any] interface {
 	// New creates a new fork data object.
@@ -234,8 +293,15 @@ type Validator[
 		effectiveBalanceIncrement math.Gwei,
 		maxEffectiveBalance math.Gwei,
 	) ValidatorT
+	// IsActive returns true if the validator is active at the given epoch.
+	IsActive(math.Epoch) bool
+	// IsSlashable returns true if the validator can be slashed at the given
+	// epoch.
+	IsSlashable(math.Epoch) bool
 	// IsSlashed returns true if the validator is slashed.
 	IsSlashed() bool
+	// SetSlashed sets whether the validator is slashed.
+	SetSlashed(bool)
 	// GetPubkey returns the public key of the validator.
 	GetPubkey() crypto.BLSPubkey
 	// GetEffectiveBalance returns the effective balance of the validator in
@@ -243,8 +309,18 @@ type Validator[
 	GetEffectiveBalance() math.Gwei
 	// SetEffectiveBalance sets the effective balance of the validator in Gwei.
 	SetEffectiveBalance(math.Gwei)
+	// GetActivationEpoch returns the epoch in which the validator activates.
+	GetActivationEpoch() math.Epoch
+	// SetActivationEpoch sets the epoch in which the validator activates.
+	SetActivationEpoch(math.Epoch)
+	// GetExitEpoch returns the epoch in which the validator exits.
+	GetExitEpoch() math.Epoch
+	// SetExitEpoch sets the epoch in which the validator exits.
+	SetExitEpoch(math.Epoch)
 	// GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 	GetWithdrawableEpoch() math.Epoch
+	// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+	SetWithdrawableEpoch(math.Epoch)
 }
 
 // Withdrawal is the interface for a withdrawal.
@@ -89,3 +89,47 @@ func (kv *KVStore[
 ) error {
 	return kv.eth1Data.Set(kv.ctx, data)
 }
+
+// GetEth1DataVotes retrieves the eth1 data votes from the beacon state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT,
+]) GetEth1DataVotes() ([]Eth1DataT, error) {
+	var votes []Eth1DataT
+	iter, err := kv.eth1DataVotes.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for iter.Valid() {
+		var vote Eth1DataT
+		vote, err = iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		votes = append(votes, vote)
+		iter.Next()
+	}
+	return votes, nil
+}
+
+// AddEth1DataVote appends a vote to the eth1 data votes in the beacon state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT,
+]) AddEth1DataVote(
+	vote Eth1DataT,
+) error {
+	votes, err := kv.GetEth1DataVotes()
+	if err != nil {
+		return err
+	}
+	return kv.eth1DataVotes.Set(kv.ctx, uint64(len(votes)), vote)
+}
+
+// ResetEth1DataVotes clears the eth1 data votes in the beacon state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT,
+]) ResetEth1DataVotes() error {
+	return kv.eth1DataVotes.Clear(kv.ctx, nil)
+}
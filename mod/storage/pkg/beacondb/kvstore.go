@@ -22,6 +22,7 @@ package beacondb
 
 import (
 	"context"
+	"slices"
 
 	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
@@ -29,7 +30,6 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/index"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
-	"github.com/berachain/beacon-kit/mod/storage/pkg/sszdb"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -47,6 +47,7 @@ type KVStore[
 	ValidatorT Validator,
 ] struct {
 	ctx   context.Context
+	kss   store.KVStoreService
 	write func()
 	// Versioning
 	// genesisValidatorsRoot is the root of the genesis validators.
@@ -65,6 +66,8 @@ type KVStore[
 	// Eth1
 	// eth1Data stores the latest eth1 data.
 	eth1Data sdkcollections.Item[Eth1DataT]
+	// eth1DataVotes stores the eth1 data votes of the voting period.
+	eth1DataVotes sdkcollections.Map[uint64, Eth1DataT]
 	// eth1DepositIndex is the index of the latest eth1 deposit.
 	eth1DepositIndex sdkcollections.Item[uint64]
 	// latestExecutionPayload stores the latest execution payload version.
@@ -97,7 +100,6 @@ type KVStore[
 	slashings sdkcollections.Map[uint64, uint64]
 	// totalSlashing stores the total slashing in the vector range.
 	totalSlashing sdkcollections.Item[uint64]
-	sszdb         *sszdb.DB
 }
 
 // New creates a new instance of Store.
@@ -120,17 +122,12 @@ func New[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT, ValidatorT,
 ] {
 	schemaBuilder := sdkcollections.NewSchemaBuilder(kss)
-	// TODO pull up and inject.
-	sdb, err := sszdb.New(sszdb.Config{})
-	if err != nil {
-		panic(err)
-	}
 	return &KVStore[
 		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 		ForkT, ValidatorT,
 	]{
-		ctx:   nil,
-		sszdb: sdb,
+		ctx: nil,
+		kss: kss,
 		genesisValidatorsRoot: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{keys.GenesisValidatorsRootPrefix}),
@@ -169,6 +166,13 @@ func New[
 			keys.Eth1DataPrefixHumanReadable,
 			encoding.SSZValueCodec[Eth1DataT]{},
 		),
+		eth1DataVotes: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.Eth1DataVotesPrefix}),
+			keys.Eth1DataVotesPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			encoding.SSZValueCodec[Eth1DataT]{},
+		),
 		eth1DepositIndex: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{keys.Eth1DepositIndexPrefix}),
@@ -273,6 +277,36 @@ func (kv *KVStore[
 	return ss
 }
 
+// CopyInto replaces the contents of the given store with the contents of
+// the Store, each read and written under the context of its store.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT,
+]) CopyInto(
+	dst *KVStore[
+		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+		ForkT, ValidatorT,
+	],
+) error {
+	to := dst.kss.OpenKVStore(dst.ctx)
+	if err := clearStore(to); err != nil {
+		return err
+	}
+
+	it, err := kv.kss.OpenKVStore(kv.ctx).Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		err = to.Set(slices.Clone(it.Key()), slices.Clone(it.Value()))
+		if err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
 // Context returns the context of the Store.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
@@ -305,3 +339,32 @@ func (kv *KVStore[
 		kv.write()
 	}
 }
+
+// clearStore deletes every entry of the given store.
+func clearStore(s store.KVStore) error {
+	it, err := s.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+
+	// Collect the keys first, the store must not be written to while
+	// it is being iterated.
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, slices.Clone(it.Key()))
+	}
+	if err = it.Error(); err != nil {
+		it.Close()
+		return err
+	}
+	if err = it.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err = s.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"context"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+// Service persists every finalized block in the block store.
+type Service[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BlockEventT BlockEvent[BeaconBlockT],
+] struct {
+	// logger is used for logging information and errors.
+	logger log.Logger[any]
+	// store is the block store.
+	store *Store[BeaconBlockT]
+	// feed is the block feed that provides block events.
+	feed chan BlockEventT
+	// wg waits for the goroutine of the service to exit.
+	wg sync.WaitGroup
+}
+
+// NewService creates a new block store service.
+func NewService[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BlockEventT BlockEvent[BeaconBlockT],
+](
+	logger log.Logger[any],
+	store *Store[BeaconBlockT],
+	feed chan BlockEventT,
+) *Service[BeaconBlockT, BlockEventT] {
+	return &Service[BeaconBlockT, BlockEventT]{
+		logger: logger,
+		store:  store,
+		feed:   feed,
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service[_, _]) Name() string {
+	return "block-store"
+}
+
+// Start starts persisting finalized blocks.
+func (s *Service[_, _]) Start(ctx context.Context) error {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.start(ctx)
+	}()
+	return nil
+}
+
+// Stop waits for the service to finish storing the current block.
+func (s *Service[_, _]) Stop(context.Context) error {
+	s.wg.Wait()
+	return nil
+}
+
+// Status returns the status of the service.
+func (s *Service[_, _]) Status() error {
+	return nil
+}
+
+// start listens for finalized blocks.
+func (s *Service[_, _]) start(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-s.feed:
+			if !ok {
+				return
+			}
+			blk := event.Data()
+			if err := s.store.Set(blk); err != nil {
+				s.logger.Error(
+					"failed to store finalized block",
+					"slot", blk.GetSlot().Base10(),
+					"error", err,
+				)
+				continue
+			}
+			if err := event.Ack(); err != nil {
+				s.logger.Error(
+					"failed to acknowledge stored block",
+					"slot", blk.GetSlot().Base10(),
+					"error", err,
+				)
+			}
+		}
+	}
+}
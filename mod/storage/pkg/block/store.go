@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/hex"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	db "github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
+)
+
+var (
+	// blockKey is the key of a block within its slot.
+	blockKey = []byte("block")
+	// rootKey is the key of the root of a block within its slot.
+	rootKey = []byte("root")
+	// prunedKey is the key of the first slot that has not been pruned.
+	prunedKey = []byte("pruned")
+)
+
+const (
+	// rootIndexPrefix prefixes the keys of the root to slot index.
+	rootIndexPrefix = "roots/"
+	// slotSize is the size of a slot in the root to slot index.
+	slotSize = 8
+)
+
+// Store persists finalized beacon blocks. Blocks are indexed by slot, with
+// an index from block root to slot to look them up by root.
+// Invariant: No slot below firstUnprunedSlot holds a block.
+type Store[BeaconBlockT BeaconBlock[BeaconBlockT]] struct {
+	// blocks holds the blocks and their roots, indexed by slot.
+	blocks *filedb.RangeDB
+	// roots maps the block roots to their slot.
+	roots db.DB
+	// chainSpec is used to decode blocks with their fork version.
+	chainSpec common.ChainSpec
+	// firstUnprunedSlot is the slot pruning resumes from. It is persisted,
+	// so that pruning never revisits the slots pruned before a restart.
+	firstUnprunedSlot uint64
+	mu                sync.RWMutex
+}
+
+// NewStore creates a new block store on top of the given database.
+func NewStore[BeaconBlockT BeaconBlock[BeaconBlockT]](
+	db db.DB,
+	chainSpec common.ChainSpec,
+) *Store[BeaconBlockT] {
+	s := &Store[BeaconBlockT]{
+		blocks:    filedb.NewRangeDB(db),
+		roots:     db,
+		chainSpec: chainSpec,
+	}
+	// Without a valid persisted slot, pruning starts over from genesis.
+	if bz, err := db.Get(prunedKey); err == nil && len(bz) == slotSize {
+		s.firstUnprunedSlot = binary.BigEndian.Uint64(bz)
+	}
+	return s
+}
+
+// Set persists the given block.
+func (s *Store[BeaconBlockT]) Set(blk BeaconBlockT) error {
+	bz, err := blk.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	root, err := blk.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	slot := blk.GetSlot().Unwrap()
+	// enforce invariant
+	if slot < s.firstUnprunedSlot {
+		if err = s.setFirstUnprunedSlot(slot); err != nil {
+			return err
+		}
+	}
+	if err = s.blocks.Set(slot, blockKey, bz); err != nil {
+		return err
+	}
+	if err = s.blocks.Set(slot, rootKey, root[:]); err != nil {
+		return err
+	}
+	return s.roots.Set(
+		rootIndexKey(root), binary.BigEndian.AppendUint64(nil, slot),
+	)
+}
+
+// GetBySlot returns the block at the given slot.
+func (s *Store[BeaconBlockT]) GetBySlot(slot math.Slot) (BeaconBlockT, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.getBySlot(slot)
+}
+
+// GetByRoot returns the block with the given root.
+func (s *Store[BeaconBlockT]) GetByRoot(root common.Root) (BeaconBlockT, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	slot, err := s.getSlotByRoot(root)
+	if err != nil {
+		var blk BeaconBlockT
+		return blk, err
+	}
+	return s.getBySlot(slot)
+}
+
+// GetSlotByRoot returns the slot of the block with the given root.
+func (s *Store[BeaconBlockT]) GetSlotByRoot(
+	root common.Root,
+) (math.Slot, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.getSlotByRoot(root)
+}
+
+// Prune removes the blocks in the given range of slots [start, end) from
+// the store. Slots pruned by earlier calls are skipped.
+func (s *Store[BeaconBlockT]) Prune(start, end uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	start = max(start, s.firstUnprunedSlot)
+	if start >= end {
+		return nil
+	}
+	for slot := start; slot < end; slot++ {
+		root, err := s.blocks.Get(slot, rootKey)
+		if err != nil {
+			// The slot was either missed or already pruned.
+			continue
+		}
+		if err = s.roots.Delete(
+			rootIndexKey(common.Root(root)),
+		); err != nil {
+			return err
+		}
+	}
+	if err := s.blocks.Prune(start, end); err != nil {
+		return err
+	}
+	return s.setFirstUnprunedSlot(end)
+}
+
+// setFirstUnprunedSlot records the slot pruning resumes from.
+func (s *Store[BeaconBlockT]) setFirstUnprunedSlot(slot uint64) error {
+	// The slot is replaced rather than overwritten, which the database warns
+	// about.
+	if err := s.roots.Delete(prunedKey); err != nil {
+		return err
+	}
+	if err := s.roots.Set(
+		prunedKey, binary.BigEndian.AppendUint64(nil, slot),
+	); err != nil {
+		return err
+	}
+	s.firstUnprunedSlot = slot
+	return nil
+}
+
+// getBySlot returns the block at the given slot.
+func (s *Store[BeaconBlockT]) getBySlot(
+	slot math.Slot,
+) (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	bz, err := s.blocks.Get(slot.Unwrap(), blockKey)
+	if err != nil {
+		return blk, errors.Wrapf(ErrBlockNotFound, "slot: %d", slot)
+	}
+	return blk.NewFromSSZ(bz, s.chainSpec.ActiveForkVersionForSlot(slot))
+}
+
+// getSlotByRoot returns the slot of the block with the given root.
+func (s *Store[BeaconBlockT]) getSlotByRoot(
+	root common.Root,
+) (math.Slot, error) {
+	bz, err := s.roots.Get(rootIndexKey(root))
+	if err != nil {
+		return 0, errors.Wrapf(ErrBlockNotFound, "root: %s", root)
+	}
+	if len(bz) != slotSize {
+		return 0, errors.Wrapf(ErrInvalidSlotIndex, "root: %s", root)
+	}
+	return math.Slot(binary.BigEndian.Uint64(bz)), nil
+}
+
+// rootIndexKey returns the key of the given root in the root to slot index.
+func rootIndexKey(root common.Root) []byte {
+	return []byte(rootIndexPrefix + hex.FromBytes(root[:]).Unwrap())
+}
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+// testBlock is a minimal beacon block, encoded as its slot followed by the
+// fork version it was decoded with.
+type testBlock struct {
+	slot    math.Slot
+	version uint32
+}
+
+func (b *testBlock) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return binary.BigEndian.AppendUint64(buf, b.slot.Unwrap()), nil
+}
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) {
+	return b.MarshalSSZTo(nil)
+}
+
+func (b *testBlock) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != b.SizeSSZ() {
+		return errors.New("invalid size")
+	}
+	b.slot = math.Slot(binary.BigEndian.Uint64(buf))
+	return nil
+}
+
+func (b *testBlock) SizeSSZ() int {
+	return 8
+}
+
+func (b *testBlock) NewFromSSZ(buf []byte, forkVersion uint32) (
+	*testBlock, error,
+) {
+	blk := &testBlock{version: forkVersion}
+	return blk, blk.UnmarshalSSZ(buf)
+}
+
+func (b *testBlock) GetSlot() math.Slot {
+	return b.slot
+}
+
+func (b *testBlock) HashTreeRoot() ([32]byte, error) {
+	bz, err := b.MarshalSSZ()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha256.Sum256(bz), nil
+}
+
+func newTestStore(t *testing.T) *block.Store[*testBlock] {
+	t.Helper()
+	return newTestStoreAt(t, t.TempDir())
+}
+
+// newTestStoreAt returns a block store persisting its blocks in the given
+// directory.
+func newTestStoreAt(t *testing.T, dir string) *block.Store[*testBlock] {
+	t.Helper()
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			SlotsPerEpoch:    4,
+			ElectraForkEpoch: 2,
+		},
+	)
+	return block.NewStore[*testBlock](
+		filedb.NewDB(
+			filedb.WithRootDirectory(dir),
+			filedb.WithFileExtension("ssz"),
+			filedb.WithDirectoryPermissions(0700),
+			filedb.WithLogger(log.NewNopLogger()),
+		),
+		cs,
+	)
+}
+
+func TestStoreLookup(t *testing.T) {
+	store := newTestStore(t)
+	for _, slot := range []math.Slot{1, 2, 9} {
+		require.NoError(t, store.Set(&testBlock{slot: slot}))
+	}
+
+	// Blocks are decoded with the fork version active at their slot.
+	blk, err := store.GetBySlot(2)
+	require.NoError(t, err)
+	require.Equal(t, &testBlock{slot: 2, version: version.Deneb}, blk)
+
+	root, err := (&testBlock{slot: 9}).HashTreeRoot()
+	require.NoError(t, err)
+	blk, err = store.GetByRoot(root)
+	require.NoError(t, err)
+	require.Equal(t, &testBlock{slot: 9, version: version.Electra}, blk)
+
+	slot, err := store.GetSlotByRoot(root)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(9), slot)
+
+	_, err = store.GetBySlot(3)
+	require.ErrorIs(t, err, block.ErrBlockNotFound)
+	_, err = store.GetByRoot(common.Root{0x01})
+	require.ErrorIs(t, err, block.ErrBlockNotFound)
+}
+
+func TestStorePrune(t *testing.T) {
+	store := newTestStore(t)
+	for _, slot := range []math.Slot{1, 2, 4} {
+		require.NoError(t, store.Set(&testBlock{slot: slot}))
+	}
+	require.NoError(t, store.Prune(0, 3))
+
+	// Both the blocks and their roots are removed from the store.
+	for _, slot := range []math.Slot{1, 2} {
+		_, err := store.GetBySlot(slot)
+		require.ErrorIs(t, err, block.ErrBlockNotFound)
+
+		root, err := (&testBlock{slot: slot}).HashTreeRoot()
+		require.NoError(t, err)
+		_, err = store.GetSlotByRoot(root)
+		require.ErrorIs(t, err, block.ErrBlockNotFound)
+	}
+
+	_, err := store.GetBySlot(4)
+	require.NoError(t, err)
+}
+
+func TestStorePruneResumes(t *testing.T) {
+	dir := t.TempDir()
+	store := newTestStoreAt(t, dir)
+	for _, slot := range []math.Slot{1, 2, 4} {
+		require.NoError(t, store.Set(&testBlock{slot: slot}))
+	}
+	require.NoError(t, store.Prune(0, 3))
+
+	// A block left below the pruned slots, behind the back of the store, is
+	// not revisited by later prunes, even after a restart.
+	rdb := filedb.NewRangeDB(filedb.NewDB(
+		filedb.WithRootDirectory(dir),
+		filedb.WithFileExtension("ssz"),
+		filedb.WithDirectoryPermissions(0700),
+		filedb.WithLogger(log.NewNopLogger()),
+	))
+	bz, err := (&testBlock{slot: 1}).MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, rdb.Set(1, []byte("block"), bz))
+
+	store = newTestStoreAt(t, dir)
+	require.NoError(t, store.Prune(0, 5))
+	_, err = store.GetBySlot(1)
+	require.NoError(t, err)
+	_, err = store.GetBySlot(4)
+	require.ErrorIs(t, err, block.ErrBlockNotFound)
+
+	// Storing a block below the pruned slots makes it prunable again.
+	require.NoError(t, store.Set(&testBlock{slot: 2}))
+	require.NoError(t, store.Prune(0, 5))
+	_, err = store.GetBySlot(2)
+	require.ErrorIs(t, err, block.ErrBlockNotFound)
+}
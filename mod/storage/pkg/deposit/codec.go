@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+)
+
+const (
+	// legacyDepositSize is the size of the SSZ encoding of the deposits
+	// stored before deposits carried a Merkle proof: a 48 byte pubkey, 32
+	// byte credentials, an 8 byte amount, a 96 byte signature and an 8 byte
+	// index.
+	legacyDepositSize = 48 + 32 + 8 + 96 + 8
+	// depositProofSize is the size of the SSZ encoding of the Merkle proof of
+	// a deposit, which follows the legacy fields.
+	depositProofSize = (constants.DepositContractTreeDepth + 1) * 32
+)
+
+// depositCodec is the SSZ codec of the stored deposits, which also decodes
+// the deposits stored in the legacy encoding, without a Merkle proof, by
+// giving them an empty proof. The proof of a deposit is filled in from the
+// deposit tree when the deposit is included in a block.
+type depositCodec[DepositT Deposit] struct {
+	encoding.SSZValueCodec[DepositT]
+}
+
+// Decode unmarshals the provided bytes into a deposit, upgrading the legacy
+// encoding first.
+func (c depositCodec[DepositT]) Decode(b []byte) (DepositT, error) {
+	if len(b) == legacyDepositSize {
+		upgraded := make([]byte, legacyDepositSize+depositProofSize)
+		copy(upgraded, b)
+		b = upgraded
+	}
+	return c.SSZValueCodec.Decode(b)
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+// testDeposit is a deposit whose SSZ encoding is its raw bytes, of the size
+// of a deposit carrying a Merkle proof.
+type testDeposit struct {
+	raw []byte
+}
+
+func (d *testDeposit) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return append(buf, d.raw...), nil
+}
+
+func (d *testDeposit) MarshalSSZ() ([]byte, error) {
+	return d.MarshalSSZTo(nil)
+}
+
+func (d *testDeposit) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != d.SizeSSZ() {
+		return errUnexpectedSize
+	}
+	d.raw = bytes.Clone(buf)
+	return nil
+}
+
+func (d *testDeposit) SizeSSZ() int {
+	return legacyDepositSize + depositProofSize
+}
+
+func (d *testDeposit) HashTreeRoot() ([32]byte, error) {
+	return [32]byte{}, nil
+}
+
+func (d *testDeposit) GetIndex() uint64 {
+	return uint64(d.raw[legacyDepositSize-1])
+}
+
+func (d *testDeposit) GetDataRoot() (common.Root, error) {
+	return common.Root{}, nil
+}
+
+// errUnexpectedSize is returned when a test deposit is decoded from bytes of
+// the wrong size.
+var errUnexpectedSize = errors.New("unexpected size")
+
+func TestDepositCodec_Decode(t *testing.T) {
+	codec := depositCodec[*testDeposit]{}
+
+	deposit := &testDeposit{
+		raw: bytes.Repeat([]byte{7}, legacyDepositSize+depositProofSize),
+	}
+	bz, err := codec.Encode(deposit)
+	require.NoError(t, err)
+	decoded, err := codec.Decode(bz)
+	require.NoError(t, err)
+	require.Equal(t, deposit, decoded)
+
+	// A deposit in the legacy encoding is given an empty proof.
+	legacy := bytes.Repeat([]byte{7}, legacyDepositSize)
+	decoded, err = codec.Decode(legacy)
+	require.NoError(t, err)
+	require.Equal(t, legacy, decoded.raw[:legacyDepositSize])
+	require.Equal(
+		t, make([]byte, depositProofSize), decoded.raw[legacyDepositSize:],
+	)
+	require.Equal(t, uint64(7), decoded.GetIndex())
+
+	// Any other encoding is rejected.
+	_, err = codec.Decode(legacy[:legacyDepositSize-1])
+	require.ErrorIs(t, err, errUnexpectedSize)
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrDepositTreeFull is returned when pushing a deposit to a full
+	// deposit tree.
+	ErrDepositTreeFull = errors.New("deposit tree is full")
+
+	// ErrDepositFinalized is returned when proving a deposit, or computing
+	// a root, that requires the leaves of a finalized subtree.
+	ErrDepositFinalized = errors.New("deposit is finalized")
+
+	// ErrDepositNotFound is returned when proving a deposit, or
+	// finalizing deposits, that are not in the deposit tree.
+	ErrDepositNotFound = errors.New("deposit not found in deposit tree")
+
+	// ErrInvalidSnapshot is returned when the deposit root of a snapshot
+	// does not match its finalized roots.
+	ErrInvalidSnapshot = errors.New("invalid deposit tree snapshot")
+
+	// ErrInvalidEth1Block is returned when a stored execution block can not
+	// be decoded.
+	ErrInvalidEth1Block = errors.New("invalid eth1 block")
+)
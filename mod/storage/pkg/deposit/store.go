@@ -22,19 +22,34 @@ package deposit
 
 import (
 	"context"
+	"encoding/binary"
+	"encoding/json"
 	"errors"
+	"io"
 	"sync"
 
 	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
-	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4881"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
 
 // Deposit is a struct that holds the deposit information.
 var _ pruner.Prunable = (*KVStore[Deposit])(nil)
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix     = "deposit"
+	KeyDepositRootPrefix = "deposit_root"
+	KeyLastSyncedBlock   = "last_synced_block"
+	KeyDepositSnapshot   = "deposit_snapshot"
+	KeyEth1BlockPrefix   = "eth1_block"
+	KeyEth1BlockNumbers  = "eth1_block_number"
+)
+
+// eth1BlockSize is the size of a stored execution block, its hash followed
+// by the number of deposits made up to and including it.
+const eth1BlockSize = 32 + 8
 
 type KVStoreProvider struct {
 	store.KVStoreWithBatch
@@ -49,21 +64,134 @@ func (p *KVStoreProvider) OpenKVStore(context.Context) store.KVStore {
 // the deposit indexes are tracked outside of the kv store.
 type KVStore[DepositT Deposit] struct {
 	store sdkcollections.Map[uint64, DepositT]
-	mu    sync.RWMutex
+	// roots stores the data root of every deposit that is not finalized,
+	// they are kept once the deposits are pruned to rebuild the deposit
+	// tree.
+	roots sdkcollections.Map[uint64, []byte]
+	// lastSyncedBlock is the number of the last execution block whose
+	// deposits have all been stored.
+	lastSyncedBlock sdkcollections.Item[uint64]
+	// snapshot is the JSON encoded snapshot of the finalized deposits, from
+	// which the deposit tree is rebuilt on startup.
+	snapshot sdkcollections.Item[[]byte]
+	// eth1Blocks stores, by number, the hash of every synced execution
+	// block and the number of deposits made up to and including it, which
+	// proposers vote on as eth1 data.
+	eth1Blocks sdkcollections.Map[uint64, []byte]
+	// eth1BlockNumbers indexes the number of every block in eth1Blocks by
+	// its hash.
+	eth1BlockNumbers sdkcollections.Map[[]byte, uint64]
+	// tree is the deposit tree of every deposit stored so far, in order of
+	// their index.
+	tree *DepositTree
+	// closer closes the underlying database, if it can be closed.
+	closer io.Closer
+	mu     sync.RWMutex
 }
 
-// NewStore creates a new deposit store.
-func NewStore[DepositT Deposit](kvsp store.KVStoreService) *KVStore[DepositT] {
+// NewStore creates a new deposit store, rebuilding the deposit tree from
+// the stored snapshot and deposit roots.
+func NewStore[DepositT Deposit](
+	kvsp store.KVStoreService,
+) (*KVStore[DepositT], error) {
 	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
-	return &KVStore[DepositT]{
+	kv := &KVStore[DepositT]{
 		store: sdkcollections.NewMap(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{uint8(0)}),
 			KeyDepositPrefix,
 			sdkcollections.Uint64Key,
-			encoding.SSZValueCodec[DepositT]{},
+			depositCodec[DepositT]{},
+		),
+		roots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(1)}),
+			KeyDepositRootPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		lastSyncedBlock: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(2)}),
+			KeyLastSyncedBlock,
+			sdkcollections.Uint64Value,
+		),
+		snapshot: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(3)}),
+			KeyDepositSnapshot,
+			sdkcollections.BytesValue,
+		),
+		eth1Blocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(4)}),
+			KeyEth1BlockPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		eth1BlockNumbers: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(5)}),
+			KeyEth1BlockNumbers,
+			sdkcollections.BytesKey,
+			sdkcollections.Uint64Value,
 		),
 	}
+	if closer, ok := kvsp.(io.Closer); ok {
+		kv.closer = closer
+	}
+	if err := kv.loadTree(); err != nil {
+		return nil, err
+	}
+	return kv, nil
+}
+
+// Close closes the underlying database, if it can be closed.
+func (kv *KVStore[DepositT]) Close() error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if kv.closer == nil {
+		return nil
+	}
+	return kv.closer.Close()
+}
+
+// loadTree rebuilds the deposit tree from the stored snapshot, if any, and
+// the roots of the deposits that follow it.
+func (kv *KVStore[DepositT]) loadTree() error {
+	bz, err := kv.snapshot.Get(context.TODO())
+	switch {
+	case errors.Is(err, sdkcollections.ErrNotFound):
+		kv.tree = NewDepositTree()
+	case err != nil:
+		return err
+	default:
+		snapshot := new(eip4881.DepositTreeSnapshot)
+		if err = json.Unmarshal(bz, snapshot); err != nil {
+			return err
+		}
+		if kv.tree, err = NewDepositTreeFromSnapshot(snapshot); err != nil {
+			return err
+		}
+	}
+	return kv.advanceTree()
+}
+
+// advanceTree pushes the roots of the stored deposits to the deposit tree,
+// until the first deposit missing from the store.
+func (kv *KVStore[DepositT]) advanceTree() error {
+	for {
+		root, err := kv.roots.Get(context.TODO(), kv.tree.DepositCount())
+		if errors.Is(err, sdkcollections.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = kv.tree.PushLeaf(common.Root(root)); err != nil {
+			return err
+		}
+	}
 }
 
 // GetDepositsByIndex returns the first N deposits starting from the given
@@ -89,6 +217,201 @@ func (kv *KVStore[DepositT]) GetDepositsByIndex(
 	return deposits, nil
 }
 
+// GetDepositCount returns the number of deposits in the deposit tree.
+func (kv *KVStore[DepositT]) GetDepositCount() uint64 {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.tree.DepositCount()
+}
+
+// GetDepositRoot returns the deposit root of the deposit tree as it was
+// when it held the given number of deposits.
+func (kv *KVStore[DepositT]) GetDepositRoot(
+	depositCount uint64,
+) (common.Root, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.tree.RootAt(depositCount)
+}
+
+// GetDepositProof returns the Merkle proof of the deposit at the given index
+// against the deposit root of the deposit tree as it was when it held the
+// given number of deposits.
+func (kv *KVStore[DepositT]) GetDepositProof(
+	index, depositCount uint64,
+) ([]common.Root, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.tree.GetProofAt(index, depositCount)
+}
+
+// GetDepositSnapshot returns the snapshot of the finalized deposits, or nil
+// if no deposits have been finalized yet.
+func (kv *KVStore[DepositT]) GetDepositSnapshot() (
+	*eip4881.DepositTreeSnapshot, error,
+) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.tree.GetSnapshot()
+}
+
+// FinalizeDeposits finalizes the first depositCount deposits of the deposit
+// tree as of the given execution block, persists the resulting snapshot and
+// removes the roots of the finalized deposits, as well as the execution
+// blocks preceding the given one.
+func (kv *KVStore[DepositT]) FinalizeDeposits(
+	depositCount uint64,
+	blockHash common.ExecutionHash,
+	blockHeight uint64,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	// Nothing changes if the deposits are finalized as of the same block
+	// again.
+	if kv.tree.finalized && kv.tree.finalizedCount == depositCount &&
+		kv.tree.finalizedBlockHash == blockHash {
+		return nil
+	}
+	if err := kv.tree.Finalize(
+		depositCount, blockHash, blockHeight,
+	); err != nil {
+		return err
+	}
+
+	snapshot, err := kv.tree.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	if err = kv.snapshot.Set(context.TODO(), bz); err != nil {
+		return err
+	}
+
+	// The roots of the finalized deposits are part of the snapshot now.
+	if err = kv.roots.Clear(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).EndExclusive(snapshot.DepositCount),
+	); err != nil {
+		return err
+	}
+	return kv.pruneEth1Blocks(blockHeight)
+}
+
+// GetLastSyncedBlock returns the number of the last execution block whose
+// deposits have all been stored, and false if no block has been synced yet.
+func (kv *KVStore[DepositT]) GetLastSyncedBlock() (uint64, bool, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	blockNum, err := kv.lastSyncedBlock.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return blockNum, true, nil
+}
+
+// SetLastSyncedBlock sets the number of the last execution block whose
+// deposits have all been stored, and records the block along with the
+// number of deposits made up to and including it.
+func (kv *KVStore[DepositT]) SetLastSyncedBlock(
+	blockNum uint64,
+	blockHash common.ExecutionHash,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	bz := make([]byte, 0, eth1BlockSize)
+	bz = append(bz, blockHash[:]...)
+	bz = binary.BigEndian.AppendUint64(bz, kv.tree.DepositCount())
+	if err := kv.eth1Blocks.Set(context.TODO(), blockNum, bz); err != nil {
+		return err
+	}
+	if err := kv.eth1BlockNumbers.Set(
+		context.TODO(), blockHash[:], blockNum,
+	); err != nil {
+		return err
+	}
+	return kv.lastSyncedBlock.Set(context.TODO(), blockNum)
+}
+
+// GetEth1Block returns the hash and deposit count of the latest synced
+// execution block whose number is at most the given one, and false if there
+// is none.
+func (kv *KVStore[DepositT]) GetEth1Block(
+	blockNum uint64,
+) (common.ExecutionHash, uint64, bool, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.eth1Blocks.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).EndInclusive(blockNum).Descending(),
+	)
+	if err != nil {
+		return common.ExecutionHash{}, 0, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return common.ExecutionHash{}, 0, false, nil
+	}
+	bz, err := iter.Value()
+	if err != nil {
+		return common.ExecutionHash{}, 0, false, err
+	}
+	if len(bz) != eth1BlockSize {
+		return common.ExecutionHash{}, 0, false, ErrInvalidEth1Block
+	}
+	return common.ExecutionHash(bz[:32]),
+		binary.BigEndian.Uint64(bz[32:]),
+		true,
+		nil
+}
+
+// GetEth1BlockNumber returns the number of the synced execution block with
+// the given hash, and false if there is none.
+func (kv *KVStore[DepositT]) GetEth1BlockNumber(
+	blockHash common.ExecutionHash,
+) (uint64, bool, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	blockNum, err := kv.eth1BlockNumbers.Get(context.TODO(), blockHash[:])
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return blockNum, true, nil
+}
+
+// pruneEth1Blocks removes the execution blocks preceding the given one,
+// which can no longer be voted on.
+func (kv *KVStore[DepositT]) pruneEth1Blocks(blockNum uint64) error {
+	rng := new(sdkcollections.Range[uint64]).EndExclusive(blockNum)
+	iter, err := kv.eth1Blocks.Iterate(context.TODO(), rng)
+	if err != nil {
+		return err
+	}
+	values, err := iter.Values()
+	if err != nil {
+		return err
+	}
+	for _, bz := range values {
+		if len(bz) != eth1BlockSize {
+			continue
+		}
+		if err = kv.eth1BlockNumbers.Remove(
+			context.TODO(), bz[:32],
+		); err != nil {
+			return err
+		}
+	}
+	return kv.eth1Blocks.Clear(context.TODO(), rng)
+}
+
 // EnqueueDeposit pushes the deposit to the queue.
 func (kv *KVStore[DepositT]) EnqueueDeposit(deposit DepositT) error {
 	kv.mu.Lock()
@@ -108,9 +431,28 @@ func (kv *KVStore[DepositT]) EnqueueDeposits(deposits []DepositT) error {
 	return nil
 }
 
-// setDeposit sets the deposit in the store.
+// setDeposit sets the deposit and its data root in the store, and pushes
+// the roots of the deposits that now follow the deposit tree to it.
 func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
-	return kv.store.Set(context.TODO(), deposit.GetIndex(), deposit)
+	// Deposits already in the deposit tree may be stored again, but their
+	// root can not change.
+	if deposit.GetIndex() >= kv.tree.DepositCount() {
+		root, err := deposit.GetDataRoot()
+		if err != nil {
+			return err
+		}
+		if err = kv.roots.Set(
+			context.TODO(), deposit.GetIndex(), root[:],
+		); err != nil {
+			return err
+		}
+	}
+	if err := kv.store.Set(
+		context.TODO(), deposit.GetIndex(), deposit,
+	); err != nil {
+		return err
+	}
+	return kv.advanceTree()
 }
 
 // Prune removes the [start, end) deposits from the store.
@@ -0,0 +1,440 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4881"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+)
+
+// treeDepth is the depth of the deposit tree.
+const treeDepth uint64 = constants.DepositContractTreeDepth
+
+// DepositTree is the incremental deposit contract Merkle tree defined in
+// EIP-4881. Finalized subtrees are collapsed into their root, so that the
+// tree only holds the deposits that can still be proven.
+type DepositTree struct {
+	// tree is the root node of the deposit tree.
+	tree merkleTree
+	// mixInLength is the number of deposits pushed to the tree.
+	mixInLength uint64
+	// finalizedCount is the number of finalized deposits.
+	finalizedCount uint64
+	// finalizedBlockHash is the hash of the execution block the deposits
+	// are finalized at.
+	finalizedBlockHash common.ExecutionHash
+	// finalizedBlockHeight is the number of the execution block the
+	// deposits are finalized at.
+	finalizedBlockHeight uint64
+	// finalized is true once deposits have been finalized.
+	finalized bool
+}
+
+// NewDepositTree creates a new empty deposit tree.
+func NewDepositTree() *DepositTree {
+	return &DepositTree{
+		tree: &zeroNode{level: treeDepth},
+	}
+}
+
+// NewDepositTreeFromSnapshot rebuilds the deposit tree from the given
+// snapshot.
+func NewDepositTreeFromSnapshot(
+	snapshot *eip4881.DepositTreeSnapshot,
+) (*DepositTree, error) {
+	if snapshot.CalculateRoot() != snapshot.DepositRoot {
+		return nil, ErrInvalidSnapshot
+	}
+	return &DepositTree{
+		tree: fromSnapshotParts(
+			snapshot.Finalized, snapshot.DepositCount, treeDepth,
+		),
+		mixInLength:          snapshot.DepositCount,
+		finalizedCount:       snapshot.DepositCount,
+		finalizedBlockHash:   snapshot.ExecutionBlockHash,
+		finalizedBlockHeight: snapshot.ExecutionBlockHeight,
+		finalized:            true,
+	}, nil
+}
+
+// DepositCount returns the number of deposits pushed to the tree.
+func (t *DepositTree) DepositCount() uint64 {
+	return t.mixInLength
+}
+
+// HashTreeRoot returns the deposit root of the tree, including the length
+// mix-in.
+func (t *DepositTree) HashTreeRoot() common.Root {
+	return eip4881.MixInLength(t.tree.getRoot(), t.mixInLength)
+}
+
+// RootAt returns the deposit root of the tree as it was when it held the
+// given number of deposits.
+func (t *DepositTree) RootAt(count uint64) (common.Root, error) {
+	if count > t.mixInLength {
+		return common.Root{}, ErrDepositNotFound
+	}
+	root, err := t.tree.rootAt(count, treeDepth)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return eip4881.MixInLength(root, count), nil
+}
+
+// PushLeaf pushes the data root of the next deposit to the tree.
+func (t *DepositTree) PushLeaf(leaf common.Root) error {
+	if t.mixInLength == 1<<treeDepth {
+		return ErrDepositTreeFull
+	}
+	tree, err := t.tree.pushLeaf(leaf, treeDepth)
+	if err != nil {
+		return err
+	}
+	t.tree = tree
+	t.mixInLength++
+	return nil
+}
+
+// Finalize finalizes the first depositCount deposits of the tree, as of
+// the given execution block. Finalized deposits can no longer be proven.
+func (t *DepositTree) Finalize(
+	depositCount uint64,
+	blockHash common.ExecutionHash,
+	blockHeight uint64,
+) error {
+	if depositCount > t.mixInLength {
+		return ErrDepositNotFound
+	}
+	// Finalization never goes backwards.
+	if t.finalized && depositCount < t.finalizedCount {
+		return nil
+	}
+	t.tree = t.tree.finalize(depositCount, treeDepth)
+	t.finalizedCount = depositCount
+	t.finalizedBlockHash = blockHash
+	t.finalizedBlockHeight = blockHeight
+	t.finalized = true
+	return nil
+}
+
+// GetSnapshot returns the snapshot of the finalized deposits of the tree,
+// or nil if no deposits have been finalized yet.
+func (t *DepositTree) GetSnapshot() (*eip4881.DepositTreeSnapshot, error) {
+	if !t.finalized {
+		//nolint:nilnil // no snapshot before finalization.
+		return nil, nil
+	}
+	finalized, count := t.tree.getFinalized(make([]common.Root, 0))
+	root, err := t.RootAt(count)
+	if err != nil {
+		return nil, err
+	}
+	return &eip4881.DepositTreeSnapshot{
+		Finalized:            finalized,
+		DepositRoot:          root,
+		DepositCount:         count,
+		ExecutionBlockHash:   t.finalizedBlockHash,
+		ExecutionBlockHeight: t.finalizedBlockHeight,
+	}, nil
+}
+
+// GetProof returns the Merkle proof, including the length mix-in, of the
+// deposit at the given index against the deposit root of the tree.
+func (t *DepositTree) GetProof(index uint64) ([]common.Root, error) {
+	return t.GetProofAt(index, t.mixInLength)
+}
+
+// GetProofAt returns the Merkle proof, including the length mix-in, of the
+// deposit at the given index against the deposit root of the tree as it was
+// when it held the given number of deposits.
+func (t *DepositTree) GetProofAt(index, count uint64) ([]common.Root, error) {
+	if index >= count || count > t.mixInLength {
+		return nil, ErrDepositNotFound
+	}
+	if index < t.finalizedCount {
+		return nil, ErrDepositFinalized
+	}
+
+	var (
+		proof = make([]common.Root, treeDepth, treeDepth+1)
+		node  = t.tree
+		start uint64
+		err   error
+	)
+	for level := treeDepth; level > 0; level-- {
+		var inner *innerNode
+		switch n := node.(type) {
+		case *innerNode:
+			inner = n
+		case *finalizedNode:
+			return nil, ErrDepositFinalized
+		default:
+			return nil, ErrDepositNotFound
+		}
+
+		// The sibling only counts the deposits of its subtree that were
+		// pushed before the given count.
+		half := uint64(1) << (level - 1)
+		if (index>>(level-1))&1 == 1 {
+			proof[level-1], err = inner.left.rootAt(half, level-1)
+			node = inner.right
+			start += half
+		} else {
+			var rightCount uint64
+			if count > start+half {
+				rightCount = count - start - half
+			}
+			proof[level-1], err = inner.right.rootAt(rightCount, level-1)
+			node = inner.left
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return append(proof, eip4881.LengthMixIn(count)), nil
+}
+
+// merkleTree is a node of the deposit tree.
+type merkleTree interface {
+	// getRoot returns the root of the node.
+	getRoot() common.Root
+	// isFull returns true if no more leaves can be pushed to the node.
+	isFull() bool
+	// pushLeaf pushes a leaf to the node at the given level, returning the
+	// resulting node.
+	pushLeaf(leaf common.Root, level uint64) (merkleTree, error)
+	// finalize finalizes the first depositsToFinalize leaves of the node at
+	// the given level, returning the resulting node.
+	finalize(depositsToFinalize, level uint64) merkleTree
+	// getFinalized appends the roots of the finalized subtrees of the node
+	// to result, and returns the number of finalized leaves.
+	getFinalized(result []common.Root) ([]common.Root, uint64)
+	// rootAt returns the root of the node at the given level as it was when
+	// it held the given number of leaves.
+	rootAt(count, level uint64) (common.Root, error)
+}
+
+// finalizedNode is a full subtree whose leaves have been finalized.
+type finalizedNode struct {
+	depositCount uint64
+	hash         common.Root
+}
+
+func (n *finalizedNode) getRoot() common.Root { return n.hash }
+
+func (n *finalizedNode) isFull() bool { return true }
+
+func (n *finalizedNode) pushLeaf(common.Root, uint64) (merkleTree, error) {
+	return nil, ErrDepositTreeFull
+}
+
+func (n *finalizedNode) finalize(uint64, uint64) merkleTree { return n }
+
+func (n *finalizedNode) getFinalized(
+	result []common.Root,
+) ([]common.Root, uint64) {
+	return append(result, n.hash), n.depositCount
+}
+
+func (n *finalizedNode) rootAt(count, level uint64) (common.Root, error) {
+	switch {
+	case count == 0:
+		return zero.Hashes[level], nil
+	case count >= n.depositCount:
+		return n.hash, nil
+	default:
+		return common.Root{}, ErrDepositFinalized
+	}
+}
+
+// leafNode is the data root of a deposit.
+type leafNode struct {
+	hash common.Root
+}
+
+func (n *leafNode) getRoot() common.Root { return n.hash }
+
+func (n *leafNode) isFull() bool { return true }
+
+func (n *leafNode) pushLeaf(common.Root, uint64) (merkleTree, error) {
+	return nil, ErrDepositTreeFull
+}
+
+func (n *leafNode) finalize(uint64, uint64) merkleTree {
+	return &finalizedNode{depositCount: 1, hash: n.hash}
+}
+
+func (n *leafNode) getFinalized(
+	result []common.Root,
+) ([]common.Root, uint64) {
+	return result, 0
+}
+
+func (n *leafNode) rootAt(count, _ uint64) (common.Root, error) {
+	if count == 0 {
+		return zero.Hashes[0], nil
+	}
+	return n.hash, nil
+}
+
+// innerNode is a subtree holding at least one leaf.
+type innerNode struct {
+	left  merkleTree
+	right merkleTree
+	// root caches the root of the node once it is full, as it can no
+	// longer change.
+	root *common.Root
+}
+
+func (n *innerNode) getRoot() common.Root {
+	if n.root != nil {
+		return *n.root
+	}
+	root := eip4881.HashPair(n.left.getRoot(), n.right.getRoot())
+	if n.isFull() {
+		n.root = &root
+	}
+	return root
+}
+
+func (n *innerNode) isFull() bool { return n.right.isFull() }
+
+func (n *innerNode) pushLeaf(
+	leaf common.Root, level uint64,
+) (merkleTree, error) {
+	var err error
+	if !n.left.isFull() {
+		n.left, err = n.left.pushLeaf(leaf, level-1)
+	} else {
+		n.right, err = n.right.pushLeaf(leaf, level-1)
+	}
+	return n, err
+}
+
+func (n *innerNode) finalize(depositsToFinalize, level uint64) merkleTree {
+	deposits := uint64(1) << level
+	if deposits <= depositsToFinalize {
+		return &finalizedNode{depositCount: deposits, hash: n.getRoot()}
+	}
+	n.left = n.left.finalize(depositsToFinalize, level-1)
+	if depositsToFinalize > deposits/2 {
+		n.right = n.right.finalize(depositsToFinalize-deposits/2, level-1)
+	}
+	return n
+}
+
+func (n *innerNode) getFinalized(
+	result []common.Root,
+) ([]common.Root, uint64) {
+	result, left := n.left.getFinalized(result)
+	result, right := n.right.getFinalized(result)
+	return result, left + right
+}
+
+func (n *innerNode) rootAt(count, level uint64) (common.Root, error) {
+	switch {
+	case count == 0:
+		return zero.Hashes[level], nil
+	case count >= uint64(1)<<level:
+		return n.getRoot(), nil
+	}
+
+	half := uint64(1) << (level - 1)
+	left, err := n.left.rootAt(min(count, half), level-1)
+	if err != nil {
+		return common.Root{}, err
+	}
+	right := common.Root(zero.Hashes[level-1])
+	if count > half {
+		if right, err = n.right.rootAt(count-half, level-1); err != nil {
+			return common.Root{}, err
+		}
+	}
+	return eip4881.HashPair(left, right), nil
+}
+
+// zeroNode is a subtree without any leaf.
+type zeroNode struct {
+	level uint64
+}
+
+func (n *zeroNode) getRoot() common.Root { return zero.Hashes[n.level] }
+
+func (n *zeroNode) isFull() bool { return false }
+
+func (n *zeroNode) pushLeaf(
+	leaf common.Root, level uint64,
+) (merkleTree, error) {
+	return newLeafTree(leaf, level), nil
+}
+
+func (n *zeroNode) finalize(uint64, uint64) merkleTree { return n }
+
+func (n *zeroNode) getFinalized(
+	result []common.Root,
+) ([]common.Root, uint64) {
+	return result, 0
+}
+
+func (n *zeroNode) rootAt(_, level uint64) (common.Root, error) {
+	return zero.Hashes[level], nil
+}
+
+// newLeafTree returns a subtree at the given level holding the given leaf
+// only.
+func newLeafTree(leaf common.Root, level uint64) merkleTree {
+	if level == 0 {
+		return &leafNode{hash: leaf}
+	}
+	return &innerNode{
+		left:  newLeafTree(leaf, level-1),
+		right: &zeroNode{level: level - 1},
+	}
+}
+
+// fromSnapshotParts rebuilds a subtree at the given level from the roots of
+// its finalized subtrees and its number of finalized leaves.
+func fromSnapshotParts(
+	finalized []common.Root, depositCount, level uint64,
+) merkleTree {
+	if len(finalized) == 0 || depositCount == 0 {
+		return &zeroNode{level: level}
+	}
+	if depositCount == uint64(1)<<level {
+		return &finalizedNode{depositCount: depositCount, hash: finalized[0]}
+	}
+
+	half := uint64(1) << (level - 1)
+	if depositCount <= half {
+		return &innerNode{
+			left:  fromSnapshotParts(finalized, depositCount, level-1),
+			right: &zeroNode{level: level - 1},
+		}
+	}
+	return &innerNode{
+		left: &finalizedNode{depositCount: half, hash: finalized[0]},
+		right: fromSnapshotParts(
+			finalized[1:], depositCount-half, level-1,
+		),
+	}
+}
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+func testLeaves(n int) []common.Root {
+	leaves := make([]common.Root, n)
+	for i := range leaves {
+		leaves[i] = sha256.Sum256([]byte{byte(i)})
+	}
+	return leaves
+}
+
+func newTestTree(t *testing.T, leaves []common.Root) *deposit.DepositTree {
+	t.Helper()
+	tree := deposit.NewDepositTree()
+	for _, leaf := range leaves {
+		require.NoError(t, tree.PushLeaf(leaf))
+	}
+	return tree
+}
+
+// requireValidProof checks the proof of the deposit at the given index
+// against the root of the tree holding the given number of deposits.
+func requireValidProof(
+	t *testing.T,
+	tree *deposit.DepositTree,
+	leaves []common.Root,
+	index, count uint64,
+) {
+	t.Helper()
+	root, err := tree.RootAt(count)
+	require.NoError(t, err)
+	proof, err := tree.GetProofAt(index, count)
+	require.NoError(t, err)
+	require.True(t, merkle.IsValidMerkleBranch(
+		leaves[index], proof, constants.DepositContractTreeDepth+1,
+		index, root,
+	))
+}
+
+func TestDepositTree_RootsAndProofs(t *testing.T) {
+	leaves := testLeaves(20)
+	tree := newTestTree(t, leaves)
+	require.Equal(t, uint64(len(leaves)), tree.DepositCount())
+
+	for count := 1; count <= len(leaves); count++ {
+		// The merkle tree hashes in place, so it is given its own leaves.
+		expected, err := merkle.NewTreeFromLeavesWithDepth(
+			testLeaves(count), constants.DepositContractTreeDepth,
+		)
+		require.NoError(t, err)
+		expectedRoot, err := expected.HashTreeRoot()
+		require.NoError(t, err)
+
+		root, err := tree.RootAt(uint64(count))
+		require.NoError(t, err)
+		require.Equal(t, common.Root(expectedRoot), root)
+
+		for index := range count {
+			requireValidProof(t, tree, leaves, uint64(index), uint64(count))
+		}
+	}
+	root, err := tree.RootAt(tree.DepositCount())
+	require.NoError(t, err)
+	require.Equal(t, root, tree.HashTreeRoot())
+
+	_, err = tree.GetProofAt(5, 5)
+	require.ErrorIs(t, err, deposit.ErrDepositNotFound)
+	_, err = tree.RootAt(21)
+	require.ErrorIs(t, err, deposit.ErrDepositNotFound)
+}
+
+func TestDepositTree_FinalizeAndSnapshot(t *testing.T) {
+	leaves := testLeaves(30)
+	tree := newTestTree(t, leaves[:20])
+
+	snapshot, err := tree.GetSnapshot()
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+
+	root := tree.HashTreeRoot()
+	require.NoError(t, tree.Finalize(13, common.ExecutionHash{1}, 100))
+	require.Equal(t, root, tree.HashTreeRoot())
+
+	// Finalized deposits can no longer be proven, others still can.
+	_, err = tree.GetProof(12)
+	require.ErrorIs(t, err, deposit.ErrDepositFinalized)
+	for index := uint64(13); index < 20; index++ {
+		requireValidProof(t, tree, leaves, index, 20)
+	}
+
+	snapshot, err = tree.GetSnapshot()
+	require.NoError(t, err)
+	require.Equal(t, uint64(13), snapshot.DepositCount)
+	require.Equal(t, common.ExecutionHash{1}, snapshot.ExecutionBlockHash)
+	require.Equal(t, uint64(100), snapshot.ExecutionBlockHeight)
+	require.Equal(t, snapshot.CalculateRoot(), snapshot.DepositRoot)
+	expectedRoot, err := tree.RootAt(13)
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot, snapshot.DepositRoot)
+
+	// The tree rebuilt from the snapshot matches the original one once the
+	// deposits past the snapshot are pushed back.
+	rebuilt, err := deposit.NewDepositTreeFromSnapshot(snapshot)
+	require.NoError(t, err)
+	for _, leaf := range leaves[13:20] {
+		require.NoError(t, rebuilt.PushLeaf(leaf))
+	}
+	require.Equal(t, tree.HashTreeRoot(), rebuilt.HashTreeRoot())
+
+	for _, leaf := range leaves[20:] {
+		require.NoError(t, tree.PushLeaf(leaf))
+		require.NoError(t, rebuilt.PushLeaf(leaf))
+	}
+	require.Equal(t, tree.HashTreeRoot(), rebuilt.HashTreeRoot())
+	for index := uint64(13); index < 30; index++ {
+		requireValidProof(t, rebuilt, leaves, index, 30)
+	}
+
+	// Finalizing unknown deposits fails, finalizing past deposits is a
+	// no-op.
+	require.ErrorIs(
+		t,
+		tree.Finalize(31, common.ExecutionHash{}, 0),
+		deposit.ErrDepositNotFound,
+	)
+	require.NoError(t, tree.Finalize(5, common.ExecutionHash{}, 0))
+	snapshot, err = tree.GetSnapshot()
+	require.NoError(t, err)
+	require.Equal(t, uint64(13), snapshot.DepositCount)
+}
+
+func TestDepositTree_InvalidSnapshot(t *testing.T) {
+	tree := newTestTree(t, testLeaves(4))
+	require.NoError(t, tree.Finalize(3, common.ExecutionHash{}, 0))
+	snapshot, err := tree.GetSnapshot()
+	require.NoError(t, err)
+
+	snapshot.DepositCount++
+	_, err = deposit.NewDepositTreeFromSnapshot(snapshot)
+	require.ErrorIs(t, err, deposit.ErrInvalidSnapshot)
+}
@@ -20,12 +20,16 @@
 
 package deposit
 
-import "github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+)
 
 // Deposit is a struct that represents a deposit.
 type Deposit interface {
 	constraints.SSZMarshallable
 	GetIndex() uint64
+	GetDataRoot() (common.Root, error)
 }
 
 // RawBatch represents a group of writes. They may or may not be written
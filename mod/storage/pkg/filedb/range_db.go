@@ -23,12 +23,15 @@ package filedb
 import (
 	"bytes"
 	"fmt"
+	"io/fs"
+	"path/filepath"
 	"strconv"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/hex"
 	db "github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/spf13/afero"
 )
 
 // two is a constant for the number 2.
@@ -78,6 +81,39 @@ func (db *RangeDB) Set(index uint64, key []byte, value []byte) error {
 	return db.DB.Set(db.prefix(index, key), value)
 }
 
+// GetByIndex retrieves all the values stored under the given index, in the
+// lexical order of their keys. An index without any value yields an empty
+// result.
+func (db *RangeDB) GetByIndex(index uint64) ([][]byte, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil, errors.New("rangedb: get by index not supported for this db")
+	}
+
+	dir := strconv.FormatUint(index, 10)
+	entries, err := afero.ReadDir(f.fs, dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return [][]byte{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	values := make([][]byte, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != "."+f.extension {
+			continue
+		}
+		var value []byte
+		if value, err = afero.ReadFile(
+			f.fs, filepath.Join(dir, entry.Name()),
+		); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
 // Delete removes the value associated with the given index and key from the
 // database. It prefixes the key with the index and a slash before deleting it
 // from the underlying database.
@@ -194,6 +194,28 @@ func TestExtractIndex(t *testing.T) {
 
 // =========================== PRUNING =====================================
 
+func TestRangeDB_GetByIndex(t *testing.T) {
+	rdb := file.NewRangeDB(newTestFDB(t.TempDir()))
+	require.NoError(t, rdb.Set(1, []byte("b"), []byte("value-b")))
+	require.NoError(t, rdb.Set(1, []byte("a"), []byte("value-a")))
+	require.NoError(t, rdb.Set(2, []byte("c"), []byte("value-c")))
+
+	values, err := rdb.GetByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("value-a"), []byte("value-b")}, values)
+
+	values, err = rdb.GetByIndex(3)
+	require.NoError(t, err)
+	require.Empty(t, values)
+}
+
+func TestRangeDB_GetByIndex_NotSupported(t *testing.T) {
+	rdb := file.NewRangeDB(new(mocks.DB))
+
+	_, err := rdb.GetByIndex(1)
+	require.Error(t, err)
+}
+
 func TestRangeDB_DeleteRange_NotSupported(t *testing.T) {
 	tests := []struct {
 		name string
@@ -28,6 +28,7 @@ package manager
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
@@ -72,3 +73,36 @@ func (m *DBManager[
 	}
 	return nil
 }
+
+// Stop waits for all pruners to exit.
+func (m *DBManager[
+	_, _,
+]) Stop(context.Context) error {
+	for _, pruner := range m.pruners {
+		pruner.Stop()
+	}
+	return nil
+}
+
+// Status returns an error if any pruner died.
+func (m *DBManager[
+	_, _,
+]) Status() error {
+	errs := make([]error, 0, len(m.pruners))
+	for _, pruner := range m.pruners {
+		errs = append(errs, pruner.Status())
+	}
+	return errors.Join(errs...)
+}
+
+// Close closes the stores the pruners prune, once the Basic Service is
+// stopped for good.
+func (m *DBManager[
+	_, _,
+]) Close() error {
+	errs := make([]error, 0, len(m.pruners))
+	for _, pruner := range m.pruners {
+		errs = append(errs, pruner.Close())
+	}
+	return errors.Join(errs...)
+}
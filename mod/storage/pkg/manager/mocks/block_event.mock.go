@@ -20,6 +20,51 @@ func (_m *BlockEvent[BeaconBlockT]) EXPECT() *BlockEvent_Expecter[BeaconBlockT]
 	return &BlockEvent_Expecter[BeaconBlockT]{mock: &_m.Mock}
 }
 
+// Ack provides a mock function with given fields:
+func (_m *BlockEvent[BeaconBlockT]) Ack() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ack")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BlockEvent_Ack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ack'
+type BlockEvent_Ack_Call[BeaconBlockT manager.BeaconBlock] struct {
+	*mock.Call
+}
+
+// Ack is a helper method to define mock.On call
+func (_e *BlockEvent_Expecter[BeaconBlockT]) Ack() *BlockEvent_Ack_Call[BeaconBlockT] {
+	return &BlockEvent_Ack_Call[BeaconBlockT]{Call: _e.mock.On("Ack")}
+}
+
+func (_c *BlockEvent_Ack_Call[BeaconBlockT]) Run(run func()) *BlockEvent_Ack_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BlockEvent_Ack_Call[BeaconBlockT]) Return(_a0 error) *BlockEvent_Ack_Call[BeaconBlockT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BlockEvent_Ack_Call[BeaconBlockT]) RunAndReturn(run func() error) *BlockEvent_Ack_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Block provides a mock function with given fields:
 func (_m *BlockEvent[BeaconBlockT]) Block() BeaconBlockT {
 	ret := _m.Called()
@@ -39,4 +39,5 @@ type BeaconBlock interface {
 type BlockEvent[BeaconBlockT BeaconBlock] interface {
 	Is(asynctypes.EventID) bool
 	Data() BeaconBlockT
+	Ack() error
 }
@@ -37,4 +37,10 @@ type Prunable interface {
 type Pruner[PrunableT Prunable] interface {
 	Name() string
 	Start(ctx context.Context)
+	// Stop waits for the pruner to exit once its context is cancelled.
+	Stop()
+	// Status returns an error if the pruner died.
+	Status() error
+	// Close closes the store the pruner prunes, if the store can be closed.
+	Close() error
 }
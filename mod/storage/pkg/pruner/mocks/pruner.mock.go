@@ -22,6 +22,51 @@ func (_m *Pruner[PrunableT]) EXPECT() *Pruner_Expecter[PrunableT] {
 	return &Pruner_Expecter[PrunableT]{mock: &_m.Mock}
 }
 
+// Close provides a mock function with given fields:
+func (_m *Pruner[PrunableT]) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Pruner_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type Pruner_Close_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *Pruner_Expecter[PrunableT]) Close() *Pruner_Close_Call[PrunableT] {
+	return &Pruner_Close_Call[PrunableT]{Call: _e.mock.On("Close")}
+}
+
+func (_c *Pruner_Close_Call[PrunableT]) Run(run func()) *Pruner_Close_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Pruner_Close_Call[PrunableT]) Return(_a0 error) *Pruner_Close_Call[PrunableT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Pruner_Close_Call[PrunableT]) RunAndReturn(run func() error) *Pruner_Close_Call[PrunableT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function with given fields:
 func (_m *Pruner[PrunableT]) Name() string {
 	ret := _m.Called()
@@ -100,6 +145,83 @@ func (_c *Pruner_Start_Call[PrunableT]) RunAndReturn(run func(context.Context))
 	return _c
 }
 
+// Status provides a mock function with given fields:
+func (_m *Pruner[PrunableT]) Status() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Pruner_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type Pruner_Status_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+func (_e *Pruner_Expecter[PrunableT]) Status() *Pruner_Status_Call[PrunableT] {
+	return &Pruner_Status_Call[PrunableT]{Call: _e.mock.On("Status")}
+}
+
+func (_c *Pruner_Status_Call[PrunableT]) Run(run func()) *Pruner_Status_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Pruner_Status_Call[PrunableT]) Return(_a0 error) *Pruner_Status_Call[PrunableT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Pruner_Status_Call[PrunableT]) RunAndReturn(run func() error) *Pruner_Status_Call[PrunableT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields:
+func (_m *Pruner[PrunableT]) Stop() {
+	_m.Called()
+}
+
+// Pruner_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type Pruner_Stop_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *Pruner_Expecter[PrunableT]) Stop() *Pruner_Stop_Call[PrunableT] {
+	return &Pruner_Stop_Call[PrunableT]{Call: _e.mock.On("Stop")}
+}
+
+func (_c *Pruner_Stop_Call[PrunableT]) Run(run func()) *Pruner_Stop_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Pruner_Stop_Call[PrunableT]) Return() *Pruner_Stop_Call[PrunableT] {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Pruner_Stop_Call[PrunableT]) RunAndReturn(run func()) *Pruner_Stop_Call[PrunableT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPruner creates a new instance of Pruner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPruner[PrunableT pruner.Prunable](t interface {
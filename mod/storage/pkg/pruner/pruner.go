@@ -28,9 +28,10 @@ package pruner
 
 import (
 	"context"
+	"io"
 
 	"github.com/berachain/beacon-kit/mod/log"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/service"
 )
 
 // Compile-time check to ensure pruner implements the Pruner interface.
@@ -50,6 +51,8 @@ type pruner[
 	name         string
 	feed         chan BlockEventT
 	pruneRangeFn func(BlockEventT) (uint64, uint64)
+	// loops runs the pruning loop.
+	loops service.Loops
 }
 
 // NewPruner creates a new Pruner.
@@ -75,7 +78,27 @@ func NewPruner[
 
 // Start starts the Pruner by listening for new indexes to prune.
 func (p *pruner[_, _, _]) Start(ctx context.Context) {
-	go p.start(ctx)
+	p.loops.Reset()
+	p.loops.Go(ctx, p.name, p.start)
+}
+
+// Stop waits for the Pruner to finish pruning once its context is
+// cancelled.
+func (p *pruner[_, _, _]) Stop() {
+	p.loops.Wait()
+}
+
+// Status returns an error if the pruning loop died.
+func (p *pruner[_, _, _]) Status() error {
+	return p.loops.Err()
+}
+
+// Close closes the store the Pruner prunes, if the store can be closed.
+func (p *pruner[_, _, _]) Close() error {
+	if closer, ok := p.prunable.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
 }
 
 // start listens for new indexes to prune.
@@ -84,15 +107,23 @@ func (p *pruner[_, _, _]) start(ctx context.Context) {
 		select {
 		case <-ctx.Done():
 			return
-		case event := <-p.feed:
-			if event.Is(events.BeaconBlockFinalized) {
-				start, end := p.pruneRangeFn(event)
-				if err := p.prunable.Prune(start, end); err != nil {
-					p.logger.Error(
-						"‼️ error pruning index ‼️",
-						"error", err,
-					)
-				}
+		case event, ok := <-p.feed:
+			if !ok {
+				return
+			}
+			start, end := p.pruneRangeFn(event)
+			if err := p.prunable.Prune(start, end); err != nil {
+				p.logger.Error(
+					"‼️ error pruning index ‼️",
+					"error", err,
+				)
+				continue
+			}
+			if err := event.Ack(); err != nil {
+				p.logger.Error(
+					"failed to acknowledge pruned event",
+					"error", err,
+				)
 			}
 		}
 	}
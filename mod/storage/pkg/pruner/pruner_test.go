@@ -35,6 +35,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner/mocks"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func pruneRangeFn[EventT pruner.BlockEvent[pruner.BeaconBlock]](
@@ -95,6 +96,7 @@ func TestPruner(t *testing.T) {
 				event := mocks.BlockEvent[pruner.BeaconBlock]{}
 				event.On("Data").Return(&block)
 				event.On("Is", mock.Anything).Return(true)
+				event.On("Ack").Return(nil)
 				ch <- &event
 			}
 
@@ -120,3 +122,55 @@ func TestPruner(t *testing.T) {
 		})
 	}
 }
+
+// closablePrunable is a prunable store that records whether it is closed.
+type closablePrunable struct {
+	*mocks.Prunable
+	closed bool
+}
+
+func (p *closablePrunable) Close() error {
+	p.closed = true
+	return nil
+}
+
+func TestPruner_CloseClosesPrunable(t *testing.T) {
+	prunable := &closablePrunable{Prunable: new(mocks.Prunable)}
+	testPruner := pruner.NewPruner[
+		pruner.BeaconBlock,
+		pruner.BlockEvent[pruner.BeaconBlock],
+		pruner.Prunable,
+	](
+		log.NewNopLogger(), prunable, "TestPruner",
+		make(chan pruner.BlockEvent[pruner.BeaconBlock]), pruneRangeFn,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	testPruner.Start(ctx)
+	cancel()
+
+	testPruner.Stop()
+	require.NoError(t, testPruner.Status())
+	require.False(t, prunable.closed)
+
+	require.NoError(t, testPruner.Close())
+	require.True(t, prunable.closed)
+}
+
+func TestPruner_ReportsClosedFeed(t *testing.T) {
+	feed := make(chan pruner.BlockEvent[pruner.BeaconBlock])
+	testPruner := pruner.NewPruner[
+		pruner.BeaconBlock,
+		pruner.BlockEvent[pruner.BeaconBlock],
+		pruner.Prunable,
+	](
+		log.NewNopLogger(), new(mocks.Prunable), "TestPruner",
+		feed, pruneRangeFn,
+	)
+
+	testPruner.Start(context.Background())
+	close(feed)
+	testPruner.Stop()
+
+	require.Error(t, testPruner.Status())
+}
@@ -25,10 +25,7 @@
 
 package pruner
 
-import (
-	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
-)
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 
 // BeaconBlock is an interface for beacon blocks.
 type BeaconBlock interface {
@@ -37,8 +34,8 @@ type BeaconBlock interface {
 
 // BlockEvent is an interface for block events.
 type BlockEvent[BeaconBlockT BeaconBlock] interface {
-	Is(asynctypes.EventID) bool
 	Data() BeaconBlockT
+	Ack() error
 }
 
 type Subscription interface {
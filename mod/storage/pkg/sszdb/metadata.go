@@ -76,7 +76,7 @@ func getSchemaNode(path objectPath) *schemaNodePOC {
 var beaconStateLookup = map[string]*schemaNodePOC{
 	".": {
 		gindex: 1, // root node gindex=1
-		height: 5,
+		height: 6,
 		children: lookupTable{
 			"genesis_validators_root": {
 				size:  32,
@@ -139,7 +139,7 @@ var beaconStateLookup = map[string]*schemaNodePOC{
 			},
 			"validators": {
 				height: ceilLog2(nextPowerOfTwo(1099511627776)) + 2,
-				order:  9,
+				order:  10,
 				list:   true,
 				size:   uint64((&types.Validator{}).SizeSSZ()),
 				children: lookupTable{